@@ -23,6 +23,11 @@ func Context(t *testing.T) context.Context {
 	ctx = logger(ctx, t, "acctest")
 	ctx = awsSDKLogger(ctx)
 
+	if isVCREnabled() {
+		// API calls made using acctest.Provider are recorded in the test's cassette.
+		ctx = vcrContext(ctx, t.Name())
+	}
+
 	return ctx
 }
 
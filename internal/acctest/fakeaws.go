@@ -0,0 +1,858 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/fwprovider"
+)
+
+// fakeAWSClearedEnvVars are the environment variables that would otherwise change
+// the AWS SDK configuration used with the fake AWS services.
+var fakeAWSClearedEnvVars = []string{
+	"AWS_CA_BUNDLE",
+	"AWS_CONFIG_FILE",
+	"AWS_DEFAULT_PROFILE",
+	"AWS_PROFILE",
+	"AWS_SHARED_CREDENTIALS_FILE",
+	"AWS_USE_DUALSTACK_ENDPOINT",
+	"AWS_USE_FIPS_ENDPOINT",
+}
+
+const (
+	// fakeAWSResourceName is the name of the resource under test in the State passed to check functions.
+	fakeAWSResourceName = "test"
+)
+
+// FakeAWSResourceTestCase describes a Create/Read/Update/Delete/Import test of a single resource
+// run in-process against the fake AWS services in package fakeaws.
+// No Terraform CLI, AWS credentials or network access are required.
+type FakeAWSResourceTestCase struct {
+	// ResourceType is the Terraform resource type under test, e.g. "aws_cloudwatch_log_group".
+	ResourceType string
+
+	// ServicePackage is the service package that implements ResourceType.
+	// It is registered with the provider if it is not built into the provider.
+	ServicePackage conns.ServicePackage
+
+	// Server is the fake AWS services instance used by the test.
+	// A new instance is used if nil.
+	Server *fakeaws.Server
+
+	// Config is the resource's configuration, with JSON-compatible values.
+	// Unset attributes are null and unset list or set blocks are empty.
+	Config map[string]any
+
+	// Check is run against the resource's state after creation.
+	// The resource address is "<ResourceType>.test".
+	Check resource.TestCheckFunc
+
+	// UpdateConfig, if set, is applied to the resource after Config.
+	UpdateConfig map[string]any
+
+	// CheckUpdate is run against the resource's state after update.
+	CheckUpdate resource.TestCheckFunc
+
+	// ImportStateIdFunc returns the ID used to import the resource.
+	// The resource's "id" attribute is used if nil.
+	ImportStateIdFunc resource.ImportStateIdFunc //nolint:revive,stylecheck // Matches resource.TestStep.
+
+	// ImportStateVerifyIgnore lists attributes not verified after import.
+	ImportStateVerifyIgnore []string
+
+	// SkipImport disables the import step.
+	SkipImport bool
+}
+
+// FakeAWSProvider returns the primary (Plugin SDK) acctest Provider configured to send all
+// AWS API calls for the fake services to server.
+// The specified service packages are registered in addition to those built into the provider.
+// The provider's endpoints are set via conns.Config.Endpoints and its HTTP client via AWSClient.SetHTTPClient
+// so that no request leaves the process.
+// Environment variables that configure the AWS SDK are cleared for the duration of the test,
+// so FakeAWSProvider cannot be used in parallel tests.
+func FakeAWSProvider(ctx context.Context, t *testing.T, server *fakeaws.Server, sps ...conns.ServicePackage) *schema.Provider {
+	t.Helper()
+
+	for _, k := range fakeAWSClearedEnvVars {
+		t.Setenv(k, "")
+	}
+
+	primary, err := provider.NewWithServicePackages(ctx, sps...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meta := primary.Meta().(*conns.AWSClient)
+	// Must be called before the provider is configured.
+	meta.SetHTTPClient(ctx, server.HTTPClient())
+
+	config := conns.Config{
+		AccessKey:                     "fake",
+		EC2MetadataServiceEnableState: imds.ClientDisabled,
+		Endpoints:                     server.Endpoints(),
+		MaxRetries:                    1,
+		Region:                        server.Region(),
+		SecretKey:                     "fake",
+		TerraformVersion:              "1.9.0",
+	}
+
+	meta, diags := config.ConfigureProvider(ctx, meta)
+	if diags.HasError() {
+		t.Fatalf("configuring provider: %v", diags)
+	}
+	primary.SetMeta(meta)
+
+	return primary
+}
+
+// FakeAWSResourceTest runs a Create/Read/Update/Delete/Import test of a single resource against
+// the fake AWS services.
+// The resource's protocol v5 provider server is driven directly, following the sequence of calls
+// Terraform makes for each step:
+//
+//  1. Create the resource from Config and run Check
+//  2. Verify that re-planning Config produces no changes
+//  3. If UpdateConfig is set, update (or replace) the resource and run CheckUpdate
+//  4. Import the resource and verify the imported state
+//  5. Destroy the resource and verify that it can no longer be read
+func FakeAWSResourceTest(ctx context.Context, t *testing.T, testCase FakeAWSResourceTestCase) {
+	t.Helper()
+
+	server := testCase.Server
+	if server == nil {
+		server = fakeaws.New()
+	}
+
+	var sps []conns.ServicePackage
+	if testCase.ServicePackage != nil {
+		sps = append(sps, testCase.ServicePackage)
+	}
+
+	primary := FakeAWSProvider(ctx, t, server, sps...)
+
+	h := &fakeAWSHarness{
+		resourceType: testCase.ResourceType,
+		t:            t,
+	}
+
+	// Plugin SDK resources are served by the primary provider, all others by the Plugin Framework provider.
+	if _, ok := primary.ResourcesMap[testCase.ResourceType]; ok {
+		h.server = primary.GRPCProvider()
+	} else {
+		h.server = providerserver.NewProtocol5(fwprovider.New(primary))()
+		h.configureProvider = true
+	}
+
+	if err := h.init(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// Create.
+	state, err := h.apply(ctx, h.null(), testCase.Config)
+	if err != nil {
+		t.Fatalf("creating %s: %s", testCase.ResourceType, err)
+	}
+	h.check("create", state, testCase.Check)
+	state = h.refreshAndVerifyNoChanges(ctx, state, testCase.Config)
+
+	// Update.
+	if testCase.UpdateConfig != nil {
+		state, err = h.apply(ctx, state, testCase.UpdateConfig)
+		if err != nil {
+			t.Fatalf("updating %s: %s", testCase.ResourceType, err)
+		}
+		h.check("update", state, testCase.CheckUpdate)
+		state = h.refreshAndVerifyNoChanges(ctx, state, testCase.UpdateConfig)
+	}
+
+	// Import.
+	if !testCase.SkipImport {
+		h.importStateVerify(ctx, state, testCase.ImportStateIdFunc, testCase.ImportStateVerifyIgnore)
+	}
+
+	// Destroy.
+	if err := h.destroy(ctx, state); err != nil {
+		t.Fatalf("destroying %s: %s", testCase.ResourceType, err)
+	}
+
+	state, err = h.read(ctx, state)
+	if err != nil {
+		t.Fatalf("reading %s after destroy: %s", testCase.ResourceType, err)
+	}
+	if !state.Value.IsNull() {
+		t.Fatalf("%s still exists after destroy", testCase.ResourceType)
+	}
+}
+
+// fakeAWSHarness drives a single resource type through a protocol v5 provider server.
+type fakeAWSHarness struct {
+	block             *tfprotov5.SchemaBlock
+	configureProvider bool
+	resourceType      string
+	server            tfprotov5.ProviderServer
+	t                 *testing.T
+	typ               tftypes.Type
+}
+
+// fakeAWSState is a resource's state and private data.
+type fakeAWSState struct {
+	Private []byte
+	Value   tftypes.Value
+}
+
+func (h *fakeAWSHarness) init(ctx context.Context) error {
+	response, err := h.server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return err
+	}
+	if err := fakeAWSDiagnosticsError(response.Diagnostics); err != nil {
+		return err
+	}
+
+	schema, ok := response.ResourceSchemas[h.resourceType]
+	if !ok {
+		return fmt.Errorf("resource type %s not found; set the test case's ServicePackage if the service package is not built into the provider", h.resourceType)
+	}
+	h.block = schema.Block
+	h.typ = schema.ValueType()
+
+	// The Plugin Framework provider server must be configured before use.
+	// Its instance data is taken from the primary provider's Meta().
+	// The Plugin SDK provider server is not configured, as that would replace the primary provider's Meta().
+	if !h.configureProvider {
+		return nil
+	}
+
+	providerConfig, err := fakeAWSNormalizeBlocks(response.Provider.Block, tftypes.NewValue(response.Provider.ValueType(), nil), true)
+	if err != nil {
+		return err
+	}
+	dv, err := tfprotov5.NewDynamicValue(response.Provider.ValueType(), providerConfig)
+	if err != nil {
+		return err
+	}
+
+	configureResponse, err := h.server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		Config:           &dv,
+		TerraformVersion: "1.9.0",
+	})
+	if err != nil {
+		return err
+	}
+
+	return fakeAWSDiagnosticsError(configureResponse.Diagnostics)
+}
+
+func (h *fakeAWSHarness) null() *fakeAWSState {
+	return &fakeAWSState{Value: tftypes.NewValue(h.typ, nil)}
+}
+
+// config converts a test configuration to a value of the resource's type.
+func (h *fakeAWSHarness) config(config map[string]any) (tftypes.Value, error) {
+	if config == nil {
+		return tftypes.NewValue(h.typ, nil), nil
+	}
+
+	b, err := json.Marshal(config)
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	v, err := (&tfprotov5.RawState{JSON: b}).UnmarshalWithOpts(h.typ, tfprotov5.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+			IgnoreUndefinedAttributes: true,
+		},
+	})
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("converting configuration: %w", err)
+	}
+
+	return fakeAWSNormalizeBlocks(h.block, v, false)
+}
+
+func (h *fakeAWSHarness) dynamicValue(v tftypes.Value) (*tfprotov5.DynamicValue, error) {
+	dv, err := tfprotov5.NewDynamicValue(h.typ, v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dv, nil
+}
+
+func (h *fakeAWSHarness) validate(ctx context.Context, config tftypes.Value) error {
+	dv, err := h.dynamicValue(config)
+	if err != nil {
+		return err
+	}
+
+	response, err := h.server.ValidateResourceTypeConfig(ctx, &tfprotov5.ValidateResourceTypeConfigRequest{
+		Config:   dv,
+		TypeName: h.resourceType,
+	})
+	if err != nil {
+		return err
+	}
+
+	return h.diagnostics(response.Diagnostics)
+}
+
+func (h *fakeAWSHarness) plan(ctx context.Context, prior *fakeAWSState, config tftypes.Value) (tftypes.Value, *tfprotov5.PlanResourceChangeResponse, error) {
+	proposed, err := fakeAWSProposedNew(h.block, prior.Value, config)
+	if err != nil {
+		return tftypes.Value{}, nil, err
+	}
+
+	priorDV, err := h.dynamicValue(prior.Value)
+	if err != nil {
+		return tftypes.Value{}, nil, err
+	}
+	proposedDV, err := h.dynamicValue(proposed)
+	if err != nil {
+		return tftypes.Value{}, nil, err
+	}
+	configDV, err := h.dynamicValue(config)
+	if err != nil {
+		return tftypes.Value{}, nil, err
+	}
+
+	response, err := h.server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		Config:           configDV,
+		PriorPrivate:     prior.Private,
+		PriorState:       priorDV,
+		ProposedNewState: proposedDV,
+		TypeName:         h.resourceType,
+	})
+	if err != nil {
+		return tftypes.Value{}, nil, err
+	}
+	if err := h.diagnostics(response.Diagnostics); err != nil {
+		return tftypes.Value{}, nil, err
+	}
+
+	planned, err := response.PlannedState.Unmarshal(h.typ)
+	if err != nil {
+		return tftypes.Value{}, nil, err
+	}
+
+	return planned, response, nil
+}
+
+func (h *fakeAWSHarness) applyPlanned(ctx context.Context, prior *fakeAWSState, config tftypes.Value, response *tfprotov5.PlanResourceChangeResponse) (*fakeAWSState, error) {
+	priorDV, err := h.dynamicValue(prior.Value)
+	if err != nil {
+		return nil, err
+	}
+	configDV, err := h.dynamicValue(config)
+	if err != nil {
+		return nil, err
+	}
+
+	applyResponse, err := h.server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+		Config:         configDV,
+		PlannedPrivate: response.PlannedPrivate,
+		PlannedState:   response.PlannedState,
+		PriorState:     priorDV,
+		TypeName:       h.resourceType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := h.diagnostics(applyResponse.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	v, err := applyResponse.NewState.Unmarshal(h.typ)
+	if err != nil {
+		return nil, err
+	}
+	if !v.IsFullyKnown() {
+		return nil, errors.New("provider returned unknown values after apply")
+	}
+
+	return &fakeAWSState{Private: applyResponse.Private, Value: v}, nil
+}
+
+// apply creates (prior is null) or updates the resource, replacing it if the plan requires.
+func (h *fakeAWSHarness) apply(ctx context.Context, prior *fakeAWSState, config map[string]any) (*fakeAWSState, error) {
+	c, err := h.config(config)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.validate(ctx, c); err != nil {
+		return nil, fmt.Errorf("validating: %w", err)
+	}
+
+	_, response, err := h.plan(ctx, prior, c)
+	if err != nil {
+		return nil, fmt.Errorf("planning: %w", err)
+	}
+
+	if !prior.Value.IsNull() && len(response.RequiresReplace) > 0 {
+		h.t.Logf("%s must be replaced: %v", h.resourceType, response.RequiresReplace)
+
+		if err := h.destroy(ctx, prior); err != nil {
+			return nil, err
+		}
+
+		return h.apply(ctx, h.null(), config)
+	}
+
+	return h.applyPlanned(ctx, prior, c, response)
+}
+
+func (h *fakeAWSHarness) destroy(ctx context.Context, prior *fakeAWSState) error {
+	c := tftypes.NewValue(h.typ, nil)
+
+	_, response, err := h.plan(ctx, prior, c)
+	if err != nil {
+		return fmt.Errorf("planning destroy: %w", err)
+	}
+
+	_, err = h.applyPlanned(ctx, prior, c, response)
+
+	return err
+}
+
+func (h *fakeAWSHarness) read(ctx context.Context, current *fakeAWSState) (*fakeAWSState, error) {
+	dv, err := h.dynamicValue(current.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := h.server.ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+		CurrentState: dv,
+		Private:      current.Private,
+		TypeName:     h.resourceType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := h.diagnostics(response.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	v, err := response.NewState.Unmarshal(h.typ)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fakeAWSState{Private: response.Private, Value: v}, nil
+}
+
+// refreshAndVerifyNoChanges reads the resource and verifies that planning the same configuration is empty.
+func (h *fakeAWSHarness) refreshAndVerifyNoChanges(ctx context.Context, state *fakeAWSState, config map[string]any) *fakeAWSState {
+	h.t.Helper()
+
+	state, err := h.read(ctx, state)
+	if err != nil {
+		h.t.Fatalf("reading %s: %s", h.resourceType, err)
+	}
+	if state.Value.IsNull() {
+		h.t.Fatalf("%s not found after apply", h.resourceType)
+	}
+
+	c, err := h.config(config)
+	if err != nil {
+		h.t.Fatal(err)
+	}
+
+	planned, _, err := h.plan(ctx, state, c)
+	if err != nil {
+		h.t.Fatalf("planning %s: %s", h.resourceType, err)
+	}
+
+	if !planned.Equal(state.Value) {
+		diffs, err := state.Value.Diff(planned)
+		if err != nil {
+			h.t.Fatal(err)
+		}
+
+		var paths []string
+		for _, d := range diffs {
+			paths = append(paths, d.Path.String())
+		}
+		h.t.Fatalf("After applying this test step, the plan was not empty. %s changes: %s", h.resourceType, strings.Join(paths, ", "))
+	}
+
+	return state
+}
+
+func (h *fakeAWSHarness) importStateVerify(ctx context.Context, state *fakeAWSState, idFunc resource.ImportStateIdFunc, ignore []string) {
+	h.t.Helper()
+
+	attributes, err := fakeAWSFlatmap(state.Value)
+	if err != nil {
+		h.t.Fatal(err)
+	}
+
+	id := attributes["id"]
+	if idFunc != nil {
+		id, err = idFunc(h.terraformState(attributes))
+		if err != nil {
+			h.t.Fatal(err)
+		}
+	}
+
+	response, err := h.server.ImportResourceState(ctx, &tfprotov5.ImportResourceStateRequest{
+		ID:       id,
+		TypeName: h.resourceType,
+	})
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	if err := h.diagnostics(response.Diagnostics); err != nil {
+		h.t.Fatalf("importing %s (%s): %s", h.resourceType, id, err)
+	}
+	if n := len(response.ImportedResources); n != 1 {
+		h.t.Fatalf("importing %s (%s): expected 1 resource, got %d", h.resourceType, id, n)
+	}
+
+	v, err := response.ImportedResources[0].State.Unmarshal(h.typ)
+	if err != nil {
+		h.t.Fatal(err)
+	}
+
+	imported, err := h.read(ctx, &fakeAWSState{Private: response.ImportedResources[0].Private, Value: v})
+	if err != nil {
+		h.t.Fatalf("reading imported %s (%s): %s", h.resourceType, id, err)
+	}
+	if imported.Value.IsNull() {
+		h.t.Fatalf("imported %s (%s) not found", h.resourceType, id)
+	}
+
+	actual, err := fakeAWSFlatmap(imported.Value)
+	if err != nil {
+		h.t.Fatal(err)
+	}
+
+	// Remove fields that are not expected to be set on import, and empty collections,
+	// as null and empty are indistinguishable to Terraform.
+	ignore = append(slices.Clone(ignore), "timeouts")
+	for _, m := range []map[string]string{attributes, actual} {
+		for k, v := range m {
+			if (strings.HasSuffix(k, ".#") || strings.HasSuffix(k, ".%")) && v == "0" {
+				delete(m, k)
+				continue
+			}
+			for _, i := range ignore {
+				if k == i || strings.HasPrefix(k, i+".") {
+					delete(m, k)
+					break
+				}
+			}
+		}
+	}
+
+	if !maps.Equal(attributes, actual) {
+		var diffs []string
+		keys := tfmaps.Keys(attributes)
+		for k := range actual {
+			if _, ok := attributes[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			if attributes[k] != actual[k] {
+				diffs = append(diffs, fmt.Sprintf("  %s: %q (state) != %q (imported)", k, attributes[k], actual[k]))
+			}
+		}
+		h.t.Fatalf("ImportStateVerify attributes not equivalent for %s (%s):\n%s", h.resourceType, id, strings.Join(diffs, "\n"))
+	}
+}
+
+// check runs f against a State containing the resource.
+func (h *fakeAWSHarness) check(step string, state *fakeAWSState, f resource.TestCheckFunc) {
+	h.t.Helper()
+
+	if f == nil {
+		return
+	}
+
+	attributes, err := fakeAWSFlatmap(state.Value)
+	if err != nil {
+		h.t.Fatal(err)
+	}
+
+	if err := f(h.terraformState(attributes)); err != nil {
+		h.t.Fatalf("Check failed after %s: %s", step, err)
+	}
+}
+
+func (h *fakeAWSHarness) terraformState(attributes map[string]string) *terraform.State {
+	state := terraform.NewState()
+	state.RootModule().Resources[h.resourceType+"."+fakeAWSResourceName] = &terraform.ResourceState{
+		Primary: &terraform.InstanceState{
+			Attributes: attributes,
+			ID:         attributes["id"],
+		},
+		Provider: "registry.terraform.io/hashicorp/aws",
+		Type:     h.resourceType,
+	}
+
+	return state
+}
+
+// diagnostics logs warnings and returns any errors.
+func (h *fakeAWSHarness) diagnostics(diags []*tfprotov5.Diagnostic) error {
+	for _, d := range diags {
+		if d.Severity == tfprotov5.DiagnosticSeverityWarning {
+			h.t.Logf("warning: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	return fakeAWSDiagnosticsError(diags)
+}
+
+func fakeAWSDiagnosticsError(diags []*tfprotov5.Diagnostic) error {
+	var errs []error
+
+	for _, d := range diags {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			errs = append(errs, fmt.Errorf("%s: %s", d.Summary, d.Detail))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// fakeAWSNormalizeBlocks replaces null list and set nested blocks with empty collections,
+// as Terraform does for configuration. If nullObject is true, a null v is first replaced
+// by an object whose attributes are all null.
+func fakeAWSNormalizeBlocks(block *tfprotov5.SchemaBlock, v tftypes.Value, nullObject bool) (tftypes.Value, error) {
+	typ := block.ValueType()
+
+	if v.IsNull() {
+		if !nullObject {
+			return v, nil
+		}
+
+		attributes := make(map[string]tftypes.Value)
+		for k, t := range typ.(tftypes.Object).AttributeTypes {
+			attributes[k] = tftypes.NewValue(t, nil)
+		}
+		v = tftypes.NewValue(typ, attributes)
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	for _, nested := range block.BlockTypes {
+		nestedType := nested.ValueType()
+		nv := attributes[nested.TypeName]
+
+		switch nested.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList, tfprotov5.SchemaNestedBlockNestingModeSet:
+			var elems []tftypes.Value
+			if !nv.IsNull() {
+				if err := nv.As(&elems); err != nil {
+					return tftypes.Value{}, err
+				}
+			}
+
+			for i, e := range elems {
+				e, err := fakeAWSNormalizeBlocks(nested.Block, e, false)
+				if err != nil {
+					return tftypes.Value{}, err
+				}
+				elems[i] = e
+			}
+
+			attributes[nested.TypeName] = tftypes.NewValue(nestedType, elems)
+		case tfprotov5.SchemaNestedBlockNestingModeSingle, tfprotov5.SchemaNestedBlockNestingModeGroup:
+			e, err := fakeAWSNormalizeBlocks(nested.Block, nv, nested.Nesting == tfprotov5.SchemaNestedBlockNestingModeGroup)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			attributes[nested.TypeName] = e
+		}
+	}
+
+	return tftypes.NewValue(typ, attributes), nil
+}
+
+// fakeAWSProposedNew is a simplified version of Terraform's proposed new state calculation:
+// configured values are taken from config and unconfigured computed values from prior.
+func fakeAWSProposedNew(block *tfprotov5.SchemaBlock, prior, config tftypes.Value) (tftypes.Value, error) {
+	if config.IsNull() || prior.IsNull() || !prior.IsKnown() {
+		return config, nil
+	}
+
+	var priorAttributes, configAttributes map[string]tftypes.Value
+	if err := prior.As(&priorAttributes); err != nil {
+		return tftypes.Value{}, err
+	}
+	if err := config.As(&configAttributes); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	attributes := make(map[string]tftypes.Value, len(configAttributes))
+	for _, attr := range block.Attributes {
+		if v := configAttributes[attr.Name]; attr.Computed && v.IsNull() {
+			attributes[attr.Name] = priorAttributes[attr.Name]
+		} else {
+			attributes[attr.Name] = v
+		}
+	}
+
+	for _, nested := range block.BlockTypes {
+		pv, cv := priorAttributes[nested.TypeName], configAttributes[nested.TypeName]
+
+		switch nested.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList:
+			var priorElems, configElems []tftypes.Value
+			if !pv.IsNull() && pv.IsKnown() {
+				if err := pv.As(&priorElems); err != nil {
+					return tftypes.Value{}, err
+				}
+			}
+			if !cv.IsNull() {
+				if err := cv.As(&configElems); err != nil {
+					return tftypes.Value{}, err
+				}
+			}
+
+			if configElems == nil {
+				attributes[nested.TypeName] = cv
+				continue
+			}
+
+			elems := make([]tftypes.Value, len(configElems))
+			for i, e := range configElems {
+				if i < len(priorElems) {
+					var err error
+					e, err = fakeAWSProposedNew(nested.Block, priorElems[i], e)
+					if err != nil {
+						return tftypes.Value{}, err
+					}
+				}
+				elems[i] = e
+			}
+
+			attributes[nested.TypeName] = tftypes.NewValue(nested.ValueType(), elems)
+		case tfprotov5.SchemaNestedBlockNestingModeSingle, tfprotov5.SchemaNestedBlockNestingModeGroup:
+			v, err := fakeAWSProposedNew(nested.Block, pv, cv)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			attributes[nested.TypeName] = v
+		default:
+			attributes[nested.TypeName] = cv
+		}
+	}
+
+	return tftypes.NewValue(block.ValueType(), attributes), nil
+}
+
+// fakeAWSFlatmap converts a resource's state to the flatmap representation used in terraform.State.
+func fakeAWSFlatmap(v tftypes.Value) (map[string]string, error) {
+	attributes := make(map[string]string)
+
+	if v.IsNull() {
+		return attributes, nil
+	}
+
+	var m map[string]tftypes.Value
+	if err := v.As(&m); err != nil {
+		return nil, err
+	}
+
+	for k, v := range m {
+		if err := fakeAWSFlatmapValue(attributes, k, v); err != nil {
+			return nil, err
+		}
+	}
+
+	return attributes, nil
+}
+
+func fakeAWSFlatmapValue(attributes map[string]string, prefix string, v tftypes.Value) error {
+	if v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+
+	typ := v.Type()
+
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		if err := v.As(&s); err != nil {
+			return err
+		}
+		attributes[prefix] = s
+	case typ.Is(tftypes.Number):
+		n := new(big.Float)
+		if err := v.As(&n); err != nil {
+			return err
+		}
+		attributes[prefix] = n.Text('f', -1)
+	case typ.Is(tftypes.Bool):
+		var b bool
+		if err := v.As(&b); err != nil {
+			return err
+		}
+		attributes[prefix] = strconv.FormatBool(b)
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return err
+		}
+		attributes[prefix+".#"] = strconv.Itoa(len(elems))
+		for i, e := range elems {
+			if err := fakeAWSFlatmapValue(attributes, prefix+"."+strconv.Itoa(i), e); err != nil {
+				return err
+			}
+		}
+	case typ.Is(tftypes.Map{}):
+		var elems map[string]tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return err
+		}
+		attributes[prefix+".%"] = strconv.Itoa(len(elems))
+		for k, e := range elems {
+			if err := fakeAWSFlatmapValue(attributes, prefix+"."+k, e); err != nil {
+				return err
+			}
+		}
+	case typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return err
+		}
+		for k, e := range elems {
+			if err := fakeAWSFlatmapValue(attributes, prefix+"."+k, e); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported type at %s: %s", prefix, typ)
+	}
+
+	return nil
+}
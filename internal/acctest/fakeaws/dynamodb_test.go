@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDynamoDBTable(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.New()
+	conn := dynamodb.NewFromConfig(testConfig(server), func(o *dynamodb.Options) {
+		o.BaseEndpoint = aws.String(server.Endpoints()[names.DynamoDB])
+	})
+	tableName := "fakeaws"
+
+	if _, err := conn.CreateTable(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: []awstypes.AttributeDefinition{
+			{AttributeName: aws.String("pk"), AttributeType: awstypes.ScalarAttributeTypeS},
+		},
+		BillingMode: awstypes.BillingModePayPerRequest,
+		KeySchema: []awstypes.KeySchemaElement{
+			{AttributeName: aws.String("pk"), KeyType: awstypes.KeyTypeHash},
+		},
+		TableName: aws.String(tableName),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	output, err := conn.DescribeTable(ctx, &dynamodb.DescribeTableInput{
+		TableName: aws.String(tableName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := output.Table.TableStatus, awstypes.TableStatusActive; got != want {
+		t.Errorf("TableStatus = %q, want %q", got, want)
+	}
+
+	if _, err := conn.PutItem(ctx, &dynamodb.PutItemInput{
+		Item: map[string]awstypes.AttributeValue{
+			"pk":    &awstypes.AttributeValueMemberS{Value: "k1"},
+			"value": &awstypes.AttributeValueMemberN{Value: "42"},
+		},
+		TableName: aws.String(tableName),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := conn.GetItem(ctx, &dynamodb.GetItemInput{
+		Key: map[string]awstypes.AttributeValue{
+			"pk": &awstypes.AttributeValueMemberS{Value: "k1"},
+		},
+		TableName: aws.String(tableName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, ok := item.Item["value"].(*awstypes.AttributeValueMemberN); !ok || v.Value != "42" {
+		t.Errorf("item value = %#v, want 42", item.Item["value"])
+	}
+
+	if _, err := conn.UpdateTable(ctx, &dynamodb.UpdateTableInput{
+		DeletionProtectionEnabled: aws.Bool(true),
+		TableName:                 aws.String(tableName),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = conn.DeleteTable(ctx, &dynamodb.DeleteTableInput{
+		TableName: aws.String(tableName),
+	})
+	if !errs.IsA[*awstypes.ResourceInUseException](err) {
+		t.Errorf("DeleteTable (protected) error = %v, want ResourceInUseException", err)
+	}
+
+	if _, err := conn.UpdateTable(ctx, &dynamodb.UpdateTableInput{
+		DeletionProtectionEnabled: aws.Bool(false),
+		TableName:                 aws.String(tableName),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := conn.DeleteTable(ctx, &dynamodb.DeleteTableInput{
+		TableName: aws.String(tableName),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = conn.DescribeTable(ctx, &dynamodb.DescribeTableInput{
+		TableName: aws.String(tableName),
+	})
+	if !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		t.Errorf("DescribeTable (deleted) error = %v, want ResourceNotFoundException", err)
+	}
+}
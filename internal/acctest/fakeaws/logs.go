@@ -0,0 +1,563 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// logsService is a stand-in for Amazon CloudWatch Logs log groups and log streams.
+type logsService struct {
+	server    *Server
+	logGroups map[string]*logsLogGroup
+}
+
+type logsLogGroup struct {
+	name            string
+	arn             string
+	creationTime    time.Time
+	indexPolicy     *logsIndexPolicy
+	kmsKeyID        string
+	logGroupClass   string
+	retentionInDays int32
+	logStreams      map[string]*logsLogStream
+}
+
+type logsLogStream struct {
+	name         string
+	arn          string
+	creationTime time.Time
+	events       []logsOutputLogEvent
+}
+
+func newLogsService(server *Server) *logsService {
+	return &logsService{
+		server:    server,
+		logGroups: make(map[string]*logsLogGroup),
+	}
+}
+
+func (svc *logsService) handler() *jsonHandler {
+	return &jsonHandler{
+		version:      "1.1",
+		targetPrefix: "Logs_20140328",
+		operations: map[string]jsonOperation{
+			"AssociateKmsKey":       jsonOp(svc.associateKMSKey),
+			"CreateLogGroup":        jsonOp(svc.createLogGroup),
+			"CreateLogStream":       jsonOp(svc.createLogStream),
+			"DeleteIndexPolicy":     jsonOp(svc.deleteIndexPolicy),
+			"DeleteLogGroup":        jsonOp(svc.deleteLogGroup),
+			"DeleteLogStream":       jsonOp(svc.deleteLogStream),
+			"DeleteRetentionPolicy": jsonOp(svc.deleteRetentionPolicy),
+			"DescribeIndexPolicies": jsonOp(svc.describeIndexPolicies),
+			"DescribeLogGroups":     jsonOp(svc.describeLogGroups),
+			"DescribeLogStreams":    jsonOp(svc.describeLogStreams),
+			"DisassociateKmsKey":    jsonOp(svc.disassociateKMSKey),
+			"GetLogEvents":          jsonOp(svc.getLogEvents),
+			"ListTagsForResource":   jsonOp(svc.listTagsForResource),
+			"PutIndexPolicy":        jsonOp(svc.putIndexPolicy),
+			"PutLogEvents":          jsonOp(svc.putLogEvents),
+			"PutRetentionPolicy":    jsonOp(svc.putRetentionPolicy),
+			"TagResource":           jsonOp(svc.tagResource),
+			"UntagResource":         jsonOp(svc.untagResource),
+		},
+	}
+}
+
+func logsResourceNotFoundError(format string, a ...any) *apiError {
+	return newAPIError("ResourceNotFoundException", format, a...)
+}
+
+func (svc *logsService) findLogGroup(name string) (*logsLogGroup, error) {
+	lg, ok := svc.logGroups[name]
+	if !ok {
+		return nil, logsResourceNotFoundError("The specified log group does not exist.")
+	}
+
+	return lg, nil
+}
+
+// findLogGroupByIdentifier finds a log group by name or ARN.
+func (svc *logsService) findLogGroupByIdentifier(identifier string) (*logsLogGroup, error) {
+	for _, lg := range svc.logGroups {
+		if lg.arn == identifier {
+			return lg, nil
+		}
+	}
+
+	return svc.findLogGroup(identifier)
+}
+
+type logsCreateLogGroupInput struct {
+	LogGroupName  string            `json:"logGroupName"`
+	KmsKeyId      string            `json:"kmsKeyId"`
+	LogGroupClass string            `json:"logGroupClass"`
+	Tags          map[string]string `json:"tags"`
+}
+
+func (svc *logsService) createLogGroup(input *logsCreateLogGroupInput) (any, error) {
+	if input.LogGroupName == "" {
+		return nil, newAPIError("InvalidParameterException", "logGroupName is required")
+	}
+
+	if _, ok := svc.logGroups[input.LogGroupName]; ok {
+		return nil, newAPIError("ResourceAlreadyExistsException", "The specified log group already exists")
+	}
+
+	lg := &logsLogGroup{
+		name:          input.LogGroupName,
+		arn:           svc.server.arn("logs", "log-group:"+input.LogGroupName),
+		creationTime:  time.Now(),
+		kmsKeyID:      input.KmsKeyId,
+		logGroupClass: input.LogGroupClass,
+		logStreams:    make(map[string]*logsLogStream),
+	}
+	if lg.logGroupClass == "" {
+		lg.logGroupClass = "STANDARD"
+	}
+	svc.logGroups[lg.name] = lg
+	svc.server.tagResource(lg.arn, input.Tags)
+
+	return nil, nil
+}
+
+type logsLogGroupNameInput struct {
+	LogGroupName string `json:"logGroupName"`
+}
+
+func (svc *logsService) deleteLogGroup(input *logsLogGroupNameInput) (any, error) {
+	lg, err := svc.findLogGroup(input.LogGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(svc.logGroups, lg.name)
+	svc.server.deleteTags(lg.arn)
+
+	return nil, nil
+}
+
+type logsPutRetentionPolicyInput struct {
+	LogGroupName    string `json:"logGroupName"`
+	RetentionInDays int32  `json:"retentionInDays"`
+}
+
+var logsValidRetentionInDays = []int32{1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653}
+
+func (svc *logsService) putRetentionPolicy(input *logsPutRetentionPolicyInput) (any, error) {
+	lg, err := svc.findLogGroup(input.LogGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(logsValidRetentionInDays, input.RetentionInDays) {
+		return nil, newAPIError("InvalidParameterException", "invalid retentionInDays: %d", input.RetentionInDays)
+	}
+
+	lg.retentionInDays = input.RetentionInDays
+
+	return nil, nil
+}
+
+func (svc *logsService) deleteRetentionPolicy(input *logsLogGroupNameInput) (any, error) {
+	lg, err := svc.findLogGroup(input.LogGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	lg.retentionInDays = 0
+
+	return nil, nil
+}
+
+type logsAssociateKMSKeyInput struct {
+	LogGroupName string `json:"logGroupName"`
+	KmsKeyId     string `json:"kmsKeyId"`
+}
+
+func (svc *logsService) associateKMSKey(input *logsAssociateKMSKeyInput) (any, error) {
+	lg, err := svc.findLogGroup(input.LogGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	lg.kmsKeyID = input.KmsKeyId
+
+	return nil, nil
+}
+
+func (svc *logsService) disassociateKMSKey(input *logsLogGroupNameInput) (any, error) {
+	lg, err := svc.findLogGroup(input.LogGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	lg.kmsKeyID = ""
+
+	return nil, nil
+}
+
+// logsIndexPolicy is a log group-level field index policy.
+type logsIndexPolicy struct {
+	LastUpdateTime     int64  `json:"lastUpdateTime"`
+	LogGroupIdentifier string `json:"logGroupIdentifier"`
+	PolicyDocument     string `json:"policyDocument"`
+	Source             string `json:"source"`
+}
+
+type logsPutIndexPolicyInput struct {
+	LogGroupIdentifier string `json:"logGroupIdentifier"`
+	PolicyDocument     string `json:"policyDocument"`
+}
+
+func (svc *logsService) putIndexPolicy(input *logsPutIndexPolicyInput) (any, error) {
+	lg, err := svc.findLogGroupByIdentifier(input.LogGroupIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.PolicyDocument == "" {
+		return nil, newAPIError("InvalidParameterException", "policyDocument is required")
+	}
+
+	lg.indexPolicy = &logsIndexPolicy{
+		LastUpdateTime:     time.Now().UnixMilli(),
+		LogGroupIdentifier: lg.arn,
+		PolicyDocument:     input.PolicyDocument,
+		Source:             "LOG_GROUP",
+	}
+
+	return map[string]any{
+		"indexPolicy": lg.indexPolicy,
+	}, nil
+}
+
+type logsDescribeIndexPoliciesInput struct {
+	LogGroupIdentifiers []string `json:"logGroupIdentifiers"`
+	NextToken           string   `json:"nextToken"`
+}
+
+func (svc *logsService) describeIndexPolicies(input *logsDescribeIndexPoliciesInput) (any, error) {
+	if len(input.LogGroupIdentifiers) != 1 {
+		return nil, newAPIError("InvalidParameterException", "logGroupIdentifiers must contain exactly one log group")
+	}
+
+	lg, err := svc.findLogGroupByIdentifier(input.LogGroupIdentifiers[0])
+	if err != nil {
+		return nil, err
+	}
+
+	indexPolicies := make([]*logsIndexPolicy, 0)
+	if lg.indexPolicy != nil {
+		indexPolicies = append(indexPolicies, lg.indexPolicy)
+	}
+
+	return map[string]any{
+		"indexPolicies": indexPolicies,
+	}, nil
+}
+
+type logsLogGroupIdentifierInput struct {
+	LogGroupIdentifier string `json:"logGroupIdentifier"`
+}
+
+func (svc *logsService) deleteIndexPolicy(input *logsLogGroupIdentifierInput) (any, error) {
+	lg, err := svc.findLogGroupByIdentifier(input.LogGroupIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if lg.indexPolicy == nil {
+		return nil, logsResourceNotFoundError("The specified index policy does not exist.")
+	}
+
+	lg.indexPolicy = nil
+
+	return nil, nil
+}
+
+type logsDescribeLogGroupsInput struct {
+	LogGroupNamePrefix  string `json:"logGroupNamePrefix"`
+	LogGroupNamePattern string `json:"logGroupNamePattern"`
+	Limit               int    `json:"limit"`
+	NextToken           string `json:"nextToken"`
+}
+
+type logsLogGroupOutput struct {
+	Arn               string `json:"arn"`
+	CreationTime      int64  `json:"creationTime"`
+	KmsKeyId          string `json:"kmsKeyId,omitempty"`
+	LogGroupArn       string `json:"logGroupArn"`
+	LogGroupClass     string `json:"logGroupClass"`
+	LogGroupName      string `json:"logGroupName"`
+	MetricFilterCount int32  `json:"metricFilterCount"`
+	RetentionInDays   *int32 `json:"retentionInDays,omitempty"`
+	StoredBytes       int64  `json:"storedBytes"`
+}
+
+func (svc *logsService) describeLogGroups(input *logsDescribeLogGroupsInput) (any, error) {
+	var logGroups []logsLogGroupOutput
+
+	for _, name := range sortedKeys(svc.logGroups) {
+		if !strings.HasPrefix(name, input.LogGroupNamePrefix) || !strings.Contains(name, input.LogGroupNamePattern) {
+			continue
+		}
+
+		lg := svc.logGroups[name]
+		v := logsLogGroupOutput{
+			Arn:           lg.arn + ":*",
+			CreationTime:  lg.creationTime.UnixMilli(),
+			KmsKeyId:      lg.kmsKeyID,
+			LogGroupArn:   lg.arn,
+			LogGroupClass: lg.logGroupClass,
+			LogGroupName:  lg.name,
+		}
+		if lg.retentionInDays != 0 {
+			v.RetentionInDays = &lg.retentionInDays
+		}
+		logGroups = append(logGroups, v)
+	}
+
+	page, nextToken, err := paginate(logGroups, input.NextToken, input.Limit, 50)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"logGroups": page,
+		"nextToken": omitEmpty(nextToken),
+	}, nil
+}
+
+type logsLogStreamInput struct {
+	LogGroupName  string `json:"logGroupName"`
+	LogStreamName string `json:"logStreamName"`
+}
+
+func (svc *logsService) createLogStream(input *logsLogStreamInput) (any, error) {
+	lg, err := svc.findLogGroup(input.LogGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := lg.logStreams[input.LogStreamName]; ok {
+		return nil, newAPIError("ResourceAlreadyExistsException", "The specified log stream already exists")
+	}
+
+	lg.logStreams[input.LogStreamName] = &logsLogStream{
+		name:         input.LogStreamName,
+		arn:          lg.arn + ":log-stream:" + input.LogStreamName,
+		creationTime: time.Now(),
+	}
+
+	return nil, nil
+}
+
+func (svc *logsService) findLogStream(logGroupName, logStreamName string) (*logsLogStream, error) {
+	lg, err := svc.findLogGroup(logGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	ls, ok := lg.logStreams[logStreamName]
+	if !ok {
+		return nil, logsResourceNotFoundError("The specified log stream does not exist.")
+	}
+
+	return ls, nil
+}
+
+func (svc *logsService) deleteLogStream(input *logsLogStreamInput) (any, error) {
+	ls, err := svc.findLogStream(input.LogGroupName, input.LogStreamName)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(svc.logGroups[input.LogGroupName].logStreams, ls.name)
+
+	return nil, nil
+}
+
+type logsDescribeLogStreamsInput struct {
+	LogGroupName        string `json:"logGroupName"`
+	LogStreamNamePrefix string `json:"logStreamNamePrefix"`
+	Limit               int    `json:"limit"`
+	NextToken           string `json:"nextToken"`
+}
+
+type logsLogStreamOutput struct {
+	Arn                 string `json:"arn"`
+	CreationTime        int64  `json:"creationTime"`
+	FirstEventTimestamp int64  `json:"firstEventTimestamp,omitempty"`
+	LastEventTimestamp  int64  `json:"lastEventTimestamp,omitempty"`
+	LogStreamName       string `json:"logStreamName"`
+}
+
+func (svc *logsService) describeLogStreams(input *logsDescribeLogStreamsInput) (any, error) {
+	lg, err := svc.findLogGroup(input.LogGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	var logStreams []logsLogStreamOutput
+
+	for _, name := range sortedKeys(lg.logStreams) {
+		if !strings.HasPrefix(name, input.LogStreamNamePrefix) {
+			continue
+		}
+
+		ls := lg.logStreams[name]
+		v := logsLogStreamOutput{
+			Arn:           ls.arn,
+			CreationTime:  ls.creationTime.UnixMilli(),
+			LogStreamName: ls.name,
+		}
+		if n := len(ls.events); n > 0 {
+			v.FirstEventTimestamp = ls.events[0].Timestamp
+			v.LastEventTimestamp = ls.events[n-1].Timestamp
+		}
+		logStreams = append(logStreams, v)
+	}
+
+	page, nextToken, err := paginate(logStreams, input.NextToken, input.Limit, 50)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"logStreams": page,
+		"nextToken":  omitEmpty(nextToken),
+	}, nil
+}
+
+type logsPutLogEventsInput struct {
+	LogGroupName  string `json:"logGroupName"`
+	LogStreamName string `json:"logStreamName"`
+	LogEvents     []struct {
+		Message   string `json:"message"`
+		Timestamp int64  `json:"timestamp"`
+	} `json:"logEvents"`
+}
+
+type logsOutputLogEvent struct {
+	IngestionTime int64  `json:"ingestionTime"`
+	Message       string `json:"message"`
+	Timestamp     int64  `json:"timestamp"`
+}
+
+func (svc *logsService) putLogEvents(input *logsPutLogEventsInput) (any, error) {
+	ls, err := svc.findLogStream(input.LogGroupName, input.LogStreamName)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixMilli()
+	for _, v := range input.LogEvents {
+		ls.events = append(ls.events, logsOutputLogEvent{
+			IngestionTime: now,
+			Message:       v.Message,
+			Timestamp:     v.Timestamp,
+		})
+	}
+	slices.SortStableFunc(ls.events, func(a, b logsOutputLogEvent) int {
+		return cmp.Compare(a.Timestamp, b.Timestamp)
+	})
+
+	return nil, nil
+}
+
+type logsGetLogEventsInput struct {
+	LogGroupName  string `json:"logGroupName"`
+	LogStreamName string `json:"logStreamName"`
+	Limit         int    `json:"limit"`
+	NextToken     string `json:"nextToken"`
+}
+
+func (svc *logsService) getLogEvents(input *logsGetLogEventsInput) (any, error) {
+	ls, err := svc.findLogStream(input.LogGroupName, input.LogStreamName)
+	if err != nil {
+		return nil, err
+	}
+
+	page, nextToken, err := paginate(ls.events, input.NextToken, input.Limit, 10000)
+	if err != nil {
+		return nil, err
+	}
+
+	// GetLogEvents always returns a forward token; the end of the stream is reached when it is unchanged.
+	if nextToken == "" {
+		nextToken = input.NextToken
+		if nextToken == "" {
+			nextToken = "0"
+		}
+	}
+
+	return map[string]any{
+		"events":            page,
+		"nextForwardToken":  nextToken,
+		"nextBackwardToken": "0",
+	}, nil
+}
+
+type logsListTagsForResourceInput struct {
+	ResourceArn string `json:"resourceArn"`
+}
+
+func (svc *logsService) findARN(arn string) (string, error) {
+	arn = strings.TrimSuffix(arn, ":*")
+
+	for _, lg := range svc.logGroups {
+		if lg.arn == arn {
+			return arn, nil
+		}
+	}
+
+	return "", logsResourceNotFoundError("The specified resource does not exist.")
+}
+
+func (svc *logsService) listTagsForResource(input *logsListTagsForResourceInput) (any, error) {
+	arn, err := svc.findARN(input.ResourceArn)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"tags": svc.server.listTags(arn),
+	}, nil
+}
+
+type logsTagResourceInput struct {
+	ResourceArn string            `json:"resourceArn"`
+	Tags        map[string]string `json:"tags"`
+}
+
+func (svc *logsService) tagResource(input *logsTagResourceInput) (any, error) {
+	arn, err := svc.findARN(input.ResourceArn)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.server.tagResource(arn, input.Tags)
+
+	return nil, nil
+}
+
+type logsUntagResourceInput struct {
+	ResourceArn string   `json:"resourceArn"`
+	TagKeys     []string `json:"tagKeys"`
+}
+
+func (svc *logsService) untagResource(input *logsUntagResourceInput) (any, error) {
+	arn, err := svc.findARN(input.ResourceArn)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.server.untagResource(arn, input.TagKeys)
+
+	return nil, nil
+}
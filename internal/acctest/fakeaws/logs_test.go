@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestLogsLogGroup(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.New()
+	conn := cloudwatchlogs.NewFromConfig(testConfig(server), func(o *cloudwatchlogs.Options) {
+		o.BaseEndpoint = aws.String(server.Endpoints()[names.Logs])
+	})
+	name := "/fakeaws/test"
+
+	if _, err := conn.CreateLogGroup(ctx, &cloudwatchlogs.CreateLogGroupInput{
+		LogGroupName: aws.String(name),
+		Tags:         map[string]string{"key1": "value1"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := conn.CreateLogGroup(ctx, &cloudwatchlogs.CreateLogGroupInput{
+		LogGroupName: aws.String(name),
+	})
+	if !errs.IsA[*awstypes.ResourceAlreadyExistsException](err) {
+		t.Errorf("CreateLogGroup (duplicate) error = %v, want ResourceAlreadyExistsException", err)
+	}
+
+	if _, err := conn.PutRetentionPolicy(ctx, &cloudwatchlogs.PutRetentionPolicyInput{
+		LogGroupName:    aws.String(name),
+		RetentionInDays: aws.Int32(7),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	output, err := conn.DescribeLogGroups(ctx, &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: aws.String("/fakeaws/"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(output.LogGroups), 1; got != want {
+		t.Fatalf("len(LogGroups) = %d, want %d", got, want)
+	}
+	lg := output.LogGroups[0]
+	if got, want := aws.ToInt32(lg.RetentionInDays), int32(7); got != want {
+		t.Errorf("RetentionInDays = %d, want %d", got, want)
+	}
+	if got, want := aws.ToString(lg.Arn), "arn:aws:logs:us-west-2:123456789012:log-group:/fakeaws/test:*"; got != want { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("Arn = %q, want %q", got, want)
+	}
+
+	if _, err := conn.TagResource(ctx, &cloudwatchlogs.TagResourceInput{
+		ResourceArn: lg.LogGroupArn,
+		Tags:        map[string]string{"key2": "value2"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	tags, err := conn.ListTagsForResource(ctx, &cloudwatchlogs.ListTagsForResourceInput{
+		ResourceArn: lg.LogGroupArn,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(tags.Tags, map[string]string{"key1": "value1", "key2": "value2"}); diff != "" {
+		t.Errorf("unexpected tags diff (+wanted, -got): %s", diff)
+	}
+
+	if _, err := conn.DeleteLogGroup(ctx, &cloudwatchlogs.DeleteLogGroupInput{
+		LogGroupName: aws.String(name),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = conn.DeleteLogGroup(ctx, &cloudwatchlogs.DeleteLogGroupInput{
+		LogGroupName: aws.String(name),
+	})
+	if !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		t.Errorf("DeleteLogGroup (deleted) error = %v, want ResourceNotFoundException", err)
+	}
+}
+
+func TestLogsIndexPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.New()
+	conn := cloudwatchlogs.NewFromConfig(testConfig(server), func(o *cloudwatchlogs.Options) {
+		o.BaseEndpoint = aws.String(server.Endpoints()[names.Logs])
+	})
+	name := "/fakeaws/test"
+
+	if _, err := conn.CreateLogGroup(ctx, &cloudwatchlogs.CreateLogGroupInput{
+		LogGroupName: aws.String(name),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	document := `{"Fields":["eventName"]}`
+	if _, err := conn.PutIndexPolicy(ctx, &cloudwatchlogs.PutIndexPolicyInput{
+		LogGroupIdentifier: aws.String(name),
+		PolicyDocument:     aws.String(document),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	output, err := conn.DescribeIndexPolicies(ctx, &cloudwatchlogs.DescribeIndexPoliciesInput{
+		LogGroupIdentifiers: []string{name},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(output.IndexPolicies), 1; got != want {
+		t.Fatalf("len(IndexPolicies) = %d, want %d", got, want)
+	}
+	if got, want := aws.ToString(output.IndexPolicies[0].PolicyDocument), document; got != want {
+		t.Errorf("PolicyDocument = %q, want %q", got, want)
+	}
+	if got, want := output.IndexPolicies[0].Source, awstypes.IndexSourceLogGroup; got != want {
+		t.Errorf("Source = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteIndexPolicy(ctx, &cloudwatchlogs.DeleteIndexPolicyInput{
+		LogGroupIdentifier: output.IndexPolicies[0].LogGroupIdentifier,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = conn.DeleteIndexPolicy(ctx, &cloudwatchlogs.DeleteIndexPolicyInput{
+		LogGroupIdentifier: aws.String(name),
+	})
+	if !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		t.Errorf("DeleteIndexPolicy (deleted) error = %v, want ResourceNotFoundException", err)
+	}
+}
+
+func TestLogsLogEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.New()
+	conn := cloudwatchlogs.NewFromConfig(testConfig(server), func(o *cloudwatchlogs.Options) {
+		o.BaseEndpoint = aws.String(server.Endpoints()[names.Logs])
+	})
+	logGroupName, logStreamName := "fakeaws", "stream"
+
+	if _, err := conn.CreateLogGroup(ctx, &cloudwatchlogs.CreateLogGroupInput{
+		LogGroupName: aws.String(logGroupName),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := conn.CreateLogStream(ctx, &cloudwatchlogs.CreateLogStreamInput{
+		LogGroupName:  aws.String(logGroupName),
+		LogStreamName: aws.String(logStreamName),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := conn.PutLogEvents(ctx, &cloudwatchlogs.PutLogEventsInput{
+		LogEvents: []awstypes.InputLogEvent{
+			{Message: aws.String("second"), Timestamp: aws.Int64(2)},
+			{Message: aws.String("first"), Timestamp: aws.Int64(1)},
+		},
+		LogGroupName:  aws.String(logGroupName),
+		LogStreamName: aws.String(logStreamName),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	output, err := conn.GetLogEvents(ctx, &cloudwatchlogs.GetLogEventsInput{
+		LogGroupName:  aws.String(logGroupName),
+		LogStreamName: aws.String(logStreamName),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, v := range output.Events {
+		got = append(got, aws.ToString(v.Message))
+	}
+
+	if diff := cmp.Diff(got, []string{"first", "second"}); diff != "" {
+		t.Errorf("unexpected events diff (+wanted, -got): %s", diff)
+	}
+}
@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+// apiError is an AWS API error returned by a fake service operation.
+type apiError struct {
+	statusCode int
+	code       string
+	message    string
+	// queryErrorCode is the legacy AWS Query protocol error code returned by
+	// services that have migrated to the AWS JSON protocol (e.g. Amazon SQS).
+	queryErrorCode string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s: %s", e.code, e.message)
+}
+
+func newAPIError(code, format string, a ...any) *apiError {
+	return &apiError{
+		statusCode: http.StatusBadRequest,
+		code:       code,
+		message:    fmt.Sprintf(format, a...),
+	}
+}
+
+func validationError(format string, a ...any) *apiError {
+	return newAPIError("ValidationException", format, a...)
+}
+
+// jsonOperation handles a single AWS JSON protocol operation.
+// The returned value is marshaled as the operation's response body.
+type jsonOperation func(body []byte) (any, error)
+
+// jsonOp returns a jsonOperation that unmarshals the request body into a new T before calling f.
+func jsonOp[T any](f func(*T) (any, error)) jsonOperation {
+	return func(body []byte) (any, error) {
+		var input T
+
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &input); err != nil {
+				return nil, newAPIError("SerializationException", "%s", err)
+			}
+		}
+
+		return f(&input)
+	}
+}
+
+// jsonHandler serves an AWS JSON 1.0 or 1.1 protocol service, dispatching on the X-Amz-Target header.
+type jsonHandler struct {
+	version      string
+	targetPrefix string
+	operations   map[string]jsonOperation
+}
+
+func (h *jsonHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	contentType := "application/x-amz-json-" + h.version
+
+	operationName, ok := strings.CutPrefix(r.Header.Get("X-Amz-Target"), h.targetPrefix+".")
+	operation, found := h.operations[operationName]
+	if !ok || !found {
+		writeJSONError(w, contentType, newAPIError("UnknownOperationException", "operation %q is not supported", r.Header.Get("X-Amz-Target")))
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeJSONError(w, contentType, newAPIError("SerializationException", "%s", err))
+		return
+	}
+
+	output, err := operation(body)
+	if err != nil {
+		writeJSONError(w, contentType, err)
+		return
+	}
+
+	if output == nil {
+		output = struct{}{}
+	}
+
+	b, err := json.Marshal(output)
+	if err != nil {
+		writeJSONError(w, contentType, &apiError{statusCode: http.StatusInternalServerError, code: "InternalFailure", message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(b)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(b)
+}
+
+func writeJSONError(w http.ResponseWriter, contentType string, err error) {
+	apiErr, ok := err.(*apiError)
+	if !ok {
+		apiErr = &apiError{statusCode: http.StatusInternalServerError, code: "InternalFailure", message: err.Error()}
+	}
+
+	b, _ := json.Marshal(map[string]string{
+		"__type":  apiErr.code,
+		"message": apiErr.message,
+	})
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(b)))
+	w.Header().Set("X-Amzn-Errortype", apiErr.code)
+	if apiErr.queryErrorCode != "" {
+		w.Header().Set("X-Amzn-Query-Error", apiErr.queryErrorCode+";Sender")
+	}
+	w.WriteHeader(apiErr.statusCode)
+	_, _ = w.Write(b)
+}
+
+// queryOperation handles a single AWS Query protocol operation.
+// The returned value is marshaled as the operation's <ActionResult> element.
+type queryOperation func(form url.Values) (any, error)
+
+// queryHandler serves an AWS Query protocol service, dispatching on the Action parameter.
+type queryHandler struct {
+	xmlns      string
+	operations map[string]queryOperation
+}
+
+func (h *queryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		h.writeError(w, newAPIError("MalformedQueryString", "%s", err))
+		return
+	}
+
+	action := r.Form.Get("Action")
+	operation, ok := h.operations[action]
+	if !ok {
+		h.writeError(w, newAPIError("InvalidAction", "action %q is not supported", action))
+		return
+	}
+
+	output, err := operation(r.Form)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<%sResponse xmlns="%s">`, action, h.xmlns)
+	if output != nil {
+		enc := xml.NewEncoder(&b)
+		if err := enc.EncodeElement(output, xml.StartElement{Name: xml.Name{Local: action + "Result"}}); err != nil {
+			h.writeError(w, &apiError{statusCode: http.StatusInternalServerError, code: "InternalFailure", message: err.Error()})
+			return
+		}
+	}
+	fmt.Fprintf(&b, `<ResponseMetadata><RequestId>%s</RequestId></ResponseMetadata></%sResponse>`, w.Header().Get("X-Amzn-Requestid"), action)
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.Header().Set("Content-Length", strconv.Itoa(b.Len()))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(b.Bytes())
+}
+
+func (h *queryHandler) writeError(w http.ResponseWriter, err error) {
+	apiErr, ok := err.(*apiError)
+	if !ok {
+		apiErr = &apiError{statusCode: http.StatusInternalServerError, code: "InternalFailure", message: err.Error()}
+	}
+
+	typ := "Sender"
+	if apiErr.statusCode >= http.StatusInternalServerError {
+		typ = "Receiver"
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<ErrorResponse xmlns="%s"><Error><Type>%s</Type><Code>%s</Code><Message>`, h.xmlns, typ, apiErr.code)
+	_ = xml.EscapeText(&b, []byte(apiErr.message))
+	fmt.Fprintf(&b, `</Message></Error><RequestId>%s</RequestId></ErrorResponse>`, w.Header().Get("X-Amzn-Requestid"))
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.Header().Set("Content-Length", strconv.Itoa(b.Len()))
+	w.WriteHeader(apiErr.statusCode)
+	_, _ = w.Write(b.Bytes())
+}
+
+// queryStringMap returns the map serialized under prefix as <prefix>.entry.N.key and <prefix>.entry.N.value.
+func queryStringMap(form url.Values, prefix string) map[string]string {
+	m := make(map[string]string)
+
+	for i := 1; ; i++ {
+		k := fmt.Sprintf("%s.entry.%d.key", prefix, i)
+		if !form.Has(k) {
+			break
+		}
+		m[form.Get(k)] = form.Get(fmt.Sprintf("%s.entry.%d.value", prefix, i))
+	}
+
+	return m
+}
+
+// queryTags returns the tags serialized under prefix as <prefix>.member.N.Key and <prefix>.member.N.Value.
+func queryTags(form url.Values, prefix string) map[string]string {
+	m := make(map[string]string)
+
+	for i := 1; ; i++ {
+		k := fmt.Sprintf("%s.member.%d.Key", prefix, i)
+		if !form.Has(k) {
+			break
+		}
+		m[form.Get(k)] = form.Get(fmt.Sprintf("%s.member.%d.Value", prefix, i))
+	}
+
+	return m
+}
+
+// queryStrings returns the list serialized under prefix as <prefix>.member.N.
+func queryStrings(form url.Values, prefix string) []string {
+	var s []string
+
+	for i := 1; ; i++ {
+		k := fmt.Sprintf("%s.member.%d", prefix, i)
+		if !form.Has(k) {
+			break
+		}
+		s = append(s, form.Get(k))
+	}
+
+	return s
+}
+
+// queryTag is the AWS Query protocol XML representation of a resource tag.
+type queryTag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+func queryTagList(tags map[string]string) []queryTag {
+	s := make([]queryTag, 0, len(tags))
+
+	for _, k := range sortedKeys(tags) {
+		s = append(s, queryTag{Key: k, Value: tags[k]})
+	}
+
+	return s
+}
+
+// tagKeyValue is the AWS JSON protocol representation of a resource tag used by most services.
+type tagKeyValue struct {
+	Key   string `json:"Key"`
+	Value string `json:"Value"`
+}
+
+func tagsFromKeyValues(s []tagKeyValue) map[string]string {
+	m := make(map[string]string, len(s))
+
+	for _, v := range s {
+		m[v.Key] = v.Value
+	}
+
+	return m
+}
+
+func tagKeyValues(tags map[string]string) []tagKeyValue {
+	s := make([]tagKeyValue, 0, len(tags))
+
+	for _, k := range sortedKeys(tags) {
+		s = append(s, tagKeyValue{Key: k, Value: tags[k]})
+	}
+
+	return s
+}
+
+// epochSeconds returns t as the fractional number of seconds since the Unix epoch,
+// the AWS JSON protocol representation of a timestamp.
+func epochSeconds(t time.Time) float64 {
+	return float64(t.UnixMilli()) / 1000
+}
+
+func newUUID() string {
+	v, err := uuid.GenerateUUID()
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+func sortedKeys[M ~map[string]V, V any](m M) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+// paginate returns the page of items starting at the offset encoded in nextToken
+// and the token for the following page, if any.
+func paginate[T any](items []T, nextToken string, maxResults, defaultMaxResults int) ([]T, string, error) {
+	start := 0
+	if nextToken != "" {
+		v, err := strconv.Atoi(nextToken)
+		if err != nil || v < 0 || v > len(items) {
+			return nil, "", newAPIError("InvalidNextToken", "invalid pagination token %q", nextToken)
+		}
+		start = v
+	}
+
+	if maxResults <= 0 {
+		maxResults = defaultMaxResults
+	}
+
+	end := min(start+maxResults, len(items))
+	if end < len(items) {
+		return items[start:end], strconv.Itoa(end), nil
+	}
+
+	return items[start:end], "", nil
+}
+
+// omitEmpty returns nil for the empty string so that it is serialized as a JSON null.
+func omitEmpty(s string) any {
+	if s == "" {
+		return nil
+	}
+
+	return s
+}
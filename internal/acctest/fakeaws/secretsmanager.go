@@ -0,0 +1,707 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"encoding/json"
+	"math/rand"
+	"slices"
+	"strings"
+	"time"
+)
+
+// secretsManagerService is a stand-in for AWS Secrets Manager secrets and their versions.
+type secretsManagerService struct {
+	server  *Server
+	secrets map[string]*secretsManagerSecret // Keyed by ARN.
+}
+
+type secretsManagerSecret struct {
+	arn             string
+	name            string
+	createdDate     time.Time
+	deletedDate     *time.Time
+	description     string
+	kmsKeyID        string
+	lastChangedDate time.Time
+	replicas        map[string]string // Region to KMS key ID.
+	resourcePolicy  string
+	versions        map[string]*secretsManagerSecretVersion
+}
+
+type secretsManagerSecretVersion struct {
+	createdDate  time.Time
+	secretBinary []byte
+	secretString *string
+	stages       []string
+}
+
+func newSecretsManagerService(server *Server) *secretsManagerService {
+	return &secretsManagerService{
+		server:  server,
+		secrets: make(map[string]*secretsManagerSecret),
+	}
+}
+
+func (svc *secretsManagerService) handler() *jsonHandler {
+	return &jsonHandler{
+		version:      "1.1",
+		targetPrefix: "secretsmanager",
+		operations: map[string]jsonOperation{
+			"CreateSecret":                 jsonOp(svc.createSecret),
+			"DeleteResourcePolicy":         jsonOp(svc.deleteResourcePolicy),
+			"DeleteSecret":                 jsonOp(svc.deleteSecret),
+			"DescribeSecret":               jsonOp(svc.describeSecret),
+			"GetResourcePolicy":            jsonOp(svc.getResourcePolicy),
+			"GetSecretValue":               jsonOp(svc.getSecretValue),
+			"ListSecretVersionIds":         jsonOp(svc.listSecretVersionIDs),
+			"ListSecrets":                  jsonOp(svc.listSecrets),
+			"PutResourcePolicy":            jsonOp(svc.putResourcePolicy),
+			"PutSecretValue":               jsonOp(svc.putSecretValue),
+			"RemoveRegionsFromReplication": jsonOp(svc.removeRegionsFromReplication),
+			"ReplicateSecretToRegions":     jsonOp(svc.replicateSecretToRegions),
+			"RestoreSecret":                jsonOp(svc.restoreSecret),
+			"TagResource":                  jsonOp(svc.tagResource),
+			"UntagResource":                jsonOp(svc.untagResource),
+			"UpdateSecret":                 jsonOp(svc.updateSecret),
+			"UpdateSecretVersionStage":     jsonOp(svc.updateSecretVersionStage),
+		},
+	}
+}
+
+func secretsManagerResourceNotFoundError() *apiError {
+	return newAPIError("ResourceNotFoundException", "Secrets Manager can't find the specified secret.")
+}
+
+func secretsManagerInvalidRequestError(format string, a ...any) *apiError {
+	return newAPIError("InvalidRequestException", format, a...)
+}
+
+// findSecret returns the secret identified by ARN or name.
+func (svc *secretsManagerService) findSecret(id string) (*secretsManagerSecret, error) {
+	if v, ok := svc.secrets[id]; ok {
+		return v, nil
+	}
+
+	for _, v := range svc.secrets {
+		if v.name == id {
+			return v, nil
+		}
+	}
+
+	return nil, secretsManagerResourceNotFoundError()
+}
+
+// findActiveSecret returns the secret identified by ARN or name if it is not scheduled for deletion.
+func (svc *secretsManagerService) findActiveSecret(id string) (*secretsManagerSecret, error) {
+	v, err := svc.findSecret(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.deletedDate != nil {
+		return nil, secretsManagerInvalidRequestError("You can't perform this operation on the secret because it was marked for deletion.")
+	}
+
+	return v, nil
+}
+
+const secretsManagerARNSuffixChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// putVersion adds a new AWSCURRENT version of the secret's value.
+func (v *secretsManagerSecret) putVersion(versionID string, secretString *string, secretBinary []byte, stages []string) {
+	if len(stages) == 0 {
+		stages = []string{"AWSCURRENT"}
+	}
+
+	if slices.Contains(stages, "AWSCURRENT") {
+		for _, version := range v.versions {
+			if slices.Contains(version.stages, "AWSCURRENT") {
+				version.stages = []string{"AWSPREVIOUS"}
+			} else {
+				version.stages = slices.DeleteFunc(version.stages, func(s string) bool { return s == "AWSPREVIOUS" })
+			}
+		}
+	}
+
+	v.versions[versionID] = &secretsManagerSecretVersion{
+		createdDate:  time.Now(),
+		secretBinary: secretBinary,
+		secretString: secretString,
+		stages:       stages,
+	}
+	v.lastChangedDate = time.Now()
+}
+
+type secretsManagerReplicaRegion struct {
+	KmsKeyId string `json:"KmsKeyId"`
+	Region   string `json:"Region"`
+}
+
+type secretsManagerCreateSecretInput struct {
+	AddReplicaRegions  []secretsManagerReplicaRegion `json:"AddReplicaRegions"`
+	ClientRequestToken string                        `json:"ClientRequestToken"`
+	Description        string                        `json:"Description"`
+	KmsKeyId           string                        `json:"KmsKeyId"`
+	Name               string                        `json:"Name"`
+	SecretBinary       []byte                        `json:"SecretBinary"`
+	SecretString       *string                       `json:"SecretString"`
+	Tags               []tagKeyValue                 `json:"Tags"`
+}
+
+func (svc *secretsManagerService) createSecret(input *secretsManagerCreateSecretInput) (any, error) {
+	if input.Name == "" {
+		return nil, newAPIError("InvalidParameterException", "Name is required")
+	}
+
+	if v, err := svc.findSecret(input.Name); err == nil {
+		if v.deletedDate != nil {
+			return nil, secretsManagerInvalidRequestError("You can't create this secret because a secret with this name is already scheduled for deletion.")
+		}
+
+		return nil, newAPIError("ResourceExistsException", "The operation failed because the secret %s already exists.", input.Name)
+	}
+
+	suffix := make([]byte, 6)
+	for i := range suffix {
+		suffix[i] = secretsManagerARNSuffixChars[rand.Intn(len(secretsManagerARNSuffixChars))] //nolint:gosec // Not used for security.
+	}
+
+	now := time.Now()
+	v := &secretsManagerSecret{
+		arn:             svc.server.arn("secretsmanager", "secret:"+input.Name+"-"+string(suffix)),
+		name:            input.Name,
+		createdDate:     now,
+		description:     input.Description,
+		kmsKeyID:        input.KmsKeyId,
+		lastChangedDate: now,
+		replicas:        make(map[string]string),
+		versions:        make(map[string]*secretsManagerSecretVersion),
+	}
+
+	output := map[string]any{
+		"ARN":  v.arn,
+		"Name": v.name,
+	}
+
+	if input.SecretString != nil || input.SecretBinary != nil {
+		versionID := input.ClientRequestToken
+		if versionID == "" {
+			versionID = newUUID()
+		}
+		v.putVersion(versionID, input.SecretString, input.SecretBinary, nil)
+		output["VersionId"] = versionID
+	}
+
+	for _, replica := range input.AddReplicaRegions {
+		v.replicas[replica.Region] = replica.KmsKeyId
+	}
+
+	svc.secrets[v.arn] = v
+	svc.server.tagResource(v.arn, tagsFromKeyValues(input.Tags))
+
+	return output, nil
+}
+
+type secretsManagerSecretIDInput struct {
+	SecretId string `json:"SecretId"`
+}
+
+func (svc *secretsManagerService) describeSecret(input *secretsManagerSecretIDInput) (any, error) {
+	v, err := svc.findSecret(input.SecretId)
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.secretListEntry(v), nil
+}
+
+type secretsManagerSecretListEntry struct {
+	ARN                string              `json:"ARN"`
+	CreatedDate        float64             `json:"CreatedDate"`
+	DeletedDate        *float64            `json:"DeletedDate,omitempty"`
+	Description        string              `json:"Description,omitempty"`
+	KmsKeyId           string              `json:"KmsKeyId,omitempty"`
+	LastChangedDate    float64             `json:"LastChangedDate"`
+	Name               string              `json:"Name"`
+	PrimaryRegion      string              `json:"PrimaryRegion,omitempty"`
+	ReplicationStatus  []map[string]any    `json:"ReplicationStatus,omitempty"`
+	RotationEnabled    bool                `json:"RotationEnabled"`
+	Tags               []tagKeyValue       `json:"Tags,omitempty"`
+	VersionIdsToStages map[string][]string `json:"VersionIdsToStages,omitempty"`
+}
+
+func (svc *secretsManagerService) secretListEntry(v *secretsManagerSecret) *secretsManagerSecretListEntry {
+	entry := &secretsManagerSecretListEntry{
+		ARN:             v.arn,
+		CreatedDate:     epochSeconds(v.createdDate),
+		Description:     v.description,
+		KmsKeyId:        v.kmsKeyID,
+		LastChangedDate: epochSeconds(v.lastChangedDate),
+		Name:            v.name,
+		RotationEnabled: false,
+		Tags:            tagKeyValues(svc.server.listTags(v.arn)),
+	}
+
+	if v.deletedDate != nil {
+		deletedDate := epochSeconds(*v.deletedDate)
+		entry.DeletedDate = &deletedDate
+	}
+
+	if len(v.replicas) > 0 {
+		entry.PrimaryRegion = svc.server.region
+		for _, region := range sortedKeys(v.replicas) {
+			status := map[string]any{
+				"Region":        region,
+				"Status":        "InSync",
+				"StatusMessage": "Replication succeeded",
+			}
+			if kmsKeyID := v.replicas[region]; kmsKeyID != "" {
+				status["KmsKeyId"] = kmsKeyID
+			}
+			entry.ReplicationStatus = append(entry.ReplicationStatus, status)
+		}
+	}
+
+	if len(v.versions) > 0 {
+		entry.VersionIdsToStages = make(map[string][]string, len(v.versions))
+		for versionID, version := range v.versions {
+			if len(version.stages) > 0 {
+				entry.VersionIdsToStages[versionID] = version.stages
+			}
+		}
+	}
+
+	return entry
+}
+
+type secretsManagerListSecretsInput struct {
+	IncludePlannedDeletion bool `json:"IncludePlannedDeletion"`
+	Filters                []struct {
+		Key    string   `json:"Key"`
+		Values []string `json:"Values"`
+	} `json:"Filters"`
+	MaxResults int    `json:"MaxResults"`
+	NextToken  string `json:"NextToken"`
+}
+
+func (svc *secretsManagerService) listSecrets(input *secretsManagerListSecretsInput) (any, error) {
+	var secrets []*secretsManagerSecretListEntry
+
+	for _, arn := range sortedKeys(svc.secrets) {
+		v := svc.secrets[arn]
+		if v.deletedDate != nil && !input.IncludePlannedDeletion {
+			continue
+		}
+
+		match := true
+		for _, filter := range input.Filters {
+			if filter.Key != "name" {
+				continue
+			}
+			match = match && slices.ContainsFunc(filter.Values, func(s string) bool { return strings.HasPrefix(v.name, s) })
+		}
+		if match {
+			secrets = append(secrets, svc.secretListEntry(v))
+		}
+	}
+
+	page, nextToken, err := paginate(secrets, input.NextToken, input.MaxResults, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"NextToken":  omitEmpty(nextToken),
+		"SecretList": page,
+	}, nil
+}
+
+type secretsManagerUpdateSecretInput struct {
+	ClientRequestToken string  `json:"ClientRequestToken"`
+	Description        *string `json:"Description"`
+	KmsKeyId           *string `json:"KmsKeyId"`
+	SecretBinary       []byte  `json:"SecretBinary"`
+	SecretId           string  `json:"SecretId"`
+	SecretString       *string `json:"SecretString"`
+}
+
+func (svc *secretsManagerService) updateSecret(input *secretsManagerUpdateSecretInput) (any, error) {
+	v, err := svc.findActiveSecret(input.SecretId)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Description != nil {
+		v.description = *input.Description
+	}
+	if input.KmsKeyId != nil {
+		v.kmsKeyID = *input.KmsKeyId
+	}
+	v.lastChangedDate = time.Now()
+
+	output := map[string]any{
+		"ARN":  v.arn,
+		"Name": v.name,
+	}
+
+	if input.SecretString != nil || input.SecretBinary != nil {
+		versionID := input.ClientRequestToken
+		if versionID == "" {
+			versionID = newUUID()
+		}
+		v.putVersion(versionID, input.SecretString, input.SecretBinary, nil)
+		output["VersionId"] = versionID
+	}
+
+	return output, nil
+}
+
+type secretsManagerDeleteSecretInput struct {
+	ForceDeleteWithoutRecovery bool   `json:"ForceDeleteWithoutRecovery"`
+	RecoveryWindowInDays       int64  `json:"RecoveryWindowInDays"`
+	SecretId                   string `json:"SecretId"`
+}
+
+func (svc *secretsManagerService) deleteSecret(input *secretsManagerDeleteSecretInput) (any, error) {
+	v, err := svc.findSecret(input.SecretId)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.ForceDeleteWithoutRecovery && input.RecoveryWindowInDays != 0 {
+		return nil, newAPIError("InvalidParameterException", "You can't use ForceDeleteWithoutRecovery in conjunction with RecoveryWindowInDays.")
+	}
+
+	if len(v.replicas) > 0 {
+		return nil, secretsManagerInvalidRequestError("You can't delete secret %s that still has replica regions.", v.name)
+	}
+
+	now := time.Now()
+	output := map[string]any{
+		"ARN":          v.arn,
+		"DeletionDate": epochSeconds(now),
+		"Name":         v.name,
+	}
+
+	if input.ForceDeleteWithoutRecovery {
+		delete(svc.secrets, v.arn)
+		svc.server.deleteTags(v.arn)
+
+		return output, nil
+	}
+
+	recoveryWindowInDays := input.RecoveryWindowInDays
+	if recoveryWindowInDays == 0 {
+		recoveryWindowInDays = 30
+	}
+	if recoveryWindowInDays < 7 || recoveryWindowInDays > 30 {
+		return nil, newAPIError("InvalidParameterException", "RecoveryWindowInDays must be between 7 and 30 days.")
+	}
+
+	deletionDate := now.AddDate(0, 0, int(recoveryWindowInDays))
+	v.deletedDate = &now
+	output["DeletionDate"] = epochSeconds(deletionDate)
+
+	return output, nil
+}
+
+func (svc *secretsManagerService) restoreSecret(input *secretsManagerSecretIDInput) (any, error) {
+	v, err := svc.findSecret(input.SecretId)
+	if err != nil {
+		return nil, err
+	}
+
+	v.deletedDate = nil
+
+	return map[string]any{
+		"ARN":  v.arn,
+		"Name": v.name,
+	}, nil
+}
+
+type secretsManagerGetSecretValueInput struct {
+	SecretId     string `json:"SecretId"`
+	VersionId    string `json:"VersionId"`
+	VersionStage string `json:"VersionStage"`
+}
+
+func (svc *secretsManagerService) getSecretValue(input *secretsManagerGetSecretValueInput) (any, error) {
+	v, err := svc.findActiveSecret(input.SecretId)
+	if err != nil {
+		return nil, err
+	}
+
+	versionStage := input.VersionStage
+	if input.VersionId == "" && versionStage == "" {
+		versionStage = "AWSCURRENT"
+	}
+
+	for versionID, version := range v.versions {
+		if (input.VersionId != "" && versionID != input.VersionId) || (versionStage != "" && !slices.Contains(version.stages, versionStage)) {
+			continue
+		}
+
+		output := map[string]any{
+			"ARN":           v.arn,
+			"CreatedDate":   epochSeconds(version.createdDate),
+			"Name":          v.name,
+			"VersionId":     versionID,
+			"VersionStages": version.stages,
+		}
+		if version.secretString != nil {
+			output["SecretString"] = *version.secretString
+		}
+		if version.secretBinary != nil {
+			output["SecretBinary"] = version.secretBinary
+		}
+
+		return output, nil
+	}
+
+	return nil, newAPIError("ResourceNotFoundException", "Secrets Manager can't find the specified secret value for staging label: %s", versionStage)
+}
+
+type secretsManagerPutSecretValueInput struct {
+	ClientRequestToken string   `json:"ClientRequestToken"`
+	SecretBinary       []byte   `json:"SecretBinary"`
+	SecretId           string   `json:"SecretId"`
+	SecretString       *string  `json:"SecretString"`
+	VersionStages      []string `json:"VersionStages"`
+}
+
+func (svc *secretsManagerService) putSecretValue(input *secretsManagerPutSecretValueInput) (any, error) {
+	v, err := svc.findActiveSecret(input.SecretId)
+	if err != nil {
+		return nil, err
+	}
+
+	versionID := input.ClientRequestToken
+	if versionID == "" {
+		versionID = newUUID()
+	}
+
+	if version, ok := v.versions[versionID]; ok {
+		// PutSecretValue is idempotent for the same version ID and value.
+		if !equalPtr(version.secretString, input.SecretString) || string(version.secretBinary) != string(input.SecretBinary) {
+			return nil, newAPIError("ResourceExistsException", "You can't modify an existing version, you can only create a new version.")
+		}
+	} else {
+		v.putVersion(versionID, input.SecretString, input.SecretBinary, input.VersionStages)
+	}
+
+	return map[string]any{
+		"ARN":           v.arn,
+		"Name":          v.name,
+		"VersionId":     versionID,
+		"VersionStages": v.versions[versionID].stages,
+	}, nil
+}
+
+type secretsManagerListSecretVersionIDsInput struct {
+	IncludeDeprecated bool   `json:"IncludeDeprecated"`
+	MaxResults        int    `json:"MaxResults"`
+	NextToken         string `json:"NextToken"`
+	SecretId          string `json:"SecretId"`
+}
+
+func (svc *secretsManagerService) listSecretVersionIDs(input *secretsManagerListSecretVersionIDsInput) (any, error) {
+	v, err := svc.findActiveSecret(input.SecretId)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []map[string]any
+	for _, versionID := range sortedKeys(v.versions) {
+		version := v.versions[versionID]
+		if len(version.stages) == 0 && !input.IncludeDeprecated {
+			continue
+		}
+		versions = append(versions, map[string]any{
+			"CreatedDate":   epochSeconds(version.createdDate),
+			"VersionId":     versionID,
+			"VersionStages": version.stages,
+		})
+	}
+
+	page, nextToken, err := paginate(versions, input.NextToken, input.MaxResults, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"ARN":       v.arn,
+		"Name":      v.name,
+		"NextToken": omitEmpty(nextToken),
+		"Versions":  page,
+	}, nil
+}
+
+type secretsManagerUpdateSecretVersionStageInput struct {
+	MoveToVersionId     string `json:"MoveToVersionId"`
+	RemoveFromVersionId string `json:"RemoveFromVersionId"`
+	SecretId            string `json:"SecretId"`
+	VersionStage        string `json:"VersionStage"`
+}
+
+func (svc *secretsManagerService) updateSecretVersionStage(input *secretsManagerUpdateSecretVersionStageInput) (any, error) {
+	v, err := svc.findActiveSecret(input.SecretId)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.MoveToVersionId != "" {
+		if _, ok := v.versions[input.MoveToVersionId]; !ok {
+			return nil, secretsManagerResourceNotFoundError()
+		}
+	}
+
+	for versionID, version := range v.versions {
+		if versionID == input.RemoveFromVersionId || (input.MoveToVersionId != "" && versionID != input.MoveToVersionId) {
+			version.stages = slices.DeleteFunc(version.stages, func(s string) bool { return s == input.VersionStage })
+		}
+	}
+
+	if input.MoveToVersionId != "" {
+		if version := v.versions[input.MoveToVersionId]; !slices.Contains(version.stages, input.VersionStage) {
+			version.stages = append(version.stages, input.VersionStage)
+		}
+	}
+
+	return map[string]any{
+		"ARN":  v.arn,
+		"Name": v.name,
+	}, nil
+}
+
+func (svc *secretsManagerService) getResourcePolicy(input *secretsManagerSecretIDInput) (any, error) {
+	v, err := svc.findSecret(input.SecretId)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"ARN":            v.arn,
+		"Name":           v.name,
+		"ResourcePolicy": omitEmpty(v.resourcePolicy),
+	}, nil
+}
+
+type secretsManagerPutResourcePolicyInput struct {
+	ResourcePolicy string `json:"ResourcePolicy"`
+	SecretId       string `json:"SecretId"`
+}
+
+func (svc *secretsManagerService) putResourcePolicy(input *secretsManagerPutResourcePolicyInput) (any, error) {
+	v, err := svc.findActiveSecret(input.SecretId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !json.Valid([]byte(input.ResourcePolicy)) {
+		return nil, newAPIError("MalformedPolicyDocumentException", "This resource policy contains a syntax error.")
+	}
+
+	v.resourcePolicy = input.ResourcePolicy
+
+	return map[string]any{
+		"ARN":  v.arn,
+		"Name": v.name,
+	}, nil
+}
+
+func (svc *secretsManagerService) deleteResourcePolicy(input *secretsManagerSecretIDInput) (any, error) {
+	v, err := svc.findSecret(input.SecretId)
+	if err != nil {
+		return nil, err
+	}
+
+	v.resourcePolicy = ""
+
+	return map[string]any{
+		"ARN":  v.arn,
+		"Name": v.name,
+	}, nil
+}
+
+type secretsManagerReplicateSecretToRegionsInput struct {
+	AddReplicaRegions []secretsManagerReplicaRegion `json:"AddReplicaRegions"`
+	SecretId          string                        `json:"SecretId"`
+}
+
+func (svc *secretsManagerService) replicateSecretToRegions(input *secretsManagerReplicateSecretToRegionsInput) (any, error) {
+	v, err := svc.findActiveSecret(input.SecretId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, replica := range input.AddReplicaRegions {
+		if replica.Region == svc.server.region {
+			return nil, newAPIError("InvalidParameterException", "Invalid replica region %s: The replica region can't be the same as the primary region.", replica.Region)
+		}
+		v.replicas[replica.Region] = replica.KmsKeyId
+	}
+
+	return map[string]any{
+		"ARN":               v.arn,
+		"ReplicationStatus": svc.secretListEntry(v).ReplicationStatus,
+	}, nil
+}
+
+type secretsManagerRemoveRegionsFromReplicationInput struct {
+	RemoveReplicaRegions []string `json:"RemoveReplicaRegions"`
+	SecretId             string   `json:"SecretId"`
+}
+
+func (svc *secretsManagerService) removeRegionsFromReplication(input *secretsManagerRemoveRegionsFromReplicationInput) (any, error) {
+	v, err := svc.findSecret(input.SecretId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, region := range input.RemoveReplicaRegions {
+		delete(v.replicas, region)
+	}
+
+	return map[string]any{
+		"ARN":               v.arn,
+		"ReplicationStatus": svc.secretListEntry(v).ReplicationStatus,
+	}, nil
+}
+
+type secretsManagerTagResourceInput struct {
+	SecretId string        `json:"SecretId"`
+	Tags     []tagKeyValue `json:"Tags"`
+	TagKeys  []string      `json:"TagKeys"`
+}
+
+func (svc *secretsManagerService) tagResource(input *secretsManagerTagResourceInput) (any, error) {
+	v, err := svc.findSecret(input.SecretId)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.server.tagResource(v.arn, tagsFromKeyValues(input.Tags))
+
+	return nil, nil
+}
+
+func (svc *secretsManagerService) untagResource(input *secretsManagerTagResourceInput) (any, error) {
+	v, err := svc.findSecret(input.SecretId)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.server.untagResource(v.arn, input.TagKeys)
+
+	return nil, nil
+}
+
+func equalPtr[T comparable](x, y *T) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return *x == *y
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSecretsManagerSecret(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.New()
+	conn := secretsmanager.NewFromConfig(testConfig(server), func(o *secretsmanager.Options) {
+		o.BaseEndpoint = aws.String(server.Endpoints()[names.SecretsManager])
+	})
+
+	output, err := conn.CreateSecret(ctx, &secretsmanager.CreateSecretInput{
+		Name:         aws.String("fakeaws"),
+		SecretString: aws.String("s1"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	secretARN := aws.ToString(output.ARN)
+
+	if _, err := conn.PutSecretValue(ctx, &secretsmanager.PutSecretValueInput{
+		SecretId:     aws.String(secretARN),
+		SecretString: aws.String("s2"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	value, err := conn.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String("fakeaws"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := aws.ToString(value.SecretString), "s2"; got != want {
+		t.Errorf("SecretString = %q, want %q", got, want)
+	}
+
+	versions, err := conn.ListSecretVersionIds(ctx, &secretsmanager.ListSecretVersionIdsInput{
+		SecretId: aws.String(secretARN),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(versions.Versions), 2; got != want {
+		t.Errorf("len(Versions) = %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteSecret(ctx, &secretsmanager.DeleteSecretInput{
+		RecoveryWindowInDays: aws.Int64(7),
+		SecretId:             aws.String(secretARN),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	describe, err := conn.DescribeSecret(ctx, &secretsmanager.DescribeSecretInput{
+		SecretId: aws.String(secretARN),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if describe.DeletedDate == nil {
+		t.Error("DeletedDate is nil, want scheduled deletion")
+	}
+
+	if _, err := conn.DeleteSecret(ctx, &secretsmanager.DeleteSecretInput{
+		ForceDeleteWithoutRecovery: aws.Bool(true),
+		SecretId:                   aws.String(secretARN),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = conn.DescribeSecret(ctx, &secretsmanager.DescribeSecretInput{
+		SecretId: aws.String(secretARN),
+	})
+	if !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		t.Errorf("DescribeSecret (deleted) error = %v, want ResourceNotFoundException", err)
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fakeaws provides in-process, stateful stand-ins for a subset of AWS service APIs.
+//
+// A Server answers AWS API requests made by the real AWS SDK for Go v2 clients without any network access.
+// Its HTTP client is plugged into the provider via AWSClient.SetHTTPClient and its per-service
+// endpoints via conns.Config.Endpoints, so resource CRUD handlers run unmodified against it.
+package fakeaws
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// DefaultAccountID is the AWS account ID reported by a Server unless overridden.
+	DefaultAccountID = "123456789012"
+	// DefaultRegion is the AWS Region assumed by a Server unless overridden.
+	DefaultRegion = names.USWest2RegionID
+
+	hostSuffix = ".fakeaws.invalid"
+)
+
+// Server is an in-process stand-in for AWS service APIs.
+// All state is held in memory and is discarded with the Server.
+type Server struct {
+	accountID string
+	region    string
+
+	mu        sync.Mutex
+	requestID int
+	handlers  map[string]http.Handler // Keyed by service package name.
+	tags      map[string]map[string]string
+
+	dynamodb       *dynamoDBService
+	logs           *logsService
+	secretsmanager *secretsManagerService
+	sns            *snsService
+	sqs            *sqsService
+	ssm            *ssmService
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithAccountID sets the AWS account ID reported by the Server.
+func WithAccountID(accountID string) Option {
+	return func(s *Server) {
+		s.accountID = accountID
+	}
+}
+
+// WithRegion sets the AWS Region used in ARNs and URLs generated by the Server.
+func WithRegion(region string) Option {
+	return func(s *Server) {
+		s.region = region
+	}
+}
+
+// New returns a new, empty Server.
+func New(optFns ...Option) *Server {
+	s := &Server{
+		accountID: DefaultAccountID,
+		region:    DefaultRegion,
+		tags:      make(map[string]map[string]string),
+	}
+
+	for _, optFn := range optFns {
+		optFn(s)
+	}
+
+	s.dynamodb = newDynamoDBService(s)
+	s.logs = newLogsService(s)
+	s.secretsmanager = newSecretsManagerService(s)
+	s.sns = newSNSService(s)
+	s.sqs = newSQSService(s)
+	s.ssm = newSSMService(s)
+
+	s.handlers = map[string]http.Handler{
+		names.DynamoDB:       s.dynamodb.handler(),
+		names.Logs:           s.logs.handler(),
+		names.SecretsManager: s.secretsmanager.handler(),
+		names.SNS:            s.sns.handler(),
+		names.SQS:            s.sqs.handler(),
+		names.SSM:            s.ssm.handler(),
+		names.STS:            newSTSService(s).handler(),
+	}
+
+	return s
+}
+
+// AccountID returns the AWS account ID reported by the Server.
+func (s *Server) AccountID() string {
+	return s.accountID
+}
+
+// Region returns the AWS Region used by the Server.
+func (s *Server) Region() string {
+	return s.region
+}
+
+// Endpoints returns the Server's service endpoint URLs keyed by service package name,
+// suitable for use as conns.Config.Endpoints.
+func (s *Server) Endpoints() map[string]string {
+	endpoints := make(map[string]string, len(s.handlers))
+
+	for servicePackageName := range s.handlers {
+		endpoints[servicePackageName] = s.endpoint(servicePackageName)
+	}
+
+	return endpoints
+}
+
+// HTTPClient returns an http.Client that delivers requests for the Server's endpoints
+// directly to the Server, without opening any network connections.
+func (s *Server) HTTPClient() *http.Client {
+	return &http.Client{
+		Transport: s,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+// Requests are served one at a time; AWS API calls made by concurrent goroutines are serialized.
+func (s *Server) RoundTrip(r *http.Request) (*http.Response, error) {
+	host := r.URL.Hostname()
+	servicePackageName, ok := strings.CutSuffix(host, hostSuffix)
+	if !ok {
+		return nil, fmt.Errorf("fakeaws: request to %s: host is not a fake AWS endpoint", r.URL)
+	}
+
+	handler, ok := s.handlers[servicePackageName]
+	if !ok {
+		return nil, fmt.Errorf("fakeaws: request to %s: service %q is not supported", r.URL, servicePackageName)
+	}
+
+	if r.Body == nil {
+		r.Body = http.NoBody
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requestID++
+	w := httptest.NewRecorder()
+	w.Header().Set("X-Amzn-Requestid", fmt.Sprintf("fakeaws-%08d", s.requestID))
+
+	handler.ServeHTTP(w, r)
+
+	response := w.Result()
+	response.Request = r
+
+	// Drain the request body so that the caller may safely reuse it.
+	_, _ = io.Copy(io.Discard, r.Body)
+
+	return response, nil
+}
+
+func (s *Server) endpoint(servicePackageName string) string {
+	return "http://" + servicePackageName + hostSuffix
+}
+
+func (s *Server) arn(service, resource string) string {
+	return fmt.Sprintf("arn:%s:%s:%s:%s:%s", names.StandardPartitionID, service, s.region, s.accountID, resource)
+}
+
+// listTags returns a copy of the tags on the resource with the specified ARN.
+func (s *Server) listTags(arn string) map[string]string {
+	tags := make(map[string]string, len(s.tags[arn]))
+
+	for k, v := range s.tags[arn] {
+		tags[k] = v
+	}
+
+	return tags
+}
+
+func (s *Server) tagResource(arn string, tags map[string]string) {
+	if len(tags) == 0 {
+		return
+	}
+
+	if s.tags[arn] == nil {
+		s.tags[arn] = make(map[string]string, len(tags))
+	}
+
+	for k, v := range tags {
+		s.tags[arn][k] = v
+	}
+}
+
+func (s *Server) untagResource(arn string, keys []string) {
+	for _, k := range keys {
+		delete(s.tags[arn], k)
+	}
+}
+
+func (s *Server) deleteTags(arn string) {
+	delete(s.tags, arn)
+}
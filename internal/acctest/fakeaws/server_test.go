@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// testConfig returns an AWS SDK for Go v2 configuration that sends all requests to server.
+func testConfig(server *fakeaws.Server) aws.Config {
+	return aws.Config{
+		Credentials: credentials.NewStaticCredentialsProvider("fake", "fake", ""),
+		HTTPClient:  server.HTTPClient(),
+		Region:      server.Region(),
+		Retryer: func() aws.Retryer {
+			return aws.NopRetryer{}
+		},
+	}
+}
+
+func TestServerEndpoints(t *testing.T) {
+	t.Parallel()
+
+	server := fakeaws.New()
+	endpoints := server.Endpoints()
+
+	for _, servicePackageName := range []string{names.DynamoDB, names.Logs, names.SecretsManager, names.SNS, names.SQS, names.SSM, names.STS} {
+		if endpoints[servicePackageName] == "" {
+			t.Errorf("no endpoint for %s", servicePackageName)
+		}
+	}
+}
+
+func TestServerRoundTrip_unknownHost(t *testing.T) {
+	t.Parallel()
+
+	server := fakeaws.New()
+	client := server.HTTPClient()
+
+	for _, url := range []string{"https://sqs.us-west-2.amazonaws.com/", "http://ec2.fakeaws.invalid/"} {
+		request, err := http.NewRequestWithContext(context.Background(), http.MethodPost, url, http.NoBody)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		response, err := client.Do(request)
+		if err == nil {
+			response.Body.Close()
+			t.Errorf("expected error for %s", url)
+		}
+	}
+}
+
+func TestSTSGetCallerIdentity(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.New(fakeaws.WithAccountID("111122223333"))
+	conn := sts.NewFromConfig(testConfig(server), func(o *sts.Options) {
+		o.BaseEndpoint = aws.String(server.Endpoints()[names.STS])
+	})
+
+	output, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := aws.ToString(output.Account), "111122223333"; got != want {
+		t.Errorf("Account = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"fmt"
+	"maps"
+	"net/url"
+	"strings"
+)
+
+// snsService is a stand-in for Amazon Simple Notification Service topics.
+type snsService struct {
+	server *Server
+	topics map[string]*snsTopic // Keyed by ARN.
+}
+
+type snsTopic struct {
+	arn        string
+	attributes map[string]string
+}
+
+func newSNSService(server *Server) *snsService {
+	return &snsService{
+		server: server,
+		topics: make(map[string]*snsTopic),
+	}
+}
+
+func (svc *snsService) handler() *queryHandler {
+	return &queryHandler{
+		xmlns: "http://sns.amazonaws.com/doc/2010-03-31/",
+		operations: map[string]queryOperation{
+			"CreateTopic":         svc.createTopic,
+			"DeleteTopic":         svc.deleteTopic,
+			"GetTopicAttributes":  svc.getTopicAttributes,
+			"ListTagsForResource": svc.listTagsForResource,
+			"ListTopics":          svc.listTopics,
+			"SetTopicAttributes":  svc.setTopicAttributes,
+			"TagResource":         svc.tagResource,
+			"UntagResource":       svc.untagResource,
+		},
+	}
+}
+
+func snsNotFoundError(format string, a ...any) *apiError {
+	err := newAPIError("NotFound", format, a...)
+	err.statusCode = 404
+
+	return err
+}
+
+func snsInvalidParameterError(format string, a ...any) *apiError {
+	return newAPIError("InvalidParameter", format, a...)
+}
+
+func (svc *snsService) findTopic(arn string) (*snsTopic, error) {
+	t, ok := svc.topics[arn]
+	if !ok {
+		return nil, snsNotFoundError("Topic does not exist")
+	}
+
+	return t, nil
+}
+
+// snsDefaultTopicPolicy returns the access policy AWS attaches to a new topic.
+func (svc *snsService) defaultTopicPolicy(arn string) string {
+	return fmt.Sprintf(`{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":["SNS:GetTopicAttributes","SNS:SetTopicAttributes","SNS:AddPermission","SNS:RemovePermission","SNS:DeleteTopic","SNS:Subscribe","SNS:ListSubscriptionsByTopic","SNS:Publish"],"Resource":%q,"Condition":{"StringEquals":{"AWS:SourceOwner":%q}}}]}`, arn, svc.server.accountID)
+}
+
+func (svc *snsService) setAttribute(t *snsTopic, name, value string) error {
+	switch name {
+	case "FifoTopic", "ContentBasedDeduplication":
+		if value != "true" && value != "false" {
+			return snsInvalidParameterError("Invalid parameter: Attributes Reason: %s: Invalid value [%s]", name, value)
+		}
+		if name == "ContentBasedDeduplication" && t.attributes["FifoTopic"] != "true" && value == "true" {
+			return snsInvalidParameterError("Invalid parameter: Attributes Reason: Content-based deduplication can only be set for FIFO topics")
+		}
+	case "SignatureVersion":
+		if value != "1" && value != "2" {
+			return snsInvalidParameterError("Invalid parameter: SignatureVersion")
+		}
+	case "Owner", "TopicArn", "SubscriptionsConfirmed", "SubscriptionsDeleted", "SubscriptionsPending", "EffectiveDeliveryPolicy":
+		return snsInvalidParameterError("Invalid parameter: AttributeName")
+	}
+
+	switch {
+	case name == "Policy" && value == "":
+		t.attributes[name] = svc.defaultTopicPolicy(t.arn)
+	case value == "" && name != "DisplayName":
+		delete(t.attributes, name)
+	default:
+		t.attributes[name] = value
+	}
+
+	if name == "DeliveryPolicy" && value != "" {
+		t.attributes["EffectiveDeliveryPolicy"] = value
+	}
+
+	return nil
+}
+
+type snsCreateTopicResult struct {
+	TopicArn string `xml:"TopicArn"`
+}
+
+func (svc *snsService) createTopic(form url.Values) (any, error) {
+	name := form.Get("Name")
+	if name == "" {
+		return nil, snsInvalidParameterError("Invalid parameter: Topic Name")
+	}
+
+	attributes := queryStringMap(form, "Attributes")
+	if strings.HasSuffix(name, ".fifo") != (attributes["FifoTopic"] == "true") {
+		return nil, snsInvalidParameterError("Invalid parameter: Topic Name")
+	}
+
+	arn := svc.server.arn("sns", name)
+	if t, ok := svc.topics[arn]; ok {
+		// CreateTopic is idempotent if the requested attributes match the existing topic's.
+		for k, v := range attributes {
+			if t.attributes[k] != v {
+				return nil, snsInvalidParameterError("Invalid parameter: Attributes Reason: Topic already exists with different attributes")
+			}
+		}
+
+		return &snsCreateTopicResult{TopicArn: arn}, nil
+	}
+
+	t := &snsTopic{
+		arn: arn,
+		attributes: map[string]string{
+			"DisplayName":             "",
+			"EffectiveDeliveryPolicy": `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false,"defaultRequestPolicy":{"headerContentType":"text/plain; charset=UTF-8"}}}`,
+			"Owner":                   svc.server.accountID,
+			"Policy":                  svc.defaultTopicPolicy(arn),
+			"SubscriptionsConfirmed":  "0",
+			"SubscriptionsDeleted":    "0",
+			"SubscriptionsPending":    "0",
+			"TopicArn":                arn,
+		},
+	}
+	if attributes["FifoTopic"] == "true" {
+		t.attributes["ContentBasedDeduplication"] = "false"
+	}
+	for _, k := range sortedKeys(attributes) {
+		if err := svc.setAttribute(t, k, attributes[k]); err != nil {
+			return nil, err
+		}
+	}
+
+	svc.topics[arn] = t
+	svc.server.tagResource(arn, queryTags(form, "Tags"))
+
+	return &snsCreateTopicResult{TopicArn: arn}, nil
+}
+
+func (svc *snsService) deleteTopic(form url.Values) (any, error) {
+	arn := form.Get("TopicArn")
+
+	// DeleteTopic is idempotent.
+	delete(svc.topics, arn)
+	svc.server.deleteTags(arn)
+
+	return nil, nil
+}
+
+type snsAttributeEntry struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+type snsGetTopicAttributesResult struct {
+	Attributes []snsAttributeEntry `xml:"Attributes>entry"`
+}
+
+func (svc *snsService) getTopicAttributes(form url.Values) (any, error) {
+	t, err := svc.findTopic(form.Get("TopicArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &snsGetTopicAttributesResult{}
+	for _, k := range sortedKeys(t.attributes) {
+		result.Attributes = append(result.Attributes, snsAttributeEntry{Key: k, Value: t.attributes[k]})
+	}
+
+	return result, nil
+}
+
+func (svc *snsService) setTopicAttributes(form url.Values) (any, error) {
+	t, err := svc.findTopic(form.Get("TopicArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the change to a copy so that a failed update leaves the topic unchanged.
+	v := &snsTopic{arn: t.arn, attributes: maps.Clone(t.attributes)}
+	if err := svc.setAttribute(v, form.Get("AttributeName"), form.Get("AttributeValue")); err != nil {
+		return nil, err
+	}
+	t.attributes = v.attributes
+
+	return nil, nil
+}
+
+type snsTopicMember struct {
+	TopicArn string `xml:"TopicArn"`
+}
+
+type snsListTopicsResult struct {
+	NextToken string           `xml:"NextToken,omitempty"`
+	Topics    []snsTopicMember `xml:"Topics>member"`
+}
+
+func (svc *snsService) listTopics(form url.Values) (any, error) {
+	var topics []snsTopicMember
+
+	for _, arn := range sortedKeys(svc.topics) {
+		topics = append(topics, snsTopicMember{TopicArn: arn})
+	}
+
+	page, nextToken, err := paginate(topics, form.Get("NextToken"), 0, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	return &snsListTopicsResult{
+		NextToken: nextToken,
+		Topics:    page,
+	}, nil
+}
+
+// snsEmptyResult is the result of operations whose response has an empty result element.
+type snsEmptyResult struct{}
+
+type snsListTagsForResourceResult struct {
+	Tags []queryTag `xml:"Tags>member"`
+}
+
+func (svc *snsService) findTaggableResource(arn string) error {
+	if _, ok := svc.topics[arn]; !ok {
+		return newAPIError("ResourceNotFound", "Resource does not exist")
+	}
+
+	return nil
+}
+
+func (svc *snsService) listTagsForResource(form url.Values) (any, error) {
+	arn := form.Get("ResourceArn")
+	if err := svc.findTaggableResource(arn); err != nil {
+		return nil, err
+	}
+
+	return &snsListTagsForResourceResult{
+		Tags: queryTagList(svc.server.listTags(arn)),
+	}, nil
+}
+
+func (svc *snsService) tagResource(form url.Values) (any, error) {
+	arn := form.Get("ResourceArn")
+	if err := svc.findTaggableResource(arn); err != nil {
+		return nil, err
+	}
+
+	tags := queryTags(form, "Tags")
+	if n := len(svc.server.listTags(arn)) + len(tags); n > 50 {
+		return nil, newAPIError("TagLimitExceeded", "Could not complete request: tag quota of per resource exceeded (%d)", n)
+	}
+
+	svc.server.tagResource(arn, tags)
+
+	return &snsEmptyResult{}, nil
+}
+
+func (svc *snsService) untagResource(form url.Values) (any, error) {
+	arn := form.Get("ResourceArn")
+	if err := svc.findTaggableResource(arn); err != nil {
+		return nil, err
+	}
+
+	svc.server.untagResource(arn, queryStrings(form, "TagKeys"))
+
+	return &snsEmptyResult{}, nil
+}
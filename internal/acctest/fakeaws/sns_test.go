@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSNSTopic(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.New()
+	conn := sns.NewFromConfig(testConfig(server), func(o *sns.Options) {
+		o.BaseEndpoint = aws.String(server.Endpoints()[names.SNS])
+	})
+
+	output, err := conn.CreateTopic(ctx, &sns.CreateTopicInput{
+		Attributes: map[string]string{"DisplayName": "fake"},
+		Name:       aws.String("fakeaws"),
+		Tags: []awstypes.Tag{
+			{Key: aws.String("key1"), Value: aws.String("value1")},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	topicARN := aws.ToString(output.TopicArn)
+
+	if _, err := conn.SetTopicAttributes(ctx, &sns.SetTopicAttributesInput{
+		AttributeName:  aws.String("SignatureVersion"),
+		AttributeValue: aws.String("2"),
+		TopicArn:       aws.String(topicARN),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	attributes, err := conn.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{
+		TopicArn: aws.String(topicARN),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for k, want := range map[string]string{
+		"DisplayName":      "fake",
+		"Owner":            fakeaws.DefaultAccountID,
+		"SignatureVersion": "2",
+		"TopicArn":         topicARN,
+	} {
+		if got := attributes.Attributes[k]; got != want {
+			t.Errorf("attribute %s = %q, want %q", k, got, want)
+		}
+	}
+
+	if _, err := conn.TagResource(ctx, &sns.TagResourceInput{
+		ResourceArn: aws.String(topicARN),
+		Tags: []awstypes.Tag{
+			{Key: aws.String("key2"), Value: aws.String("value2")},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := conn.UntagResource(ctx, &sns.UntagResourceInput{
+		ResourceArn: aws.String(topicARN),
+		TagKeys:     []string{"key1"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	tags, err := conn.ListTagsForResource(ctx, &sns.ListTagsForResourceInput{
+		ResourceArn: aws.String(topicARN),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(tags.Tags), 1; got != want {
+		t.Errorf("len(Tags) = %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteTopic(ctx, &sns.DeleteTopicInput{
+		TopicArn: aws.String(topicARN),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = conn.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{
+		TopicArn: aws.String(topicARN),
+	})
+	if !errs.IsA[*awstypes.NotFoundException](err) {
+		t.Errorf("GetTopicAttributes (deleted) error = %v, want NotFoundException", err)
+	}
+}
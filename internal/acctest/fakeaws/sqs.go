@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"maps"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sqsService is a stand-in for Amazon Simple Queue Service queues.
+type sqsService struct {
+	server *Server
+	queues map[string]*sqsQueue // Keyed by queue name.
+}
+
+type sqsQueue struct {
+	name       string
+	arn        string
+	url        string
+	attributes map[string]string
+}
+
+func newSQSService(server *Server) *sqsService {
+	return &sqsService{
+		server: server,
+		queues: make(map[string]*sqsQueue),
+	}
+}
+
+func (svc *sqsService) handler() *jsonHandler {
+	return &jsonHandler{
+		version:      "1.0",
+		targetPrefix: "AmazonSQS",
+		operations: map[string]jsonOperation{
+			"CreateQueue":        jsonOp(svc.createQueue),
+			"DeleteQueue":        jsonOp(svc.deleteQueue),
+			"GetQueueAttributes": jsonOp(svc.getQueueAttributes),
+			"GetQueueUrl":        jsonOp(svc.getQueueURL),
+			"ListQueueTags":      jsonOp(svc.listQueueTags),
+			"ListQueues":         jsonOp(svc.listQueues),
+			"SetQueueAttributes": jsonOp(svc.setQueueAttributes),
+			"TagQueue":           jsonOp(svc.tagQueue),
+			"UntagQueue":         jsonOp(svc.untagQueue),
+		},
+	}
+}
+
+func sqsQueueDoesNotExistError() *apiError {
+	err := newAPIError("com.amazonaws.sqs#QueueDoesNotExist", "The specified queue does not exist.")
+	err.queryErrorCode = "AWS.SimpleQueueService.NonExistentQueue"
+
+	return err
+}
+
+func sqsInvalidAttributeValueError(format string, a ...any) *apiError {
+	err := newAPIError("com.amazonaws.sqs#InvalidAttributeValue", format, a...)
+	err.queryErrorCode = "InvalidAttributeValue"
+
+	return err
+}
+
+// sqsDefaultAttributes are the attributes of a queue created without any explicit attributes.
+var sqsDefaultAttributes = map[string]string{
+	"DelaySeconds":                  "0",
+	"MaximumMessageSize":            "262144",
+	"MessageRetentionPeriod":        "345600",
+	"ReceiveMessageWaitTimeSeconds": "0",
+	"SqsManagedSseEnabled":          "true",
+	"VisibilityTimeout":             "30",
+}
+
+// sqsReadOnlyAttributes are the attributes maintained by the service.
+var sqsReadOnlyAttributes = []string{
+	"ApproximateNumberOfMessages",
+	"ApproximateNumberOfMessagesDelayed",
+	"ApproximateNumberOfMessagesNotVisible",
+	"CreatedTimestamp",
+	"LastModifiedTimestamp",
+	"QueueArn",
+}
+
+func (svc *sqsService) findQueueByURL(url string) (*sqsQueue, error) {
+	for _, q := range svc.queues {
+		if q.url == url {
+			return q, nil
+		}
+	}
+
+	return nil, sqsQueueDoesNotExistError()
+}
+
+func (svc *sqsService) setAttributes(q *sqsQueue, attributes map[string]string) error {
+	for k, v := range attributes {
+		switch k {
+		case "DelaySeconds", "MaximumMessageSize", "MessageRetentionPeriod", "ReceiveMessageWaitTimeSeconds", "VisibilityTimeout", "KmsDataKeyReusePeriodSeconds":
+			if _, err := strconv.Atoi(v); err != nil {
+				return sqsInvalidAttributeValueError("Invalid value for the parameter %s.", k)
+			}
+		case "FifoQueue":
+			if (v == "true") != strings.HasSuffix(q.name, ".fifo") {
+				return sqsInvalidAttributeValueError("The name of a FIFO queue can only include alphanumeric characters, hyphens, or underscores, must end with .fifo suffix.")
+			}
+		}
+
+		for _, readOnly := range sqsReadOnlyAttributes {
+			if k == readOnly {
+				return sqsInvalidAttributeValueError("Unknown Attribute %s.", k)
+			}
+		}
+
+		if v == "" && (k == "Policy" || k == "RedrivePolicy" || k == "RedriveAllowPolicy") {
+			delete(q.attributes, k)
+			continue
+		}
+		q.attributes[k] = v
+	}
+
+	if v, ok := attributes["KmsMasterKeyId"]; ok {
+		if v == "" {
+			delete(q.attributes, "KmsMasterKeyId")
+			delete(q.attributes, "KmsDataKeyReusePeriodSeconds")
+		} else {
+			q.attributes["SqsManagedSseEnabled"] = "false"
+			if _, ok := q.attributes["KmsDataKeyReusePeriodSeconds"]; !ok {
+				q.attributes["KmsDataKeyReusePeriodSeconds"] = "300"
+			}
+		}
+	}
+
+	if q.attributes["FifoQueue"] == "true" {
+		for k, v := range map[string]string{"ContentBasedDeduplication": "false", "DeduplicationScope": "queue", "FifoThroughputLimit": "perQueue"} {
+			if _, ok := q.attributes[k]; !ok {
+				q.attributes[k] = v
+			}
+		}
+	}
+
+	q.attributes["LastModifiedTimestamp"] = strconv.FormatInt(time.Now().Unix(), 10)
+
+	return nil
+}
+
+type sqsCreateQueueInput struct {
+	Attributes map[string]string `json:"Attributes"`
+	QueueName  string            `json:"QueueName"`
+	Tags       map[string]string `json:"tags"`
+}
+
+func (svc *sqsService) createQueue(input *sqsCreateQueueInput) (any, error) {
+	if input.QueueName == "" {
+		return nil, newAPIError("com.amazonaws.sqs#InvalidParameterValue", "QueueName is required")
+	}
+
+	if q, ok := svc.queues[input.QueueName]; ok {
+		// CreateQueue is idempotent if the requested attributes match the existing queue's.
+		for k, v := range input.Attributes {
+			if q.attributes[k] != v {
+				err := newAPIError("com.amazonaws.sqs#QueueNameExists", "A queue already exists with the same name and a different value for attribute %s", k)
+				err.queryErrorCode = "QueueAlreadyExists"
+				return nil, err
+			}
+		}
+
+		return map[string]any{"QueueUrl": q.url}, nil
+	}
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	q := &sqsQueue{
+		name:       input.QueueName,
+		arn:        svc.server.arn("sqs", input.QueueName),
+		url:        svc.server.endpoint("sqs") + "/" + svc.server.accountID + "/" + input.QueueName,
+		attributes: maps.Clone(sqsDefaultAttributes),
+	}
+	if err := svc.setAttributes(q, input.Attributes); err != nil {
+		return nil, err
+	}
+	q.attributes["ApproximateNumberOfMessages"] = "0"
+	q.attributes["ApproximateNumberOfMessagesDelayed"] = "0"
+	q.attributes["ApproximateNumberOfMessagesNotVisible"] = "0"
+	q.attributes["CreatedTimestamp"] = now
+	q.attributes["QueueArn"] = q.arn
+
+	svc.queues[q.name] = q
+	svc.server.tagResource(q.arn, input.Tags)
+
+	return map[string]any{"QueueUrl": q.url}, nil
+}
+
+type sqsQueueURLInput struct {
+	QueueUrl string `json:"QueueUrl"`
+}
+
+func (svc *sqsService) deleteQueue(input *sqsQueueURLInput) (any, error) {
+	q, err := svc.findQueueByURL(input.QueueUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(svc.queues, q.name)
+	svc.server.deleteTags(q.arn)
+
+	return nil, nil
+}
+
+type sqsGetQueueAttributesInput struct {
+	AttributeNames []string `json:"AttributeNames"`
+	QueueUrl       string   `json:"QueueUrl"`
+}
+
+func (svc *sqsService) getQueueAttributes(input *sqsGetQueueAttributesInput) (any, error) {
+	q, err := svc.findQueueByURL(input.QueueUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make(map[string]string)
+	for _, name := range input.AttributeNames {
+		if name == "All" {
+			maps.Copy(attributes, q.attributes)
+			break
+		}
+		if v, ok := q.attributes[name]; ok {
+			attributes[name] = v
+		}
+	}
+
+	return map[string]any{"Attributes": attributes}, nil
+}
+
+type sqsSetQueueAttributesInput struct {
+	Attributes map[string]string `json:"Attributes"`
+	QueueUrl   string            `json:"QueueUrl"`
+}
+
+func (svc *sqsService) setQueueAttributes(input *sqsSetQueueAttributesInput) (any, error) {
+	q, err := svc.findQueueByURL(input.QueueUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, svc.setAttributes(q, input.Attributes)
+}
+
+type sqsGetQueueURLInput struct {
+	QueueName string `json:"QueueName"`
+}
+
+func (svc *sqsService) getQueueURL(input *sqsGetQueueURLInput) (any, error) {
+	q, ok := svc.queues[input.QueueName]
+	if !ok {
+		return nil, sqsQueueDoesNotExistError()
+	}
+
+	return map[string]any{"QueueUrl": q.url}, nil
+}
+
+type sqsListQueuesInput struct {
+	MaxResults      int    `json:"MaxResults"`
+	NextToken       string `json:"NextToken"`
+	QueueNamePrefix string `json:"QueueNamePrefix"`
+}
+
+func (svc *sqsService) listQueues(input *sqsListQueuesInput) (any, error) {
+	var urls []string
+
+	for _, name := range sortedKeys(svc.queues) {
+		if strings.HasPrefix(name, input.QueueNamePrefix) {
+			urls = append(urls, svc.queues[name].url)
+		}
+	}
+
+	page, nextToken, err := paginate(urls, input.NextToken, input.MaxResults, 1000)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"NextToken": omitEmpty(nextToken),
+		"QueueUrls": page,
+	}, nil
+}
+
+func (svc *sqsService) listQueueTags(input *sqsQueueURLInput) (any, error) {
+	q, err := svc.findQueueByURL(input.QueueUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{"Tags": svc.server.listTags(q.arn)}, nil
+}
+
+type sqsTagQueueInput struct {
+	QueueUrl string            `json:"QueueUrl"`
+	Tags     map[string]string `json:"Tags"`
+}
+
+func (svc *sqsService) tagQueue(input *sqsTagQueueInput) (any, error) {
+	q, err := svc.findQueueByURL(input.QueueUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.server.tagResource(q.arn, input.Tags)
+
+	return nil, nil
+}
+
+type sqsUntagQueueInput struct {
+	QueueUrl string   `json:"QueueUrl"`
+	TagKeys  []string `json:"TagKeys"`
+}
+
+func (svc *sqsService) untagQueue(input *sqsUntagQueueInput) (any, error) {
+	q, err := svc.findQueueByURL(input.QueueUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.server.untagResource(q.arn, input.TagKeys)
+
+	return nil, nil
+}
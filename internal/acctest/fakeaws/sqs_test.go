@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSQSQueue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.New()
+	conn := sqs.NewFromConfig(testConfig(server), func(o *sqs.Options) {
+		o.BaseEndpoint = aws.String(server.Endpoints()[names.SQS])
+	})
+
+	output, err := conn.CreateQueue(ctx, &sqs.CreateQueueInput{
+		Attributes: map[string]string{
+			string(awstypes.QueueAttributeNameVisibilityTimeout): "60",
+		},
+		QueueName: aws.String("fakeaws"),
+		Tags:      map[string]string{"key1": "value1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	queueURL := aws.ToString(output.QueueUrl)
+
+	attributes, err := conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		AttributeNames: []awstypes.QueueAttributeName{awstypes.QueueAttributeNameAll},
+		QueueUrl:       aws.String(queueURL),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := attributes.Attributes[string(awstypes.QueueAttributeNameVisibilityTimeout)], "60"; got != want {
+		t.Errorf("VisibilityTimeout = %q, want %q", got, want)
+	}
+	if got, want := attributes.Attributes[string(awstypes.QueueAttributeNameQueueArn)], "arn:aws:sqs:us-west-2:123456789012:fakeaws"; got != want { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("QueueArn = %q, want %q", got, want)
+	}
+
+	tags, err := conn.ListQueueTags(ctx, &sqs.ListQueueTagsInput{
+		QueueUrl: aws.String(queueURL),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := tags.Tags["key1"], "value1"; got != want {
+		t.Errorf("tag key1 = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteQueue(ctx, &sqs.DeleteQueueInput{
+		QueueUrl: aws.String(queueURL),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		AttributeNames: []awstypes.QueueAttributeName{awstypes.QueueAttributeNameAll},
+		QueueUrl:       aws.String(queueURL),
+	})
+	if !tfawserr.ErrCodeEquals(err, "AWS.SimpleQueueService.NonExistentQueue") {
+		t.Errorf("GetQueueAttributes (deleted) error = %v, want AWS.SimpleQueueService.NonExistentQueue", err)
+	}
+}
@@ -0,0 +1,457 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ssmService is a stand-in for AWS Systems Manager Parameter Store.
+type ssmService struct {
+	server     *Server
+	parameters map[string]*ssmParameter
+}
+
+type ssmParameter struct {
+	name             string
+	arn              string
+	allowedPattern   string
+	dataType         string
+	description      string
+	keyID            string
+	lastModifiedDate time.Time
+	tier             string
+	typ              string
+	value            string
+	version          int64
+}
+
+func newSSMService(server *Server) *ssmService {
+	return &ssmService{
+		server:     server,
+		parameters: make(map[string]*ssmParameter),
+	}
+}
+
+func (svc *ssmService) handler() *jsonHandler {
+	return &jsonHandler{
+		version:      "1.1",
+		targetPrefix: "AmazonSSM",
+		operations: map[string]jsonOperation{
+			"AddTagsToResource":      jsonOp(svc.addTagsToResource),
+			"DeleteParameter":        jsonOp(svc.deleteParameter),
+			"DeleteParameters":       jsonOp(svc.deleteParameters),
+			"DescribeParameters":     jsonOp(svc.describeParameters),
+			"GetParameter":           jsonOp(svc.getParameter),
+			"GetParameters":          jsonOp(svc.getParameters),
+			"GetParametersByPath":    jsonOp(svc.getParametersByPath),
+			"ListTagsForResource":    jsonOp(svc.listTagsForResource),
+			"PutParameter":           jsonOp(svc.putParameter),
+			"RemoveTagsFromResource": jsonOp(svc.removeTagsFromResource),
+		},
+	}
+}
+
+func ssmParameterNotFoundError(name string) *apiError {
+	return newAPIError("ParameterNotFound", "Parameter %s not found.", name)
+}
+
+func (svc *ssmService) parameterARN(name string) string {
+	return svc.server.arn("ssm", "parameter/"+strings.TrimPrefix(name, "/"))
+}
+
+type ssmPutParameterInput struct {
+	AllowedPattern string        `json:"AllowedPattern"`
+	DataType       string        `json:"DataType"`
+	Description    *string       `json:"Description"`
+	KeyId          string        `json:"KeyId"`
+	Name           string        `json:"Name"`
+	Overwrite      bool          `json:"Overwrite"`
+	Tags           []tagKeyValue `json:"Tags"`
+	Tier           string        `json:"Tier"`
+	Type           string        `json:"Type"`
+	Value          string        `json:"Value"`
+}
+
+func (svc *ssmService) putParameter(input *ssmPutParameterInput) (any, error) {
+	if input.Name == "" || input.Value == "" {
+		return nil, validationError("Name and Value are required")
+	}
+
+	if input.Overwrite && len(input.Tags) > 0 {
+		return nil, validationError("tags and overwrite can't be used together. To create a parameter with tags, please remove overwrite flag. To update tags for an existing parameter, please use AddTagsToResource or RemoveTagsFromResource.")
+	}
+
+	if input.AllowedPattern != "" {
+		re, err := regexp.Compile(input.AllowedPattern)
+		if err != nil {
+			return nil, validationError("invalid AllowedPattern: %s", err)
+		}
+		if !re.MatchString(input.Value) {
+			return nil, newAPIError("ParameterPatternMismatchException", "Parameter value, cannot be validated against allowedPattern: %s", input.AllowedPattern)
+		}
+	}
+
+	p, ok := svc.parameters[input.Name]
+	if ok {
+		if !input.Overwrite {
+			return nil, newAPIError("ParameterAlreadyExists", "The parameter already exists. To overwrite this value, set the overwrite option in the request to true.")
+		}
+	} else {
+		if input.Type == "" {
+			return nil, validationError("A parameter type is required when you create a parameter.")
+		}
+
+		p = &ssmParameter{
+			name:     input.Name,
+			arn:      svc.parameterARN(input.Name),
+			dataType: "text",
+			tier:     "Standard",
+		}
+		svc.parameters[p.name] = p
+		svc.server.tagResource(p.arn, tagsFromKeyValues(input.Tags))
+	}
+
+	p.allowedPattern = input.AllowedPattern
+	if input.DataType != "" {
+		p.dataType = input.DataType
+	}
+	if input.Description != nil {
+		p.description = *input.Description
+	}
+	if input.Tier != "" && input.Tier != "Intelligent-Tiering" {
+		p.tier = input.Tier
+	}
+	if input.Type != "" {
+		p.typ = input.Type
+	}
+	p.keyID = ""
+	if p.typ == "SecureString" {
+		p.keyID = input.KeyId
+		if p.keyID == "" {
+			p.keyID = "alias/aws/ssm"
+		}
+	}
+	p.lastModifiedDate = time.Now()
+	p.value = input.Value
+	p.version++
+
+	return map[string]any{
+		"Tier":    p.tier,
+		"Version": p.version,
+	}, nil
+}
+
+type ssmParameterOutput struct {
+	ARN              string  `json:"ARN"`
+	DataType         string  `json:"DataType"`
+	LastModifiedDate float64 `json:"LastModifiedDate"`
+	Name             string  `json:"Name"`
+	Type             string  `json:"Type"`
+	Value            string  `json:"Value"`
+	Version          int64   `json:"Version"`
+}
+
+func (p *ssmParameter) output(withDecryption bool) ssmParameterOutput {
+	value := p.value
+	if p.typ == "SecureString" && !withDecryption {
+		// Real ciphertext is not needed, only that the plaintext is not returned.
+		value = "ENCRYPTED:" + p.keyID
+	}
+
+	return ssmParameterOutput{
+		ARN:              p.arn,
+		DataType:         p.dataType,
+		LastModifiedDate: epochSeconds(p.lastModifiedDate),
+		Name:             p.name,
+		Type:             p.typ,
+		Value:            value,
+		Version:          p.version,
+	}
+}
+
+type ssmGetParameterInput struct {
+	Name           string `json:"Name"`
+	WithDecryption bool   `json:"WithDecryption"`
+}
+
+func (svc *ssmService) getParameter(input *ssmGetParameterInput) (any, error) {
+	name, _, _ := strings.Cut(input.Name, ":") // Version and label selectors are not supported.
+
+	p, ok := svc.parameters[name]
+	if !ok {
+		return nil, ssmParameterNotFoundError(input.Name)
+	}
+
+	return map[string]any{
+		"Parameter": p.output(input.WithDecryption),
+	}, nil
+}
+
+type ssmGetParametersInput struct {
+	Names          []string `json:"Names"`
+	WithDecryption bool     `json:"WithDecryption"`
+}
+
+func (svc *ssmService) getParameters(input *ssmGetParametersInput) (any, error) {
+	parameters := []ssmParameterOutput{}
+	invalidParameters := []string{}
+
+	for _, name := range input.Names {
+		if p, ok := svc.parameters[name]; ok {
+			parameters = append(parameters, p.output(input.WithDecryption))
+		} else {
+			invalidParameters = append(invalidParameters, name)
+		}
+	}
+
+	return map[string]any{
+		"InvalidParameters": invalidParameters,
+		"Parameters":        parameters,
+	}, nil
+}
+
+type ssmGetParametersByPathInput struct {
+	MaxResults     int    `json:"MaxResults"`
+	NextToken      string `json:"NextToken"`
+	Path           string `json:"Path"`
+	Recursive      bool   `json:"Recursive"`
+	WithDecryption bool   `json:"WithDecryption"`
+}
+
+func (svc *ssmService) getParametersByPath(input *ssmGetParametersByPathInput) (any, error) {
+	path := strings.TrimSuffix(input.Path, "/") + "/"
+	var parameters []ssmParameterOutput
+
+	for _, name := range sortedKeys(svc.parameters) {
+		rest, ok := strings.CutPrefix(name, path)
+		if !ok || (!input.Recursive && strings.Contains(rest, "/")) {
+			continue
+		}
+		parameters = append(parameters, svc.parameters[name].output(input.WithDecryption))
+	}
+
+	page, nextToken, err := paginate(parameters, input.NextToken, input.MaxResults, 10)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"NextToken":  omitEmpty(nextToken),
+		"Parameters": page,
+	}, nil
+}
+
+type ssmDeleteParameterInput struct {
+	Name string `json:"Name"`
+}
+
+func (svc *ssmService) deleteParameter(input *ssmDeleteParameterInput) (any, error) {
+	p, ok := svc.parameters[input.Name]
+	if !ok {
+		return nil, ssmParameterNotFoundError(input.Name)
+	}
+
+	delete(svc.parameters, p.name)
+	svc.server.deleteTags(p.arn)
+
+	return nil, nil
+}
+
+type ssmDeleteParametersInput struct {
+	Names []string `json:"Names"`
+}
+
+func (svc *ssmService) deleteParameters(input *ssmDeleteParametersInput) (any, error) {
+	deletedParameters := []string{}
+	invalidParameters := []string{}
+
+	for _, name := range input.Names {
+		if p, ok := svc.parameters[name]; ok {
+			delete(svc.parameters, p.name)
+			svc.server.deleteTags(p.arn)
+			deletedParameters = append(deletedParameters, name)
+		} else {
+			invalidParameters = append(invalidParameters, name)
+		}
+	}
+
+	return map[string]any{
+		"DeletedParameters": deletedParameters,
+		"InvalidParameters": invalidParameters,
+	}, nil
+}
+
+type ssmDescribeParametersInput struct {
+	Filters []struct {
+		Key    string   `json:"Key"`
+		Values []string `json:"Values"`
+	} `json:"Filters"`
+	MaxResults       int    `json:"MaxResults"`
+	NextToken        string `json:"NextToken"`
+	ParameterFilters []struct {
+		Key    string   `json:"Key"`
+		Option string   `json:"Option"`
+		Values []string `json:"Values"`
+	} `json:"ParameterFilters"`
+}
+
+type ssmParameterMetadataOutput struct {
+	ARN              string  `json:"ARN"`
+	AllowedPattern   string  `json:"AllowedPattern,omitempty"`
+	DataType         string  `json:"DataType"`
+	Description      string  `json:"Description,omitempty"`
+	KeyId            string  `json:"KeyId,omitempty"`
+	LastModifiedDate float64 `json:"LastModifiedDate"`
+	Name             string  `json:"Name"`
+	Tier             string  `json:"Tier"`
+	Type             string  `json:"Type"`
+	Version          int64   `json:"Version"`
+}
+
+func (svc *ssmService) describeParameters(input *ssmDescribeParametersInput) (any, error) {
+	var parameters []ssmParameterMetadataOutput
+
+	for _, name := range sortedKeys(svc.parameters) {
+		p := svc.parameters[name]
+
+		match := true
+		for _, filter := range input.ParameterFilters {
+			match = match && ssmMatchFilter(p, filter.Key, filter.Option, filter.Values)
+		}
+		for _, filter := range input.Filters {
+			match = match && ssmMatchFilter(p, filter.Key, "Equals", filter.Values)
+		}
+		if !match {
+			continue
+		}
+
+		parameters = append(parameters, ssmParameterMetadataOutput{
+			ARN:              p.arn,
+			AllowedPattern:   p.allowedPattern,
+			DataType:         p.dataType,
+			Description:      p.description,
+			KeyId:            p.keyID,
+			LastModifiedDate: epochSeconds(p.lastModifiedDate),
+			Name:             p.name,
+			Tier:             p.tier,
+			Type:             p.typ,
+			Version:          p.version,
+		})
+	}
+
+	page, nextToken, err := paginate(parameters, input.NextToken, input.MaxResults, 10)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"NextToken":  omitEmpty(nextToken),
+		"Parameters": page,
+	}, nil
+}
+
+func ssmMatchFilter(p *ssmParameter, key, option string, values []string) bool {
+	var v string
+	switch key {
+	case "Name":
+		v = p.name
+	case "Type":
+		v = p.typ
+	case "KeyId":
+		v = p.keyID
+	case "Tier":
+		v = p.tier
+	case "DataType":
+		v = p.dataType
+	case "Path":
+		path := strings.TrimSuffix(firstOrEmpty(values), "/") + "/"
+		rest, ok := strings.CutPrefix(p.name, path)
+		return ok && (option == "Recursive" || !strings.Contains(rest, "/"))
+	default:
+		return false
+	}
+
+	for _, value := range values {
+		switch option {
+		case "BeginsWith":
+			if strings.HasPrefix(v, value) {
+				return true
+			}
+		case "Contains":
+			if strings.Contains(v, value) {
+				return true
+			}
+		default:
+			if v == value {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+type ssmTagResourceInput struct {
+	ResourceId   string        `json:"ResourceId"`
+	ResourceType string        `json:"ResourceType"`
+	Tags         []tagKeyValue `json:"Tags"`
+	TagKeys      []string      `json:"TagKeys"`
+}
+
+func (svc *ssmService) findResourceARN(resourceType, resourceID string) (string, error) {
+	if resourceType != "Parameter" {
+		return "", newAPIError("InvalidResourceType", "resource type %q is not supported", resourceType)
+	}
+
+	p, ok := svc.parameters[resourceID]
+	if !ok {
+		return "", newAPIError("InvalidResourceId", "The resource ID %q is not valid. Verify the ID and try again.", resourceID)
+	}
+
+	return p.arn, nil
+}
+
+func (svc *ssmService) addTagsToResource(input *ssmTagResourceInput) (any, error) {
+	arn, err := svc.findResourceARN(input.ResourceType, input.ResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.server.tagResource(arn, tagsFromKeyValues(input.Tags))
+
+	return nil, nil
+}
+
+func (svc *ssmService) removeTagsFromResource(input *ssmTagResourceInput) (any, error) {
+	arn, err := svc.findResourceARN(input.ResourceType, input.ResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.server.untagResource(arn, input.TagKeys)
+
+	return nil, nil
+}
+
+func (svc *ssmService) listTagsForResource(input *ssmTagResourceInput) (any, error) {
+	arn, err := svc.findResourceARN(input.ResourceType, input.ResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"TagList": tagKeyValues(svc.server.listTags(arn)),
+	}, nil
+}
+
+func firstOrEmpty(s []string) string {
+	if len(s) == 0 {
+		return ""
+	}
+
+	return s[0]
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSSMParameter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.New()
+	conn := ssm.NewFromConfig(testConfig(server), func(o *ssm.Options) {
+		o.BaseEndpoint = aws.String(server.Endpoints()[names.SSM])
+	})
+	name := "/fakeaws/param"
+
+	if _, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:  aws.String(name),
+		Type:  awstypes.ParameterTypeString,
+		Value: aws.String("v1"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:  aws.String(name),
+		Type:  awstypes.ParameterTypeString,
+		Value: aws.String("v2"),
+	})
+	if !errs.IsA[*awstypes.ParameterAlreadyExists](err) {
+		t.Errorf("PutParameter (no overwrite) error = %v, want ParameterAlreadyExists", err)
+	}
+
+	if _, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:      aws.String(name),
+		Overwrite: aws.Bool(true),
+		Type:      awstypes.ParameterTypeString,
+		Value:     aws.String("v2"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	output, err := conn.GetParameter(ctx, &ssm.GetParameterInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := aws.ToString(output.Parameter.Value), "v2"; got != want {
+		t.Errorf("Value = %q, want %q", got, want)
+	}
+	if got, want := output.Parameter.Version, int64(2); got != want {
+		t.Errorf("Version = %d, want %d", got, want)
+	}
+
+	byPath, err := conn.GetParametersByPath(ctx, &ssm.GetParametersByPathInput{
+		Path: aws.String("/fakeaws"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(byPath.Parameters), 1; got != want {
+		t.Errorf("len(Parameters) = %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteParameter(ctx, &ssm.DeleteParameterInput{
+		Name: aws.String(name),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = conn.GetParameter(ctx, &ssm.GetParameterInput{
+		Name: aws.String(name),
+	})
+	if !errs.IsA[*awstypes.ParameterNotFound](err) {
+		t.Errorf("GetParameter (deleted) error = %v, want ParameterNotFound", err)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// stsService is a stand-in for the AWS Security Token Service, used by the provider to discover the caller's account.
+type stsService struct {
+	server *Server
+}
+
+func newSTSService(server *Server) *stsService {
+	return &stsService{
+		server: server,
+	}
+}
+
+func (svc *stsService) handler() *queryHandler {
+	return &queryHandler{
+		xmlns: "https://sts.amazonaws.com/doc/2011-06-15/",
+		operations: map[string]queryOperation{
+			"GetCallerIdentity": svc.getCallerIdentity,
+		},
+	}
+}
+
+type stsGetCallerIdentityResult struct {
+	Account string `xml:"Account"`
+	Arn     string `xml:"Arn"`
+	UserId  string `xml:"UserId"`
+}
+
+func (svc *stsService) getCallerIdentity(url.Values) (any, error) {
+	return &stsGetCallerIdentityResult{
+		Account: svc.server.accountID,
+		Arn:     fmt.Sprintf("arn:aws:iam::%s:user/fakeaws", svc.server.accountID),
+		UserId:  "AIDAFAKEAWSFAKEAWSFAKE",
+	}, nil
+}
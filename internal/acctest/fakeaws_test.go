@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
+	tfsns "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestFakeAWSResourceTest(t *testing.T) { //nolint:paralleltest // acctest.FakeAWSResourceTest sets environment variables.
+	ctx := acctest.Context(t)
+	rName := "tf-fake-test"
+
+	testCases := map[string]acctest.FakeAWSResourceTestCase{
+		"aws_cloudwatch_log_group": {
+			ServicePackage: tflogs.ServicePackage(ctx),
+			Config: map[string]any{
+				names.AttrName: rName,
+				names.AttrTags: map[string]any{
+					acctest.CtKey1: acctest.CtValue1,
+				},
+			},
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("aws_cloudwatch_log_group.test", names.AttrARN, "arn:aws:logs:us-west-2:123456789012:log-group:"+rName), //lintignore:AWSAT003,AWSAT005
+				resource.TestCheckResourceAttr("aws_cloudwatch_log_group.test", "log_group_class", "STANDARD"),
+				resource.TestCheckResourceAttr("aws_cloudwatch_log_group.test", "retention_in_days", acctest.Ct0),
+				resource.TestCheckResourceAttr("aws_cloudwatch_log_group.test", acctest.CtTagsKey1, acctest.CtValue1),
+			),
+			UpdateConfig: map[string]any{
+				names.AttrName:      rName,
+				"retention_in_days": 7,
+				names.AttrTags: map[string]any{
+					acctest.CtKey2: acctest.CtValue2,
+				},
+			},
+			CheckUpdate: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("aws_cloudwatch_log_group.test", "retention_in_days", "7"),
+				resource.TestCheckResourceAttr("aws_cloudwatch_log_group.test", acctest.CtTagsPercent, acctest.Ct1),
+				resource.TestCheckResourceAttr("aws_cloudwatch_log_group.test", acctest.CtTagsKey2, acctest.CtValue2),
+			),
+		},
+		"aws_dynamodb_table": {
+			ServicePackage: tfdynamodb.ServicePackage(ctx),
+			Config: map[string]any{
+				"attribute": []any{
+					map[string]any{
+						names.AttrName: "pk",
+						names.AttrType: "S",
+					},
+				},
+				"billing_mode":   "PROVISIONED",
+				"hash_key":       "pk",
+				names.AttrName:   rName,
+				"read_capacity":  1,
+				"write_capacity": 1,
+			},
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("aws_dynamodb_table.test", names.AttrARN, "arn:aws:dynamodb:us-west-2:123456789012:table/"+rName), //lintignore:AWSAT003,AWSAT005
+				resource.TestCheckResourceAttr("aws_dynamodb_table.test", "deletion_protection_enabled", acctest.CtFalse),
+				resource.TestCheckResourceAttr("aws_dynamodb_table.test", "table_class", "STANDARD"),
+			),
+			UpdateConfig: map[string]any{
+				"attribute": []any{
+					map[string]any{
+						names.AttrName: "pk",
+						names.AttrType: "S",
+					},
+				},
+				"billing_mode":   "PROVISIONED",
+				"hash_key":       "pk",
+				names.AttrName:   rName,
+				"read_capacity":  2,
+				"write_capacity": 2,
+			},
+			CheckUpdate: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("aws_dynamodb_table.test", "read_capacity", acctest.Ct2),
+				resource.TestCheckResourceAttr("aws_dynamodb_table.test", "write_capacity", acctest.Ct2),
+			),
+		},
+		"aws_secretsmanager_secret": {
+			ServicePackage: tfsecretsmanager.ServicePackage(ctx),
+			Config: map[string]any{
+				names.AttrName: rName,
+			},
+			Check: resource.ComposeAggregateTestCheckFunc(
+				acctest.CheckResourceAttrHasPrefix("aws_secretsmanager_secret.test", names.AttrARN, "arn:aws:secretsmanager:us-west-2:123456789012:secret:"+rName+"-"), //lintignore:AWSAT003,AWSAT005
+				resource.TestCheckResourceAttr("aws_secretsmanager_secret.test", "recovery_window_in_days", "30"),
+			),
+			UpdateConfig: map[string]any{
+				names.AttrDescription: "updated",
+				names.AttrName:        rName,
+			},
+			CheckUpdate:             resource.TestCheckResourceAttr("aws_secretsmanager_secret.test", names.AttrDescription, "updated"),
+			ImportStateVerifyIgnore: []string{"recovery_window_in_days", "force_overwrite_replica_secret"},
+		},
+		"aws_sns_topic": {
+			ServicePackage: tfsns.ServicePackage(ctx),
+			Config: map[string]any{
+				names.AttrName: rName,
+			},
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("aws_sns_topic.test", names.AttrARN, "arn:aws:sns:us-west-2:123456789012:"+rName), //lintignore:AWSAT003,AWSAT005
+				resource.TestCheckResourceAttr("aws_sns_topic.test", names.AttrOwner, "123456789012"),
+				resource.TestCheckResourceAttrSet("aws_sns_topic.test", names.AttrPolicy),
+			),
+			UpdateConfig: map[string]any{
+				names.AttrDisplayName: "updated",
+				names.AttrName:        rName,
+			},
+			CheckUpdate: resource.TestCheckResourceAttr("aws_sns_topic.test", names.AttrDisplayName, "updated"),
+		},
+		"aws_sqs_queue": {
+			ServicePackage: tfsqs.ServicePackage(ctx),
+			Config: map[string]any{
+				names.AttrName: rName,
+			},
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("aws_sqs_queue.test", names.AttrARN, "arn:aws:sqs:us-west-2:123456789012:"+rName), //lintignore:AWSAT003,AWSAT005
+				resource.TestCheckResourceAttr("aws_sqs_queue.test", "sqs_managed_sse_enabled", acctest.CtTrue),
+				resource.TestCheckResourceAttrPair("aws_sqs_queue.test", names.AttrURL, "aws_sqs_queue.test", names.AttrID),
+			),
+			UpdateConfig: map[string]any{
+				names.AttrName:               rName,
+				"delay_seconds":              90,
+				"visibility_timeout_seconds": 60,
+			},
+			CheckUpdate: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("aws_sqs_queue.test", "delay_seconds", "90"),
+				resource.TestCheckResourceAttr("aws_sqs_queue.test", "visibility_timeout_seconds", "60"),
+			),
+		},
+		"aws_ssm_parameter": {
+			ServicePackage: tfssm.ServicePackage(ctx),
+			Config: map[string]any{
+				names.AttrName:  "/" + rName + "/parameter",
+				names.AttrType:  "String",
+				names.AttrValue: "test1",
+			},
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("aws_ssm_parameter.test", names.AttrARN, "arn:aws:ssm:us-west-2:123456789012:parameter/"+rName+"/parameter"), //lintignore:AWSAT003,AWSAT005
+				resource.TestCheckResourceAttr("aws_ssm_parameter.test", names.AttrVersion, acctest.Ct1),
+			),
+			UpdateConfig: map[string]any{
+				names.AttrName:  "/" + rName + "/parameter",
+				names.AttrType:  "String",
+				names.AttrValue: "test2",
+			},
+			CheckUpdate: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("aws_ssm_parameter.test", names.AttrValue, "test2"),
+				resource.TestCheckResourceAttr("aws_ssm_parameter.test", names.AttrVersion, acctest.Ct2),
+			),
+			ImportStateVerifyIgnore: []string{"overwrite"},
+		},
+	}
+
+	for resourceType, testCase := range testCases { //nolint:paralleltest // acctest.FakeAWSResourceTest sets environment variables.
+		t.Run(resourceType, func(t *testing.T) {
+			testCase.ResourceType = resourceType
+
+			acctest.FakeAWSResourceTest(ctx, t, testCase)
+		})
+	}
+}
@@ -9,26 +9,35 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"math/rand"
+	"mime"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 	"gopkg.in/dnaeon/go-vcr.v3/recorder"
@@ -39,12 +48,88 @@ const (
 	envVarVCRPath = "VCR_PATH"
 )
 
+const (
+	// vcrProviderCassetteName is the name of the cassette recording the configuration of the acctest Provider.
+	vcrProviderCassetteName = "Provider"
+
+	// vcrAccountIDPlaceholder replaces the first AWS account ID found in a cassette.
+	// Subsequent account IDs are replaced by consecutive values.
+	vcrAccountIDPlaceholder = 123456789012
+
+	// vcrRedacted replaces credentials and signatures in cassettes.
+	vcrRedacted = "REDACTED"
+)
+
+var (
+	// vcrCredentialsRegexps match credentials in recorded request and response bodies.
+	// The first submatch is kept, the credential value is replaced, and the second submatch is kept.
+	vcrCredentialsRegexps = []*regexp.Regexp{
+		regexache.MustCompile(`(<(?:AccessKeyId|SecretAccessKey|SecretKey|SessionToken)>)[^<]*(</)`),
+		regexache.MustCompile(`("(?i:accessKeyId|secretAccessKey|secretKey|sessionToken)"\s*:\s*")[^"]*(")`),
+		regexache.MustCompile(`((?:^|&)(?:AccessKeyId|SecretAccessKey|SessionToken)=)[^&]*()`),
+	}
+
+	// vcrSignatureQueryParameterRegexp matches SigV4 query string parameters, for example in presigned URLs.
+	vcrSignatureQueryParameterRegexp = regexache.MustCompile(`((?i:X-Amz-Signature|X-Amz-Credential|X-Amz-Security-Token)=)[^&"<\s\\]*`)
+
+	// vcrSignatureQueryParameters are ignored when matching requests.
+	vcrSignatureQueryParameters = []string{
+		"X-Amz-Credential",
+		"X-Amz-Date",
+		"X-Amz-Security-Token",
+		"X-Amz-Signature",
+	}
+
+	// vcrIdempotencyTokens are request parameters whose values are generated for each request.
+	// They are ignored when matching requests.
+	vcrIdempotencyTokens = []string{
+		"CallerReference",
+		"ClientRequestToken",
+		"ClientToken",
+		"IdempotencyToken",
+	}
+)
+
+type contextKeyType int
+
+const (
+	contextKeyVCRCassette contextKeyType = iota
+)
+
 type randomnessSource struct {
 	seed   int64
 	source rand.Source
 }
 
-type metaMap map[string]*conns.AWSClient
+// vcrCassette records a single test's AWS API interactions.
+// The cassette is shared by all provider configurations used in the test and
+// by API clients obtained from the acctest Provider using the test's Context.
+type vcrCassette struct {
+	accountIDs []string // In the order found.
+	recorder   *recorder.Recorder
+}
+
+type cassetteMap map[string]*vcrCassette
+
+func (m cassetteMap) Lock() {
+	conns.GlobalMutexKV.Lock(m.key())
+}
+
+func (m cassetteMap) Unlock() {
+	conns.GlobalMutexKV.Unlock(m.key())
+}
+
+func (m cassetteMap) key() string {
+	return "vcr-cassettes"
+}
+
+// vcrProviderMetas are the provider instance states configured in a single test.
+type vcrProviderMetas struct {
+	byConfiguration map[string]*conns.AWSClient
+	first           *conns.AWSClient
+}
+
+type metaMap map[string]*vcrProviderMetas
 
 func (m metaMap) Lock() {
 	conns.GlobalMutexKV.Lock(m.key())
@@ -73,7 +158,8 @@ func (m randomnessSourceMap) key() string {
 }
 
 var (
-	providerMetas     = metaMap(make(map[string]*conns.AWSClient, 0))
+	cassettes         = cassetteMap(make(map[string]*vcrCassette, 0))
+	providerMetas     = metaMap(make(map[string]*vcrProviderMetas, 0))
 	randomnessSources = randomnessSourceMap(make(map[string]*randomnessSource, 0))
 )
 
@@ -82,14 +168,14 @@ func ProviderMeta(_ context.Context, t *testing.T) *conns.AWSClient {
 	t.Helper()
 
 	providerMetas.Lock()
-	meta, ok := providerMetas[t.Name()]
+	metas, ok := providerMetas[t.Name()]
 	defer providerMetas.Unlock()
 
-	if !ok {
-		meta = Provider.Meta().(*conns.AWSClient)
+	if ok && metas.first != nil {
+		return metas.first
 	}
 
-	return meta
+	return Provider.Meta().(*conns.AWSClient)
 }
 
 func isVCREnabled() bool {
@@ -107,6 +193,16 @@ func vcrMode() (recorder.Mode, error) {
 	}
 }
 
+// vcrContext returns a Context whose AWS API calls are recorded in the named cassette.
+func vcrContext(ctx context.Context, cassetteName string) context.Context {
+	return context.WithValue(ctx, contextKeyVCRCassette, cassetteName)
+}
+
+func vcrCassetteNameFromContext(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(contextKeyVCRCassette).(string)
+	return v, ok
+}
+
 // vcrEnabledProtoV5ProviderFactories returns ProtoV5ProviderFactories ready for use with VCR.
 func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input map[string]func() (tfprotov5.ProviderServer, error)) map[string]func() (tfprotov5.ProviderServer, error) {
 	t.Helper()
@@ -121,6 +217,8 @@ func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input
 				return nil, err
 			}
 
+			// The Plugin Framework provider server's instance state is the primary (Plugin SDK) provider's Meta(),
+			// so configuring the primary provider for VCR also covers Framework resources, data sources and ephemeral resources.
 			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, t.Name())
 
 			return providerServerFactory(), nil
@@ -131,163 +229,639 @@ func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input
 }
 
 // vcrProviderConfigureContextFunc returns a provider configuration function returning cached provider instance state.
-// This is necessary as ConfigureContextFunc is called multiple times for a given test, each time creating a new HTTP client.
-// VCR requires a single HTTP client to handle all interactions.
+// This is necessary as ConfigureContextFunc is called multiple times for a given test and provider configuration,
+// each time creating a new HTTP client. Instance state is cached for each distinct provider configuration
+// (for example the alternate Region provider) so that all provider instances record to the test's cassette.
 func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc, testName string) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		var diags diag.Diagnostics
 
+		key := vcrProviderConfigurationKey(provider, d)
+
 		providerMetas.Lock()
-		meta, ok := providerMetas[testName]
+		metas, ok := providerMetas[testName]
 		defer providerMetas.Unlock()
 
-		if ok {
+		if !ok {
+			metas = &vcrProviderMetas{
+				byConfiguration: make(map[string]*conns.AWSClient),
+			}
+			providerMetas[testName] = metas
+		}
+
+		if meta, ok := metas.byConfiguration[key]; ok {
 			return meta, nil
 		}
 
-		vcrMode, err := vcrMode()
+		// Use the VCR HTTP client for AWS APIs.
+		// As the HTTP client is used in the provider's ConfigureContextFunc
+		// we must do this setup before calling the ConfigureContextFunc.
+		var meta *conns.AWSClient
+		if v, ok := provider.Meta().(*conns.AWSClient); ok {
+			meta = v
+		} else {
+			meta = new(conns.AWSClient)
+		}
+		meta.SetHTTPClient(ctx, vcrHTTPClient(testName))
+		provider.SetMeta(meta)
 
-		if err != nil {
+		if v, ds := configureContextFunc(ctx, d); ds.HasError() {
+			return nil, append(diags, ds...)
+		} else {
+			diags = append(diags, ds...)
+			meta = v.(*conns.AWSClient)
+		}
+
+		if err := vcrAddAccountID(ctx, testName, meta.AccountID); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
-		// Cribbed from aws-sdk-go-base.
-		httpClient := cleanhttp.DefaultPooledClient()
-		transport := httpClient.Transport.(*http.Transport)
-		transport.MaxIdleConnsPerHost = 10
-		tlsConfig := transport.TLSClientConfig
-		if tlsConfig == nil {
-			tlsConfig = &tls.Config{}
-			transport.TLSClientConfig = tlsConfig
+		if metas.first == nil {
+			metas.first = meta
 		}
-		tlsConfig.MinVersion = tls.VersionTLS12
+		metas.byConfiguration[key] = meta
+
+		return meta, diags
+	}
+}
 
-		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
+// vcrProviderConfigurationKey returns a key identifying a provider configuration.
+func vcrProviderConfigurationKey(provider *schema.Provider, d *schema.ResourceData) string {
+	var sb strings.Builder
 
-		// Create a VCR recorder around a default HTTP client.
-		r, err := recorder.NewWithOptions(&recorder.Options{
-			CassetteName:  path,
-			Mode:          vcrMode,
-			RealTransport: httpClient.Transport,
-		})
+	keys := tfmaps.Keys(provider.Schema)
+	slices.Sort(keys)
+	for _, k := range keys {
+		fmt.Fprintf(&sb, "%s=%v;", k, vcrExpandSets(d.Get(k)))
+	}
 
-		if err != nil {
-			return nil, sdkdiag.AppendFromErr(diags, err)
+	return sb.String()
+}
+
+// vcrExpandSets replaces any sets in v by their elements, so that v can be formatted consistently.
+func vcrExpandSets(v any) any {
+	switch v := v.(type) {
+	case *schema.Set:
+		return vcrExpandSets(v.List())
+	case []any:
+		for i, e := range v {
+			v[i] = vcrExpandSets(e)
 		}
+	case map[string]any:
+		for k, e := range v {
+			v[k] = vcrExpandSets(e)
+		}
+	}
 
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
+	return v
+}
 
-			return nil
-		}, recorder.AfterCaptureHook)
+// vcrConfigureProvider configures the acctest Provider for use with VCR.
+// AWS API interactions made during configuration are recorded in a dedicated cassette.
+// Interactions made subsequently using the acctest Provider's API clients are recorded in
+// the cassette of the test associated with each call's Context (see Context()).
+func vcrConfigureProvider(ctx context.Context, provider *schema.Provider) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
+	meta, ok := provider.Meta().(*conns.AWSClient)
+	if !ok {
+		meta = new(conns.AWSClient)
+	}
+	meta.SetHTTPClient(ctx, vcrHTTPClient(""))
+	provider.SetMeta(meta)
+
+	ctx = vcrContext(ctx, vcrProviderCassetteName)
+
+	if ds := provider.Configure(ctx, terraformsdk.NewResourceConfigRaw(nil)); ds.HasError() {
+		// Don't save the cassette.
+		if err := vcrStopCassette(vcrProviderCassetteName, false); err != nil {
+			ds = sdkdiag.AppendFromErr(ds, err)
+		}
+
+		return append(diags, ds...)
+	} else {
+		diags = append(diags, ds...)
+	}
+
+	if err := vcrAddAccountID(ctx, vcrProviderCassetteName, ProviderAccountID(provider)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := vcrStopCassette(vcrProviderCassetteName, true); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+// vcrHTTPClient returns an HTTP client that records and replays AWS API interactions.
+// If testName is empty, interactions are recorded in the cassette associated with each request's Context.
+func vcrHTTPClient(testName string) *http.Client {
+	return &http.Client{
+		Transport: &vcrTransport{
+			cassetteName: testName,
+		},
+	}
+}
+
+// vcrTransport is an http.RoundTripper that records and replays AWS API interactions.
+type vcrTransport struct {
+	cassetteName string
+}
+
+func (t *vcrTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx := r.Context()
+
+	name := t.cassetteName
+	if name == "" {
+		if v, ok := vcrCassetteNameFromContext(ctx); ok {
+			name = v
+		} else {
+			mode, err := vcrMode()
+			if err != nil {
+				return nil, err
 			}
 
-			if r.Body == nil {
-				return true
+			if mode == recorder.ModeReplayOnly {
+				return nil, fmt.Errorf("replaying %s %s: no VCR cassette for request Context", r.Method, r.URL)
 			}
 
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+			tflog.Warn(ctx, "AWS API request not recorded, no VCR cassette for request Context", map[string]any{
+				"http.method": r.Method,
+				"http.url":    r.URL.String(),
+			})
+
+			return vcrRealTransport().RoundTrip(r)
+		}
+	}
+
+	c, err := vcrCassetteNamed(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.recorder.RoundTrip(r)
+
+	if errors.Is(err, cassette.ErrInteractionNotFound) {
+		return nil, &vcrInteractionNotFoundError{
+			err:    err,
+			method: r.Method,
+			url:    r.URL.String(),
+		}
+	}
+
+	return resp, err
+}
+
+// vcrInteractionNotFoundError is returned when no recorded interaction matches a request.
+// The AWS SDKs don't retry requests failing with this error.
+type vcrInteractionNotFoundError struct {
+	err    error
+	method string
+	url    string
+}
+
+func (e *vcrInteractionNotFoundError) Error() string {
+	return fmt.Sprintf("replaying %s %s: %s", e.method, e.url, e.err)
+}
+
+func (e *vcrInteractionNotFoundError) Unwrap() error {
+	return e.err
+}
+
+// CanceledError prevents retries by the AWS SDK for Go v2.
+func (e *vcrInteractionNotFoundError) CanceledError() bool {
+	return true
+}
+
+// Temporary prevents retries by the AWS SDK for Go v1.
+func (e *vcrInteractionNotFoundError) Temporary() bool {
+	return false
+}
+
+// vcrRealTransport returns the transport used to make real AWS API requests.
+func vcrRealTransport() http.RoundTripper {
+	// Cribbed from aws-sdk-go-base.
+	httpClient := cleanhttp.DefaultPooledClient()
+	transport := httpClient.Transport.(*http.Transport)
+	transport.MaxIdleConnsPerHost = 10
+	tlsConfig := transport.TLSClientConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+		transport.TLSClientConfig = tlsConfig
+	}
+	tlsConfig.MinVersion = tls.VersionTLS12
+
+	return transport
+}
+
+// vcrCassetteNamed returns the named cassette, creating it if necessary.
+func vcrCassetteNamed(ctx context.Context, name string) (*vcrCassette, error) {
+	cassettes.Lock()
+	defer cassettes.Unlock()
+
+	if c, ok := cassettes[name]; ok {
+		return c, nil
+	}
+
+	vcrMode, err := vcrMode()
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The acctest Provider is configured at most once per run so its cassette is always re-recorded.
+	if name == vcrProviderCassetteName && vcrMode == recorder.ModeRecordOnce {
+		vcrMode = recorder.ModeRecordOnly
+	}
+
+	r, err := recorder.NewWithOptions(&recorder.Options{
+		CassetteName:       vcrCassettePath(name),
+		Mode:               vcrMode,
+		RealTransport:      vcrRealTransport(),
+		SkipRequestLatency: true,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	c := &vcrCassette{
+		recorder: r,
+	}
+
+	// Ensure that the acctest Provider's account ID has the same placeholder in all cassettes.
+	if v := ProviderAccountID(Provider); v != "" {
+		c.accountIDs = append(c.accountIDs, v)
+	}
+
+	// Remove sensitive HTTP headers.
+	r.AddHook(func(i *cassette.Interaction) error {
+		delete(i.Request.Headers, "Authorization")
+		delete(i.Request.Headers, "X-Amz-Security-Token")
+
+		return nil
+	}, recorder.AfterCaptureHook)
+
+	// Scrub credentials, signatures and account IDs.
+	// This is done before saving so that the recording test run sees unmodified responses.
+	r.AddHook(func(i *cassette.Interaction) error {
+		vcrScrubInteraction(i, c.accountIDs)
+
+		return nil
+	}, recorder.BeforeSaveHook)
+
+	// Defines how VCR will match requests to responses.
+	r.SetMatcher(vcrMatcher(ctx))
+
+	cassettes[name] = c
+
+	return c, nil
+}
+
+// vcrAddAccountID adds an AWS account ID to be scrubbed from the named cassette.
+func vcrAddAccountID(ctx context.Context, name, accountID string) error {
+	if accountID == "" {
+		return nil
+	}
+
+	c, err := vcrCassetteNamed(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	cassettes.Lock()
+	defer cassettes.Unlock()
+
+	if !slices.Contains(c.accountIDs, accountID) {
+		c.accountIDs = append(c.accountIDs, accountID)
+	}
+
+	return nil
+}
+
+// vcrStopCassette stops the named cassette's recorder, saving the cassette if save is true.
+func vcrStopCassette(name string, save bool) error {
+	cassettes.Lock()
+	c, ok := cassettes[name]
+	delete(cassettes, name)
+	cassettes.Unlock()
+
+	if !ok || !save {
+		return nil
+	}
+
+	return c.recorder.Stop()
+}
+
+func vcrCassettePath(name string) string {
+	return filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(name))
+}
+
+// vcrSkipIfNotRecorded skips the test if VCR is replaying and no cassette has been recorded for the test.
+// This allows the whole acceptance test suite to be replayed.
+func vcrSkipIfNotRecorded(t *testing.T) {
+	t.Helper()
+
+	if vcrMode, err := vcrMode(); err != nil || vcrMode != recorder.ModeReplayOnly {
+		return
+	}
+
+	if _, err := os.Stat(cassette.New(vcrCassettePath(t.Name())).File); errors.Is(err, fs.ErrNotExist) {
+		t.Skipf("no VCR cassette recorded for %s", t.Name())
+	}
+}
+
+// vcrScrubInteraction removes credentials and signatures from a recorded interaction and
+// replaces AWS account IDs with placeholders.
+func vcrScrubInteraction(i *cassette.Interaction, accountIDs []string) {
+	oldnew := make([]string, 0, 2*len(accountIDs))
+	for n, v := range accountIDs {
+		oldnew = append(oldnew, v, strconv.Itoa(vcrAccountIDPlaceholder+n))
+	}
+	replacer := strings.NewReplacer(oldnew...)
+
+	scrub := func(s string) string {
+		s = vcrSignatureQueryParameterRegexp.ReplaceAllString(s, "${1}"+vcrRedacted)
+		for _, re := range vcrCredentialsRegexps {
+			s = re.ReplaceAllString(s, "${1}"+vcrRedacted+"${2}")
+		}
+
+		return replacer.Replace(s)
+	}
+	scrubValues := func(m map[string][]string) {
+		for _, v := range m {
+			for i, e := range v {
+				v[i] = scrub(e)
+			}
+		}
+	}
+
+	i.Request.Host = scrub(i.Request.Host)
+	i.Request.URL = scrub(i.Request.URL)
+	i.Request.Body = scrub(i.Request.Body)
+	scrubValues(i.Request.Form)
+	scrubValues(i.Request.Headers)
+
+	if body := scrub(i.Response.Body); body != i.Response.Body {
+		i.Response.Body = body
+		if i.Response.ContentLength > 0 {
+			i.Response.ContentLength = int64(len(body))
+		}
+		if _, ok := i.Response.Headers["Content-Length"]; ok {
+			i.Response.Headers["Content-Length"] = []string{strconv.Itoa(len(body))}
+		}
+	}
+	scrubValues(i.Response.Headers)
+}
+
+// vcrMatcher returns a function that matches requests to recorded interactions.
+// A request matches if the method and URL (ignoring any signature) are the same and
+// the bodies are the same once normalized.
+func vcrMatcher(ctx context.Context) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method {
+			return false
+		}
+
+		if !vcrURLsEquivalent(ctx, r.URL, i.URL) {
+			return false
+		}
+
+		var body string
+		if r.Body != nil && r.Body != http.NoBody {
+			b, err := io.ReadAll(r.Body)
+			if err != nil {
+				tflog.Debug(ctx, "Failed to read request body", map[string]interface{}{
 					"error": err,
 				})
 				return false
 			}
 
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
+			// The body is read for each recorded interaction.
+			r.Body = io.NopCloser(bytes.NewReader(b))
+			body = string(b)
+		}
+
+		return vcrBodiesEquivalent(ctx, r.Header.Get("Content-Type"), body, i.Body)
+	}
+}
+
+func vcrURLsEquivalent(ctx context.Context, u *url.URL, s string) bool {
+	v, err := url.Parse(s)
+	if err != nil {
+		tflog.Debug(ctx, "Failed to parse cassette URL", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	if u.Scheme != v.Scheme || u.Host != v.Host || u.EscapedPath() != v.EscapedPath() {
+		return false
+	}
+
+	return reflect.DeepEqual(vcrNormalizeQuery(u.Query()), vcrNormalizeQuery(v.Query()))
+}
+
+// vcrBodiesEquivalent returns whether a request body and a recorded request body are equivalent.
+// JSON, XML and query (form-encoded) bodies are normalized before comparison.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+func vcrBodiesEquivalent(ctx context.Context, contentType, requestBody, cassetteBody string) bool {
+	if requestBody == cassetteBody {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	var normalize func(string) (string, error)
+	switch {
+	case mediaType == "application/json", strings.HasPrefix(mediaType, "application/x-amz-json-"):
+		normalize = vcrNormalizeJSON
+	case mediaType == "application/xml", mediaType == "text/xml":
+		normalize = vcrNormalizeXML
+	case mediaType == "application/x-www-form-urlencoded":
+		normalize = vcrNormalizeForm
+	default:
+		return false
+	}
+
+	requestBody, err = normalize(requestBody)
+	if err != nil {
+		tflog.Debug(ctx, "Failed to normalize request body", map[string]interface{}{
+			"content_type": contentType,
+			"error":        err,
+		})
+		return false
+	}
+
+	cassetteBody, err = normalize(cassetteBody)
+	if err != nil {
+		tflog.Debug(ctx, "Failed to normalize cassette body", map[string]interface{}{
+			"content_type": contentType,
+			"error":        err,
+		})
+		return false
+	}
+
+	return requestBody == cassetteBody
+}
+
+func vcrIsIdempotencyToken(name string) bool {
+	return slices.ContainsFunc(vcrIdempotencyTokens, func(v string) bool {
+		return strings.EqualFold(v, name)
+	})
+}
+
+// vcrNormalizeQuery removes signature parameters and idempotency tokens from query parameters.
+func vcrNormalizeQuery(v url.Values) url.Values {
+	for k := range v {
+		if vcrIsIdempotencyToken(k) || slices.ContainsFunc(vcrSignatureQueryParameters, func(v string) bool {
+			return strings.EqualFold(v, k)
+		}) {
+			delete(v, k)
+		}
+	}
+
+	return v
+}
+
+// vcrNormalizeForm normalizes a form-encoded (AWS Query protocol) body.
+// Parameters are sorted and idempotency tokens are removed.
+func vcrNormalizeForm(s string) (string, error) {
+	v, err := url.ParseQuery(s)
+	if err != nil {
+		return "", err
+	}
+
+	for k := range v {
+		if i := strings.LastIndexByte(k, '.'); vcrIsIdempotencyToken(k[i+1:]) {
+			delete(v, k)
+		}
+	}
+
+	return v.Encode(), nil
+}
+
+// vcrNormalizeJSON normalizes a JSON body.
+// Object members are sorted, array elements are sorted and idempotency tokens are removed.
+func vcrNormalizeJSON(s string) (string, error) {
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return "", err
+	}
+
+	v, err := vcrNormalizeJSONValue(v)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func vcrNormalizeJSONValue(v any) (any, error) {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if vcrIsIdempotencyToken(k) {
+				delete(v, k)
+				continue
 			}
 
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
+			e, err := vcrNormalizeJSONValue(e)
+			if err != nil {
+				return nil, err
 			}
+			v[k] = e
+		}
 
-			return false
-		})
+	case []any:
+		// Lists are often built from maps, so element order isn't significant.
+		elements := make([]string, len(v))
+		for i, e := range v {
+			e, err := vcrNormalizeJSONValue(e)
+			if err != nil {
+				return nil, err
+			}
 
-		// Use the wrapped HTTP Client for AWS APIs.
-		// As the HTTP client is used in the provider's ConfigureContextFunc
-		// we must do this setup before calling the ConfigureContextFunc.
-		httpClient.Transport = r
-		if v, ok := provider.Meta().(*conns.AWSClient); ok {
-			meta = v
-		} else {
-			meta = new(conns.AWSClient)
+			b, err := json.Marshal(e)
+			if err != nil {
+				return nil, err
+			}
+			elements[i] = string(b)
 		}
-		meta.SetHTTPClient(ctx, httpClient)
-		provider.SetMeta(meta)
+		slices.Sort(elements)
 
-		if v, ds := configureContextFunc(ctx, d); ds.HasError() {
-			return nil, append(diags, ds...)
-		} else {
-			meta = v.(*conns.AWSClient)
+		return elements, nil
+	}
+
+	return v, nil
+}
+
+// vcrNormalizeXML normalizes an XML body.
+// Attributes and child elements are sorted, whitespace is trimmed and idempotency tokens are removed.
+func vcrNormalizeXML(s string) (string, error) {
+	type element struct {
+		name     xml.Name
+		attrs    []string
+		text     strings.Builder
+		children []string
+	}
+
+	stack := []*element{{}}
+	d := xml.NewDecoder(strings.NewReader(s))
+
+	for {
+		token, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
 		}
 
-		// Don't retry requests if a recorded interaction isn't found.
-		// TODO Need to loop through all API clients to do this.
-		// TODO Use []*client.Client?
-		// TODO AWS SDK for Go v2 API clients.
-		// meta.LogsConn(ctx).Handlers.AfterRetry.PushFront(func(r *request.Request) {
-		// 	// We have to use 'Contains' rather than 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
-		// 	if errs.Contains(r.Error, cassette.ErrInteractionNotFound.Error()) {
-		// 		r.Retryable = aws.Bool(false)
-		// 	}
-		// })
+		switch token := token.(type) {
+		case xml.StartElement:
+			e := &element{
+				name: token.Name,
+			}
+			for _, attr := range token.Attr {
+				e.attrs = append(e.attrs, fmt.Sprintf("%s:%s=%q", attr.Name.Space, attr.Name.Local, attr.Value))
+			}
+			slices.Sort(e.attrs)
+			stack = append(stack, e)
 
-		providerMetas[testName] = meta
+		case xml.EndElement:
+			e := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
 
-		return meta, diags
+			if vcrIsIdempotencyToken(e.name.Local) {
+				continue
+			}
+
+			// Lists are often built from maps, so element order isn't significant.
+			slices.Sort(e.children)
+
+			parent := stack[len(stack)-1]
+			parent.children = append(parent.children, fmt.Sprintf("<%s:%s %s>%s%s</>", e.name.Space, e.name.Local, strings.Join(e.attrs, " "), e.text.String(), strings.Join(e.children, "")))
+
+		case xml.CharData:
+			stack[len(stack)-1].text.WriteString(strings.TrimSpace(string(token)))
+		}
 	}
+
+	if len(stack) != 1 {
+		return "", errors.New("unexpected end of XML")
+	}
+
+	return strings.Join(stack[0].children, ""), nil
 }
 
 // vcrRandomnessSource returns a rand.Source for VCR testing.
@@ -322,6 +896,10 @@ func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 	case recorder.ModeReplayOnly:
 		seed, err := readSeedFromFile(vcrSeedFile(os.Getenv(envVarVCRPath), testName))
 
+		if errors.Is(err, fs.ErrNotExist) {
+			t.Skipf("no randomness seed recorded for %s", testName)
+		}
+
 		if err != nil {
 			return nil, fmt.Errorf("no cassette found on disk for %s, please replay this testcase in recording mode - %w", testName, err)
 		}
@@ -392,21 +970,16 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	}
 
 	testName := t.Name()
-	providerMetas.Lock()
-	meta, ok := providerMetas[testName]
-	defer providerMetas.Unlock()
 
-	if ok {
-		if !t.Failed() {
-			if v, ok := meta.HTTPClient(ctx).Transport.(*recorder.Recorder); ok {
-				t.Log("stopping VCR recorder")
-				if err := v.Stop(); err != nil {
-					t.Error(err)
-				}
-			}
-		}
+	providerMetas.Lock()
+	delete(providerMetas, testName)
+	providerMetas.Unlock()
 
-		delete(providerMetas, testName)
+	if !t.Failed() {
+		t.Log("stopping VCR recorder")
+	}
+	if err := vcrStopCassette(testName, !t.Failed()); err != nil {
+		t.Error(err)
 	}
 
 	// Save the randomness seed.
@@ -431,6 +1004,7 @@ func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
 	if isVCREnabled() {
+		vcrSkipIfNotRecorded(t)
 		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
 		defer closeVCRRecorder(ctx, t)
 	}
@@ -443,6 +1017,7 @@ func Test(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
 	if isVCREnabled() {
+		vcrSkipIfNotRecorded(t)
 		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
 		defer closeVCRRecorder(ctx, t)
 	}
@@ -4,9 +4,13 @@
 package acctest_test
 
 import (
+	"net/http"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 )
 
 func TestRandInt(t *testing.T) {
@@ -52,3 +56,169 @@ func TestRandomWithPrefix(t *testing.T) {
 		t.Errorf("REPLAYING: %s, RECORDING: %s", rep2, rec2)
 	}
 }
+
+func TestVCRBodiesEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		contentType  string
+		requestBody  string
+		cassetteBody string
+		expected     bool
+	}{
+		"identical": {
+			contentType:  "application/octet-stream",
+			requestBody:  "abc",
+			cassetteBody: "abc",
+			expected:     true,
+		},
+		"different unknown content type": {
+			contentType:  "application/octet-stream",
+			requestBody:  "abc",
+			cassetteBody: "abd",
+		},
+		"JSON reordered": {
+			contentType:  "application/x-amz-json-1.1",
+			requestBody:  `{"Name":"test","Tags":[{"Key":"k1","Value":"v1"},{"Key":"k2","Value":"v2"}]}`,
+			cassetteBody: `{"Tags":[{"Value":"v2","Key":"k2"},{"Key":"k1","Value":"v1"}], "Name":"test"}`,
+			expected:     true,
+		},
+		"JSON idempotency token": {
+			contentType:  "application/json",
+			requestBody:  `{"Name":"test","ClientToken":"a"}`,
+			cassetteBody: `{"Name":"test","ClientToken":"b"}`,
+			expected:     true,
+		},
+		"JSON different": {
+			contentType:  "application/json; charset=utf-8",
+			requestBody:  `{"Name":"test1"}`,
+			cassetteBody: `{"Name":"test2"}`,
+		},
+		"XML reordered": {
+			contentType:  "application/xml",
+			requestBody:  `<Tagging><TagSet><Tag><Key>k1</Key><Value>v1</Value></Tag><Tag><Key>k2</Key><Value>v2</Value></Tag></TagSet></Tagging>`,
+			cassetteBody: "<Tagging>\n  <TagSet>\n    <Tag><Key>k2</Key><Value>v2</Value></Tag>\n    <Tag><Value>v1</Value><Key>k1</Key></Tag>\n  </TagSet>\n</Tagging>",
+			expected:     true,
+		},
+		"XML idempotency token": {
+			contentType:  "application/xml",
+			requestBody:  `<CreateHostedZoneRequest><Name>example.com</Name><CallerReference>a</CallerReference></CreateHostedZoneRequest>`,
+			cassetteBody: `<CreateHostedZoneRequest><Name>example.com</Name><CallerReference>b</CallerReference></CreateHostedZoneRequest>`,
+			expected:     true,
+		},
+		"XML different": {
+			contentType:  "application/xml",
+			requestBody:  `<Tagging><TagSet><Tag><Key>k1</Key><Value>v1</Value></Tag></TagSet></Tagging>`,
+			cassetteBody: `<Tagging><TagSet><Tag><Key>k1</Key><Value>v2</Value></Tag></TagSet></Tagging>`,
+		},
+		"query reordered": {
+			contentType:  "application/x-www-form-urlencoded; charset=utf-8",
+			requestBody:  "Action=CreateVpc&CidrBlock=10.0.0.0%2F16&ClientToken=a&Version=2016-11-15",
+			cassetteBody: "Version=2016-11-15&Action=CreateVpc&ClientToken=b&CidrBlock=10.0.0.0%2F16",
+			expected:     true,
+		},
+		"query different": {
+			contentType:  "application/x-www-form-urlencoded; charset=utf-8",
+			requestBody:  "Action=CreateVpc&CidrBlock=10.0.0.0%2F16&Version=2016-11-15",
+			cassetteBody: "Action=CreateVpc&CidrBlock=10.1.0.0%2F16&Version=2016-11-15",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+
+			if got, expected := acctest.VCRBodiesEquivalent(ctx, testCase.contentType, testCase.requestBody, testCase.cassetteBody), testCase.expected; got != expected {
+				t.Errorf("VCRBodiesEquivalent = %t, want %t", got, expected)
+			}
+		})
+	}
+}
+
+func TestVCRMatcher(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	matcher := acctest.VCRMatcher(ctx)
+
+	r, err := http.NewRequest(http.MethodGet, "https://examplebucket.s3.amazonaws.com/test.txt?X-Amz-Signature=abc&versionId=1&X-Amz-Credential=def", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !matcher(r, cassette.Request{Method: http.MethodGet, URL: "https://examplebucket.s3.amazonaws.com/test.txt?versionId=1&X-Amz-Credential=REDACTED&X-Amz-Signature=REDACTED"}) {
+		t.Error("expected request to match ignoring signature")
+	}
+	if matcher(r, cassette.Request{Method: http.MethodGet, URL: "https://examplebucket.s3.amazonaws.com/test.txt?versionId=2"}) {
+		t.Error("expected request not to match different query")
+	}
+	if matcher(r, cassette.Request{Method: http.MethodHead, URL: "https://examplebucket.s3.amazonaws.com/test.txt?versionId=1"}) {
+		t.Error("expected request not to match different method")
+	}
+
+	body := `{"Name":"test"}`
+	r, err = http.NewRequest(http.MethodPost, "https://logs.us-west-2.amazonaws.com/", strings.NewReader(body)) //lintignore:AWSAT003
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Header.Set("Content-Type", "application/x-amz-json-1.1")
+
+	// The request body can be read for each recorded interaction.
+	for range 2 {
+		if !matcher(r, cassette.Request{Method: http.MethodPost, URL: "https://logs.us-west-2.amazonaws.com/", Body: body}) { //lintignore:AWSAT003
+			t.Error("expected request to match")
+		}
+	}
+}
+
+func TestVCRScrubInteraction(t *testing.T) {
+	t.Parallel()
+
+	i := &cassette.Interaction{
+		Request: cassette.Request{
+			Body:    "Action=AssumeRole&RoleArn=arn%3Aaws%3Aiam%3A%3A111122223333%3Arole%2Ftest&Version=2011-06-15",
+			Headers: http.Header{"X-Amz-Source-Account": {"444455556666"}},
+			URL:     "https://sts.amazonaws.com/",
+		},
+		Response: cassette.Response{
+			Body: `<AssumeRoleResponse><Credentials><AccessKeyId>ASIAEXAMPLE</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken></Credentials><Arn>arn:aws:sts::111122223333:assumed-role/test/session</Arn></AssumeRoleResponse>`,
+			Headers: http.Header{
+				"Content-Length": {"999"},
+			},
+			ContentLength: 999,
+		},
+	}
+
+	acctest.VCRScrubInteraction(i, []string{"111122223333", "444455556666"})
+
+	if got, expected := i.Request.Body, "Action=AssumeRole&RoleArn=arn%3Aaws%3Aiam%3A%3A123456789012%3Arole%2Ftest&Version=2011-06-15"; got != expected {
+		t.Errorf("request body = %q, want %q", got, expected)
+	}
+	if got, expected := i.Request.Headers.Get("X-Amz-Source-Account"), "123456789013"; got != expected {
+		t.Errorf("request header = %q, want %q", got, expected)
+	}
+	expectedBody := `<AssumeRoleResponse><Credentials><AccessKeyId>REDACTED</AccessKeyId><SecretAccessKey>REDACTED</SecretAccessKey><SessionToken>REDACTED</SessionToken></Credentials><Arn>arn:aws:sts::123456789012:assumed-role/test/session</Arn></AssumeRoleResponse>`
+	if got := i.Response.Body; got != expectedBody {
+		t.Errorf("response body = %q, want %q", got, expectedBody)
+	}
+	if got, expected := i.Response.ContentLength, int64(len(expectedBody)); got != expected {
+		t.Errorf("response content length = %d, want %d", got, expected)
+	}
+	if got, expected := i.Response.Headers.Get("Content-Length"), strconv.Itoa(len(expectedBody)); got != expected {
+		t.Errorf("response Content-Length header = %q, want %q", got, expected)
+	}
+
+	i = &cassette.Interaction{
+		Response: cassette.Response{
+			Body: `{"Code":{"Location":"https://bucket.s3.amazonaws.com/fn?X-Amz-Security-Token=token&X-Amz-Signature=sig&X-Amz-Date=20240101T000000Z"},"roleCredentials":{"accessKeyId":"ASIAEXAMPLE","secretAccessKey":"secret","sessionToken":"token"}}`,
+		},
+	}
+
+	acctest.VCRScrubInteraction(i, nil)
+
+	if got, expected := i.Response.Body, `{"Code":{"Location":"https://bucket.s3.amazonaws.com/fn?X-Amz-Security-Token=REDACTED&X-Amz-Signature=REDACTED&X-Amz-Date=20240101T000000Z"},"roleCredentials":{"accessKeyId":"REDACTED","secretAccessKey":"REDACTED","sessionToken":"REDACTED"}}`; got != expected {
+		t.Errorf("response body = %q, want %q", got, expected)
+	}
+}
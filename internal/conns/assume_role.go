@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// maxChainedAssumeRoleDuration is the maximum session duration of a role assumed using
+	// credentials obtained by assuming another role.
+	// See https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_terms-and-concepts.html#iam-term-role-chaining.
+	maxChainedAssumeRoleDuration = 1 * time.Hour
+)
+
+// assumeRoleHop returns the name used for the specified hop in errors and logs.
+func assumeRoleHop(i int) string {
+	return fmt.Sprintf("assume_role[%d]", i)
+}
+
+// validateAssumeRoleChain validates an ordered list of IAM Roles to assume.
+// A single hop with no role ARN is ignored, as it always has been.
+func validateAssumeRoleChain(hops []awsbase.AssumeRole) error {
+	if len(hops) < 2 {
+		return nil
+	}
+
+	var errs []error
+	for i, hop := range hops {
+		if hop.RoleARN == "" {
+			errs = append(errs, fmt.Errorf("%s: role_arn is required when assuming more than one IAM Role", assumeRoleHop(i)))
+		}
+
+		if i > 0 && hop.Duration > maxChainedAssumeRoleDuration {
+			errs = append(errs, fmt.Errorf("%s (%s): duration (%s) must be at most %s for a chained IAM Role session", assumeRoleHop(i), hop.RoleARN, hop.Duration, maxChainedAssumeRoleDuration))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// assumeRoleChainCredentialsProvider returns a credentials provider that assumes each of the specified IAM Roles in turn,
+// starting with the credentials in cfg.
+// Credentials for each hop are retrieved immediately so that any error names the failing hop.
+// offset is the index of the first hop in the provider's `assume_role` configuration.
+func assumeRoleChainCredentialsProvider(ctx context.Context, cfg aws_sdkv2.Config, hops []awsbase.AssumeRole, offset int, stsEndpoint, stsRegion string) (aws_sdkv2.CredentialsProvider, error) {
+	credentialsProvider := cfg.Credentials
+
+	for i, hop := range hops {
+		hopName := assumeRoleHop(offset + i)
+		tflog.Info(ctx, "Assuming chained IAM Role", map[string]any{
+			"tf_aws.assume_role.hop":             hopName,
+			"tf_aws.assume_role.role_arn":        hop.RoleARN,
+			"tf_aws.assume_role.session_name":    hop.SessionName,
+			"tf_aws.assume_role.external_id":     hop.ExternalID,
+			"tf_aws.assume_role.source_identity": hop.SourceIdentity,
+		})
+
+		cfg := cfg.Copy()
+		cfg.Credentials = credentialsProvider
+		client := sts_sdkv2.NewFromConfig(cfg, func(o *sts_sdkv2.Options) {
+			if stsEndpoint != "" {
+				o.BaseEndpoint = aws_sdkv2.String(stsEndpoint)
+			}
+			if stsRegion != "" {
+				o.Region = stsRegion
+			}
+		})
+
+		provider := aws_sdkv2.NewCredentialsCache(stscreds.NewAssumeRoleProvider(client, hop.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			expandAssumeRoleOptions(o, hop)
+		}))
+
+		if _, err := provider.Retrieve(ctx); err != nil {
+			return nil, fmt.Errorf("%s: assuming IAM Role (%s): %w", hopName, hop.RoleARN, err)
+		}
+
+		credentialsProvider = provider
+	}
+
+	return credentialsProvider, nil
+}
+
+func expandAssumeRoleOptions(o *stscreds.AssumeRoleOptions, hop awsbase.AssumeRole) {
+	o.RoleSessionName = hop.SessionName
+	o.Duration = hop.Duration
+
+	if hop.ExternalID != "" {
+		o.ExternalID = aws_sdkv2.String(hop.ExternalID)
+	}
+
+	if hop.Policy != "" {
+		o.Policy = aws_sdkv2.String(hop.Policy)
+	}
+
+	for _, v := range hop.PolicyARNs {
+		o.PolicyARNs = append(o.PolicyARNs, ststypes.PolicyDescriptorType{
+			Arn: aws_sdkv2.String(v),
+		})
+	}
+
+	if hop.SourceIdentity != "" {
+		o.SourceIdentity = aws_sdkv2.String(hop.SourceIdentity)
+	}
+
+	for k, v := range hop.Tags {
+		o.Tags = append(o.Tags, ststypes.Tag{
+			Key:   aws_sdkv2.String(k),
+			Value: aws_sdkv2.String(v),
+		})
+	}
+
+	o.TransitiveTagKeys = hop.TransitiveTagKeys
+}
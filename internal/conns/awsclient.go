@@ -9,6 +9,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -28,26 +29,60 @@ import (
 )
 
 type AWSClient struct {
-	AccountID         string
-	DefaultTagsConfig *tftags.DefaultConfig
-	IgnoreTagsConfig  *tftags.IgnoreConfig
-	Partition         string
-	Region            string
-	ServicePackages   map[string]ServicePackage
-
+	AccountID                string
+	DefaultTagsConfig        *tftags.DefaultConfig
+	DeletionProtectionConfig *DeletionProtectionConfig
+	IgnoreTagsConfig         *tftags.IgnoreConfig
+	LintIAMPolicies          bool
+	Partition                string
+	Region                   string
+	ServicePackages          map[string]ServicePackage
+	TagPolicyConfig          *tftags.PolicyConfig
+
+	allowedRegions            []string // From provider configuration.
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
 	conns                     map[string]any
 	dnsSuffix                 string
 	endpoints                 map[string]string // From provider configuration.
+	forbiddenRegions          []string          // From provider configuration.
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	organization              *accountOrganization // Cached AWS Organizations membership.
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3_sdkv2.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	s3UsePathStyle            bool                       // From provider configuration.
+	serviceLimiters           map[string]*serviceLimiter // From provider configuration.
+	s3USEast1RegionalEndpoint string                     // From provider configuration.
+	stsRegion                 string                     // From provider configuration.
+	telemetry                 *Telemetry
+}
+
+// Telemetry returns the client-side API call telemetry recorder.
+func (c *AWSClient) Telemetry() *Telemetry {
+	if c.telemetry == nil {
+		return NewTelemetry()
+	}
+	return c.telemetry
+}
+
+// RegionForContext returns the AWS Region for API calls made using the specified Context.
+// Any per-resource Region override in Context takes precedence over the provider's configured Region.
+func (c *AWSClient) RegionForContext(ctx context.Context) string {
+	if region, ok := RegionFromContext(ctx); ok {
+		return region
+	}
+	return c.Region
+}
+
+// DefaultTagsConfigForContext returns the default tags configuration for the resource or data source whose operation is running in the specified Context.
+// Any per-resource type default tags rules have already been applied to the returned configuration.
+func (c *AWSClient) DefaultTagsConfigForContext(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		return inContext.DefaultConfig
+	}
+	return c.DefaultTagsConfig
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -63,13 +98,9 @@ func (c *AWSClient) AwsConfig(context.Context) aws_sdkv2.Config { // nosemgrep:c
 }
 
 // OpsWorksConnForRegion returns an AWS SDK For Go v1 OpsWorks API client for the specified AWS Region.
-// If the specified region is not the default a new "simple" client is created.
-// This new client does not use any configured endpoint override.
+// If the specified region is not the default the client does not use any configured endpoint override.
 func (c *AWSClient) OpsWorksConnForRegion(ctx context.Context, region string) *opsworks_sdkv1.OpsWorks {
-	if region == c.Region {
-		return c.OpsWorksConn(ctx)
-	}
-	return opsworks_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
+	return c.OpsWorksConn(NewRegionContext(ctx, region))
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -83,17 +114,13 @@ func (c *AWSClient) PartitionHostname(ctx context.Context, prefix string) string
 // e.g. PREFIX.us-west-2.amazonaws.com
 // The prefix should not contain a trailing period.
 func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string {
-	return fmt.Sprintf("%s.%s.%s", prefix, c.Region, c.DNSSuffix(ctx))
+	return fmt.Sprintf("%s.%s.%s", prefix, c.RegionForContext(ctx), c.DNSSuffix(ctx))
 }
 
 // RDSConnForRegion returns an AWS SDK For Go v1 RDS API client for the specified AWS Region.
-// If the specified region is not the default a new "simple" client is created.
-// This new client does not use any configured endpoint override.
+// If the specified region is not the default the client does not use any configured endpoint override.
 func (c *AWSClient) RDSConnForRegion(ctx context.Context, region string) *rds_sdkv1.RDS {
-	if region == c.Region {
-		return c.RDSConn(ctx)
-	}
-	return rds_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
+	return c.RDSConn(NewRegionContext(ctx, region))
 }
 
 // S3ExpressClient returns an AWS SDK for Go v2 S3 API client suitable for use with S3 Express (directory buckets).
@@ -209,8 +236,8 @@ func (c *AWSClient) ReverseDNSPrefix(ctx context.Context) string {
 }
 
 // EC2RegionalPrivateDNSSuffix returns the EC2 private DNS suffix for the configured AWS Region.
-func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
-	region := c.Region
+func (c *AWSClient) EC2RegionalPrivateDNSSuffix(ctx context.Context) string {
+	region := c.RegionForContext(ctx)
 	if region == names.USEast1RegionID {
 		return "ec2.internal"
 	}
@@ -219,8 +246,8 @@ func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
 }
 
 // EC2RegionalPublicDNSSuffix returns the EC2 public DNS suffix for the configured AWS Region.
-func (c *AWSClient) EC2RegionalPublicDNSSuffix(context.Context) string {
-	region := c.Region
+func (c *AWSClient) EC2RegionalPublicDNSSuffix(ctx context.Context) string {
+	region := c.RegionForContext(ctx)
 	if region == names.USEast1RegionID {
 		return "compute-1"
 	}
@@ -242,14 +269,26 @@ func convertIPToDashIP(ip string) string {
 	return strings.Replace(ip, ".", "-", -1)
 }
 
-// apiClientConfig returns the AWS API client configuration parameters for the specified service.
-func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+// apiClientConfig returns the AWS API client configuration parameters for the specified service and AWS Region.
+// Clients for a Region other than the provider's configured Region do not use any configured endpoint override.
+func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName, region string) map[string]any {
 	m := map[string]any{
 		"aws_sdkv2_config": c.awsConfig,
 		"endpoint":         c.resolveEndpoint(ctx, servicePackageName),
 		"partition":        c.Partition,
 		"session":          c.session,
 	}
+	if region != c.Region {
+		if c.awsConfig != nil {
+			awsConfig := c.awsConfig.Copy()
+			awsConfig.Region = region
+			m["aws_sdkv2_config"] = &awsConfig
+		}
+		m["endpoint"] = ""
+		if c.session != nil {
+			m["session"] = c.session.Copy(aws_sdkv1.NewConfig().WithRegion(region))
+		}
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
@@ -320,18 +359,20 @@ func resolveServiceBaseEndpoint(ctx context.Context, sdkID string, configs []any
 }
 
 // conn returns the AWS SDK for Go v1 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) for each AWS Region is cached. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
+	region := c.RegionForContext(ctx)
+	key := clientCacheKey(servicePackageName, region, c.Region)
 	isDefault := len(extra) == 0
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.conns[servicePackageName]; ok {
+		if raw, ok := c.conns[key]; ok {
 			if conn, ok := raw.(T); ok {
 				return conn, nil
 			} else {
@@ -355,7 +396,7 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 		return zero, fmt.Errorf("no AWS SDK v1 API client factory: %s", servicePackageName)
 	}
 
-	config := c.apiClientConfig(ctx, servicePackageName)
+	config := c.apiClientConfig(ctx, servicePackageName, region)
 	maps.Copy(config, extra) // Extras overwrite per-service defaults.
 	conn, err := v.NewConn(ctx, config)
 	if err != nil {
@@ -375,25 +416,27 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 
 	// Default service client is cached.
 	if isDefault {
-		c.conns[servicePackageName] = conn
+		c.conns[key] = conn
 	}
 
 	return conn, nil
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) for each AWS Region is cached. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
+	region := c.RegionForContext(ctx)
+	key := clientCacheKey(servicePackageName, region, c.Region)
 	isDefault := len(extra) == 0
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -417,7 +460,15 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 		return zero, fmt.Errorf("no AWS SDK v2 API client factory: %s", servicePackageName)
 	}
 
-	config := c.apiClientConfig(ctx, servicePackageName)
+	config := c.apiClientConfig(ctx, servicePackageName, region)
+	if limiter, ok := c.serviceLimiters[servicePackageName]; ok {
+		if awsConfig, ok := config["aws_sdkv2_config"].(*aws_sdkv2.Config); ok && awsConfig != nil {
+			// The limiter is shared by all of this service's API clients, whatever their Region.
+			awsConfig := awsConfig.Copy()
+			awsConfig.APIOptions = append(slices.Clone(awsConfig.APIOptions), limiter.apiOptions()...)
+			config["aws_sdkv2_config"] = &awsConfig
+		}
+	}
 	maps.Copy(config, extra) // Extras overwrite per-service defaults.
 	client, err := v.NewClient(ctx, config)
 	if err != nil {
@@ -428,8 +479,16 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
 }
+
+// clientCacheKey returns the key used to cache the default API client for the specified service and AWS Region.
+func clientCacheKey(servicePackageName, region, defaultRegion string) string {
+	if region == defaultRegion {
+		return servicePackageName
+	}
+	return fmt.Sprintf("%s@%s", servicePackageName, region)
+}
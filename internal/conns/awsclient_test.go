@@ -6,6 +6,8 @@ package conns
 import (
 	"context"
 	"testing"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -181,3 +183,86 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientRegionForContext(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name      string
+		AWSClient *AWSClient
+		Context   context.Context
+		Expected  string
+	}{
+		{
+			Name: "no override",
+			AWSClient: &AWSClient{
+				Region: "us-west-2", //lintignore:AWSAT003
+			},
+			Context:  context.TODO(),
+			Expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name: "empty override",
+			AWSClient: &AWSClient{
+				Region: "us-west-2", //lintignore:AWSAT003
+			},
+			Context:  NewRegionContext(context.TODO(), ""),
+			Expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name: "override",
+			AWSClient: &AWSClient{
+				Region: "us-west-2", //lintignore:AWSAT003
+			},
+			Context:  NewRegionContext(context.TODO(), "eu-west-1"), //lintignore:AWSAT003
+			Expected: "eu-west-1",                                   //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.AWSClient.RegionForContext(testCase.Context)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAWSClientDefaultTagsConfigForContext(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	defaultTagsConfig := &tftags.DefaultConfig{
+		Tags: tftags.New(ctx, map[string]string{
+			"CostCenter": "1234",
+		}),
+		Rules: []tftags.DefaultTagsRule{
+			{
+				ResourceTypes: []string{"aws_iam_*"},
+				ExcludeKeys:   []string{"CostCenter"},
+			},
+		},
+	}
+	client := &AWSClient{
+		DefaultTagsConfig: defaultTagsConfig,
+	}
+
+	if got := client.DefaultTagsConfigForContext(ctx); got != defaultTagsConfig {
+		t.Errorf("got %v, expected provider default tags configuration", got)
+	}
+
+	resourceDefaultTagsConfig := defaultTagsConfig.ForResourceType(ctx, "aws_iam_role")
+	got := client.DefaultTagsConfigForContext(tftags.NewContext(ctx, resourceDefaultTagsConfig, nil))
+
+	if got != resourceDefaultTagsConfig {
+		t.Errorf("got %v, expected resource type default tags configuration", got)
+	}
+	if len(got.GetTags()) != 0 {
+		t.Errorf("got tags %v, expected none", got.GetTags())
+	}
+}
@@ -29,27 +29,35 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	AllowedOUIds                   []string // AWS Organizations organizational unit or root IDs.
+	AllowedRegions                 []string
+	AssumeRole                     []awsbase.AssumeRole // Ordered list of IAM Roles to assume.
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DeletionProtectionConfig       *DeletionProtectionConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
+	ForbiddenRegions               []string
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	LintIAMPolicies                bool
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	ReadOnly                       bool // Rejects any AWS API operation that is not read-only.
 	Region                         string
+	RequiredOrganizationID         string
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceRateLimits              map[string]ServiceRateLimit // Keyed by service package name.
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -57,6 +65,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -114,8 +123,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:                c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	if err := validateAssumeRoleChain(c.AssumeRole); err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if len(c.AssumeRole) > 0 && c.AssumeRole[0].RoleARN != "" {
+		awsbaseConfig.AssumeRole = &c.AssumeRole[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -148,9 +161,13 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	ctx, cfg, awsDiags := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
 
 	for _, d := range awsDiags {
+		summary := d.Summary()
+		if len(c.AssumeRole) > 1 && awsbase.IsCannotAssumeRoleError(d) {
+			summary = fmt.Sprintf("%s: %s", assumeRoleHop(0), summary)
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: baseSeverityToSDKSeverity(d.Severity()),
-			Summary:  d.Summary(),
+			Summary:  summary,
 			Detail:   d.Detail(),
 		})
 	}
@@ -159,6 +176,15 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	// aws-sdk-go-base assumes the first IAM Role. Any others are assumed in turn.
+	if len(c.AssumeRole) > 1 {
+		credentialsProvider, err := assumeRoleChainCredentialsProvider(ctx, cfg, c.AssumeRole[1:], 1, c.Endpoints[names.STS], c.STSRegion)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "Cannot assume IAM Role: %s", err)
+		}
+		cfg.Credentials = credentialsProvider
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -166,8 +192,21 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	if err := verifyRegionAllowed(c.Region, c.AllowedRegions, c.ForbiddenRegions); err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
+	telemetry, err := globalTelemetry()
+	if err != nil {
+		diags = append(diags, errs.NewWarningDiagnostic("Client-side telemetry not exported", err.Error()))
+	}
+	cfg.APIOptions = append(cfg.APIOptions, telemetry.apiOptions()...)
+	if c.ReadOnly {
+		cfg.APIOptions = append(cfg.APIOptions, readOnlyAPIOptions()...)
+	}
+
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
 	session, awsDiags := awsbasev1.GetSession(ctx, &cfg, &awsbaseConfig)
 
@@ -183,6 +222,11 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	telemetry.addSDKv1Handlers(&session.Handlers)
+	if c.ReadOnly {
+		addReadOnlySDKv1Handlers(&session.Handlers)
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -199,22 +243,49 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			"See https://registry.terraform.io/providers/hashicorp/aws/latest/docs#skip_requesting_account_id for implications."))
 	}
 
-	err := awsbaseConfig.VerifyAccountIDAllowed(accountID)
+	err = awsbaseConfig.VerifyAccountIDAllowed(accountID)
 	if err != nil {
 		return nil, sdkdiag.AppendErrorf(diags, err.Error())
 	}
 
+	if c.RequiredOrganizationID != "" || len(c.AllowedOUIds) > 0 {
+		if accountID == "" {
+			return nil, sdkdiag.AppendErrorf(diags, "AWS account ID is required to verify AWS Organizations membership")
+		}
+
+		tflog.Debug(ctx, "Verifying AWS Organizations membership")
+		organization, err := client.accountOrganization(ctx, organizationsClient(cfg, c.Endpoints[names.Organizations]), accountID, len(c.AllowedOUIds) > 0)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "verifying AWS Organizations membership: %s", err)
+		}
+
+		if err := c.verifyOrganizationAllowed(organization); err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	dnsSuffix := "amazonaws.com"
 	if p, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), c.Region); ok {
 		dnsSuffix = p.DNSSuffix()
 	}
 
 	client.AccountID = accountID
+	client.allowedRegions = c.AllowedRegions
 	client.DefaultTagsConfig = c.DefaultTagsConfig
+	client.DeletionProtectionConfig = c.DeletionProtectionConfig
 	client.dnsSuffix = dnsSuffix
+	client.forbiddenRegions = c.ForbiddenRegions
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
+	client.LintIAMPolicies = c.LintIAMPolicies
 	client.Partition = partition
 	client.Region = c.Region
+	client.TagPolicyConfig = c.TagPolicyConfig
+	for servicePackageName, limit := range c.ServiceRateLimits {
+		if client.serviceLimiters == nil {
+			client.serviceLimiters = make(map[string]*serviceLimiter)
+		}
+		client.serviceLimiters[servicePackageName] = sharedServiceLimiter(servicePackageName, limit)
+	}
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
@@ -227,6 +298,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
+	client.telemetry = telemetry
 
 	return client, diags
 }
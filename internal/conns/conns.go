@@ -5,7 +5,9 @@ package conns
 
 import (
 	"context"
+	"strings"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
@@ -19,17 +21,30 @@ type ServicePackage interface {
 	ServicePackageName() string
 }
 
+// ServicePackageWithEphemeralResources is implemented by service packages that contain ephemeral resources.
+type ServicePackageWithEphemeralResources interface {
+	ServicePackage
+	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
+}
+
 type (
 	contextKeyType int
 )
 
 var (
-	contextKey contextKeyType
+	regionRegexp = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+)
+
+const (
+	contextKeyResource contextKeyType = iota
+	contextKeyRegion
+	contextKeyTelemetry
 )
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
 	IsDataSource       bool   // Data source?
+	IsEphemeral        bool   // Ephemeral resource?
 	ResourceName       string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName string // Canonical name defined as a constant in names package
 }
@@ -41,7 +56,17 @@ func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName
 		ServicePackageName: servicePackageName,
 	}
 
-	return context.WithValue(ctx, contextKey, &v)
+	return context.WithValue(ctx, contextKeyResource, &v)
+}
+
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+	v := InContext{
+		IsEphemeral:        true,
+		ResourceName:       resourceName,
+		ServicePackageName: servicePackageName,
+	}
+
+	return context.WithValue(ctx, contextKeyResource, &v)
 }
 
 func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
@@ -50,10 +75,37 @@ func NewResourceContext(ctx context.Context, servicePackageName, resourceName st
 		ServicePackageName: servicePackageName,
 	}
 
-	return context.WithValue(ctx, contextKey, &v)
+	return context.WithValue(ctx, contextKeyResource, &v)
 }
 
 func FromContext(ctx context.Context) (*InContext, bool) {
-	v, ok := ctx.Value(contextKey).(*InContext)
+	v, ok := ctx.Value(contextKeyResource).(*InContext)
 	return v, ok
 }
+
+// NewRegionContext returns a Context that overrides the AWS Region used by API clients.
+// An empty region removes any override.
+func NewRegionContext(ctx context.Context, region string) context.Context {
+	return context.WithValue(ctx, contextKeyRegion, region)
+}
+
+// RegionFromContext returns any AWS Region override kept in Context.
+func RegionFromContext(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(contextKeyRegion).(string)
+	return v, ok && v != ""
+}
+
+// SplitImportIDRegion splits an import ID of the form `<id>@<region>` into the resource's own ID and AWS Region.
+// found is false if the import ID has no valid AWS Region suffix, in which case id is returned unchanged.
+func SplitImportIDRegion(importID string) (id, region string, found bool) {
+	i := strings.LastIndex(importID, "@")
+	if i <= 0 {
+		return importID, "", false
+	}
+
+	if region := importID[i+1:]; regionRegexp.MatchString(region) {
+		return importID[:i], region, true
+	}
+
+	return importID, "", false
+}
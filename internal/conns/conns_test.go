@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestSplitImportIDRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		ImportID       string
+		ExpectedID     string
+		ExpectedRegion string
+		ExpectedFound  bool
+	}{
+		{
+			Name:       "empty",
+			ImportID:   "",
+			ExpectedID: "",
+		},
+		{
+			Name:       "no suffix",
+			ImportID:   "queue-1",
+			ExpectedID: "queue-1",
+		},
+		{
+			Name:           "region suffix",
+			ImportID:       "queue-1@eu-west-1", //lintignore:AWSAT003
+			ExpectedID:     "queue-1",
+			ExpectedRegion: "eu-west-1", //lintignore:AWSAT003
+			ExpectedFound:  true,
+		},
+		{
+			Name:           "region suffix on composite ID",
+			ImportID:       "user@example.com,group@us-gov-west-1", //lintignore:AWSAT003
+			ExpectedID:     "user@example.com,group",
+			ExpectedRegion: "us-gov-west-1", //lintignore:AWSAT003
+			ExpectedFound:  true,
+		},
+		{
+			Name:       "non-region suffix",
+			ImportID:   "user@example.com",
+			ExpectedID: "user@example.com",
+		},
+		{
+			Name:       "only region",
+			ImportID:   "@us-west-2", //lintignore:AWSAT003
+			ExpectedID: "@us-west-2", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			id, region, found := SplitImportIDRegion(testCase.ImportID)
+
+			if got, want := id, testCase.ExpectedID; got != want {
+				t.Errorf("id: got %s, expected %s", got, want)
+			}
+			if got, want := region, testCase.ExpectedRegion; got != want {
+				t.Errorf("region: got %s, expected %s", got, want)
+			}
+			if got, want := found, testCase.ExpectedFound; got != want {
+				t.Errorf("found: got %t, expected %t", got, want)
+			}
+		})
+	}
+}
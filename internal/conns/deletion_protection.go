@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// DeletionProtectionOverrideEnvVar is the environment variable that, when set to a true value,
+// disables the provider's deletion protection rules.
+const DeletionProtectionOverrideEnvVar = "TF_AWS_DELETION_PROTECTION_OVERRIDE"
+
+// DeletionProtectionConfig is the provider's safety net against destroying resources.
+// A resource is protected if its type matches any of the resource type patterns or
+// if it is tagged with any of the protected tags.
+type DeletionProtectionConfig struct {
+	ResourceTypes []string          // Resource type glob patterns, e.g. "aws_kms_*"
+	Tags          map[string]string // Tag keys and values, e.g. Protected = "true"
+}
+
+// BlockingRule returns a description of the first rule that protects a resource of the specified type
+// with the specified tags, or the empty string if the resource is not protected.
+func (c *DeletionProtectionConfig) BlockingRule(typeName string, tags map[string]string) string {
+	if c == nil {
+		return ""
+	}
+
+	for _, pattern := range c.ResourceTypes {
+		if ok, _ := path.Match(pattern, typeName); ok {
+			return fmt.Sprintf("prevent_destroy_types = %q", pattern)
+		}
+	}
+
+	keys := make([]string, 0, len(c.Tags))
+	for key := range c.Tags {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		if v, ok := tags[key]; ok && strings.EqualFold(v, c.Tags[key]) {
+			return fmt.Sprintf("prevent_destroy_tags = { %s = %q }", key, c.Tags[key])
+		}
+	}
+
+	return ""
+}
+
+// DeletionProtectionOverridden returns whether deletion protection has been disabled via environment variable.
+func DeletionProtectionOverridden() bool {
+	v, err := strconv.ParseBool(os.Getenv(DeletionProtectionOverrideEnvVar))
+
+	return err == nil && v
+}
+
+// DeletionProtectionErrorDetail returns the detail of the error reported when the specified rule prevents a resource from being deleted.
+func DeletionProtectionErrorDetail(typeName, id, rule string) string {
+	return fmt.Sprintf("%s (%s) is protected by the provider's deletion_protection rule %s and cannot be deleted.\n\n"+
+		"To delete the resource, remove it from the rule or set the %s environment variable to true.", typeName, id, rule, DeletionProtectionOverrideEnvVar)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestDeletionProtectionConfigBlockingRule(t *testing.T) {
+	t.Parallel()
+
+	config := &DeletionProtectionConfig{
+		ResourceTypes: []string{"aws_kms_*", "aws_s3_bucket"},
+		Tags: map[string]string{
+			"Environment": "production",
+			"Protected":   "true",
+		},
+	}
+
+	testCases := map[string]struct {
+		config   *DeletionProtectionConfig
+		typeName string
+		tags     map[string]string
+		expected string
+	}{
+		"nil config": {
+			typeName: "aws_s3_bucket",
+		},
+		"resource type": {
+			config:   config,
+			typeName: "aws_s3_bucket",
+			expected: `prevent_destroy_types = "aws_s3_bucket"`,
+		},
+		"resource type pattern": {
+			config:   config,
+			typeName: "aws_kms_key",
+			expected: `prevent_destroy_types = "aws_kms_*"`,
+		},
+		"resource type no match": {
+			config:   config,
+			typeName: "aws_s3_bucket_policy",
+		},
+		"tag": {
+			config:   config,
+			typeName: "aws_route53_zone",
+			tags:     map[string]string{"Protected": "True"},
+			expected: `prevent_destroy_tags = { Protected = "true" }`,
+		},
+		"multiple tags": {
+			config:   config,
+			typeName: "aws_route53_zone",
+			tags:     map[string]string{"Environment": "production", "Protected": "true"},
+			expected: `prevent_destroy_tags = { Environment = "production" }`,
+		},
+		"tag value no match": {
+			config:   config,
+			typeName: "aws_route53_zone",
+			tags:     map[string]string{"Protected": "false"},
+		},
+		"untagged": {
+			config:   config,
+			typeName: "aws_route53_zone",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.config.BlockingRule(testCase.typeName, testCase.tags), testCase.expected; got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestDeletionProtectionOverridden(t *testing.T) {
+	for value, expected := range map[string]bool{
+		"":      false,
+		"0":     false,
+		"false": false,
+		"maybe": false,
+		"1":     true,
+		"true":  true,
+	} {
+		t.Setenv(DeletionProtectionOverrideEnvVar, value)
+
+		if got := DeletionProtectionOverridden(); got != expected {
+			t.Errorf("%s=%q: got %t, want %t", DeletionProtectionOverrideEnvVar, value, got, expected)
+		}
+	}
+}
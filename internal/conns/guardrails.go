@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	organizations_sdkv2 "github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizationstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// accountOrganization is an AWS account's AWS Organizations membership.
+type accountOrganization struct {
+	accountID      string
+	organizationID string
+	parentIDs      []string // The account's parent organizational unit, its ancestors and the root, nearest first. nil if not retrieved.
+}
+
+// organizationsAPI is the subset of the AWS Organizations API used to retrieve an account's membership.
+type organizationsAPI interface {
+	DescribeOrganization(context.Context, *organizations_sdkv2.DescribeOrganizationInput, ...func(*organizations_sdkv2.Options)) (*organizations_sdkv2.DescribeOrganizationOutput, error)
+	ListParents(context.Context, *organizations_sdkv2.ListParentsInput, ...func(*organizations_sdkv2.Options)) (*organizations_sdkv2.ListParentsOutput, error)
+}
+
+// verifyRegionAllowed returns an error if the specified AWS Region is forbidden or not allowed.
+func verifyRegionAllowed(region string, allowedRegions, forbiddenRegions []string) error {
+	if slices.Contains(forbiddenRegions, region) {
+		return fmt.Errorf("AWS Region not allowed: %s", region)
+	}
+
+	if len(allowedRegions) > 0 && !slices.Contains(allowedRegions, region) {
+		return fmt.Errorf("AWS Region not allowed: %s", region)
+	}
+
+	return nil
+}
+
+// VerifyRegionAllowed returns an error if the provider configuration forbids, or does not allow, the specified AWS Region.
+// It is used to check per-resource Region overrides.
+func (c *AWSClient) VerifyRegionAllowed(region string) error {
+	return verifyRegionAllowed(region, c.allowedRegions, c.forbiddenRegions)
+}
+
+// verifyOrganizationAllowed returns an error if the account's AWS Organizations membership does not match the provider configuration.
+func (c *Config) verifyOrganizationAllowed(organization *accountOrganization) error {
+	if c.RequiredOrganizationID != "" && organization.organizationID != c.RequiredOrganizationID {
+		return fmt.Errorf("AWS account %s is not a member of AWS Organizations organization %s", organization.accountID, c.RequiredOrganizationID)
+	}
+
+	if len(c.AllowedOUIds) > 0 && !slices.ContainsFunc(organization.parentIDs, func(id string) bool {
+		return slices.Contains(c.AllowedOUIds, id)
+	}) {
+		return fmt.Errorf("AWS account %s is not in an allowed organizational unit: %s", organization.accountID, strings.Join(organization.parentIDs, ", "))
+	}
+
+	return nil
+}
+
+// accountOrganization returns the specified account's AWS Organizations membership.
+// The account's parents are only retrieved if withParents is true.
+// Listing parents requires credentials for the organization's management account or a delegated administrator account.
+// Results are cached in the AWSClient.
+func (c *AWSClient) accountOrganization(ctx context.Context, conn organizationsAPI, accountID string, withParents bool) (*accountOrganization, error) {
+	if v := c.organization; v != nil && v.accountID == accountID && (!withParents || v.parentIDs != nil) {
+		return v, nil
+	}
+
+	organization := &accountOrganization{
+		accountID: accountID,
+	}
+
+	tflog.Debug(ctx, "Retrieving AWS Organizations membership", map[string]any{
+		"tf_aws.account_id": accountID,
+	})
+
+	output, err := conn.DescribeOrganization(ctx, &organizations_sdkv2.DescribeOrganizationInput{})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading AWS Organizations organization: %w", err)
+	}
+
+	organization.organizationID = aws_sdkv2.ToString(output.Organization.Id)
+
+	if withParents {
+		parentIDs := make([]string, 0)
+
+		for childID := accountID; ; {
+			output, err := conn.ListParents(ctx, &organizations_sdkv2.ListParentsInput{
+				ChildId: aws_sdkv2.String(childID),
+			})
+
+			if errs.IsA[*organizationstypes.AccessDeniedException](err) {
+				return nil, fmt.Errorf("listing AWS Organizations parents (%s): allowed_ou_ids requires credentials for the organization's management account or a delegated administrator account: %w", childID, err)
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("listing AWS Organizations parents (%s): %w", childID, err)
+			}
+
+			// An account or organizational unit has exactly one parent.
+			if len(output.Parents) == 0 {
+				break
+			}
+
+			parent := output.Parents[0]
+			childID = aws_sdkv2.ToString(parent.Id)
+			parentIDs = append(parentIDs, childID)
+
+			if parent.Type == organizationstypes.ParentTypeRoot {
+				break
+			}
+		}
+
+		organization.parentIDs = parentIDs
+	}
+
+	c.organization = organization
+
+	return organization, nil
+}
+
+// organizationsClient returns an AWS Organizations API client for verifying the account's membership at provider configuration.
+func organizationsClient(cfg aws_sdkv2.Config, endpoint string) *organizations_sdkv2.Client {
+	return organizations_sdkv2.NewFromConfig(cfg, func(o *organizations_sdkv2.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = aws_sdkv2.String(endpoint)
+		}
+	})
+}
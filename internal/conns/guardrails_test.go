@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizationstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+)
+
+func TestVerifyRegionAllowed(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		region           string
+		allowedRegions   []string
+		forbiddenRegions []string
+		expectedError    bool
+	}{
+		"no restrictions": {
+			region: "us-west-2", //lintignore:AWSAT003
+		},
+		"allowed": {
+			region:         "us-west-2",                        //lintignore:AWSAT003
+			allowedRegions: []string{"us-east-1", "us-west-2"}, //lintignore:AWSAT003
+		},
+		"not allowed": {
+			region:         "eu-west-1",                        //lintignore:AWSAT003
+			allowedRegions: []string{"us-east-1", "us-west-2"}, //lintignore:AWSAT003
+			expectedError:  true,
+		},
+		"forbidden": {
+			region:           "us-east-1",           //lintignore:AWSAT003
+			forbiddenRegions: []string{"us-east-1"}, //lintignore:AWSAT003
+			expectedError:    true,
+		},
+		"not forbidden": {
+			region:           "us-west-2",           //lintignore:AWSAT003
+			forbiddenRegions: []string{"us-east-1"}, //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := verifyRegionAllowed(testCase.region, testCase.allowedRegions, testCase.forbiddenRegions)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Errorf("error = %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+// mockOrganizationsAPI is an organization with a single account in a nested organizational unit.
+type mockOrganizationsAPI struct {
+	calls          []string
+	err            error
+	listParentsErr error
+}
+
+func (m *mockOrganizationsAPI) DescribeOrganization(ctx context.Context, input *organizations.DescribeOrganizationInput, optFns ...func(*organizations.Options)) (*organizations.DescribeOrganizationOutput, error) {
+	m.calls = append(m.calls, "DescribeOrganization")
+
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	return &organizations.DescribeOrganizationOutput{
+		Organization: &organizationstypes.Organization{
+			Id: aws.String("o-example"),
+		},
+	}, nil
+}
+
+func (m *mockOrganizationsAPI) ListParents(ctx context.Context, input *organizations.ListParentsInput, optFns ...func(*organizations.Options)) (*organizations.ListParentsOutput, error) {
+	m.calls = append(m.calls, "ListParents")
+
+	if m.listParentsErr != nil {
+		return nil, m.listParentsErr
+	}
+
+	parents := map[string]organizationstypes.Parent{
+		"123456789012":         {Id: aws.String("ou-example-workloads"), Type: organizationstypes.ParentTypeOrganizationalUnit},
+		"ou-example-workloads": {Id: aws.String("ou-example-prod"), Type: organizationstypes.ParentTypeOrganizationalUnit},
+		"ou-example-prod":      {Id: aws.String("r-example"), Type: organizationstypes.ParentTypeRoot},
+	}
+
+	output := &organizations.ListParentsOutput{}
+	if v, ok := parents[aws.ToString(input.ChildId)]; ok {
+		output.Parents = []organizationstypes.Parent{v}
+	}
+
+	return output, nil
+}
+
+func TestAccountOrganization(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := &mockOrganizationsAPI{}
+	client := &AWSClient{}
+
+	organization, err := client.accountOrganization(ctx, conn, "123456789012", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := organization.organizationID, "o-example"; got != want {
+		t.Errorf("organizationID = %q, want %q", got, want)
+	}
+	if organization.parentIDs != nil {
+		t.Errorf("parentIDs = %v, want nil", organization.parentIDs)
+	}
+
+	organization, err = client.accountOrganization(ctx, conn, "123456789012", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := organization.parentIDs, []string{"ou-example-workloads", "ou-example-prod", "r-example"}; !slices.Equal(got, want) {
+		t.Errorf("parentIDs = %v, want %v", got, want)
+	}
+
+	// Cached.
+	if _, err := client.accountOrganization(ctx, conn, "123456789012", true); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := conn.calls, []string{"DescribeOrganization", "DescribeOrganization", "ListParents", "ListParents", "ListParents"}; !slices.Equal(got, want) {
+		t.Errorf("calls = %v, want %v", got, want)
+	}
+}
+
+func TestAccountOrganization_error(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := &mockOrganizationsAPI{err: errors.New("AWSOrganizationsNotInUseException")}
+	client := &AWSClient{}
+
+	if _, err := client.accountOrganization(ctx, conn, "123456789012", false); err == nil {
+		t.Fatal("expected error, got none")
+	}
+	if client.organization != nil {
+		t.Errorf("organization cached after error")
+	}
+}
+
+func TestAccountOrganization_accessDenied(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := &mockOrganizationsAPI{listParentsErr: &organizationstypes.AccessDeniedException{Message: aws.String("not authorized")}}
+	client := &AWSClient{}
+
+	_, err := client.accountOrganization(ctx, conn, "123456789012", true)
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+	if got, want := err.Error(), "management account or a delegated administrator account"; !strings.Contains(got, want) {
+		t.Errorf("error = %q, want it to contain %q", got, want)
+	}
+}
+
+func TestConfigVerifyOrganizationAllowed(t *testing.T) {
+	t.Parallel()
+
+	organization := &accountOrganization{
+		accountID:      "123456789012",
+		organizationID: "o-example",
+		parentIDs:      []string{"ou-example-workloads", "ou-example-prod", "r-example"},
+	}
+
+	testCases := map[string]struct {
+		config        Config
+		expectedError string
+	}{
+		"no restrictions": {},
+		"organization": {
+			config: Config{RequiredOrganizationID: "o-example"},
+		},
+		"wrong organization": {
+			config:        Config{RequiredOrganizationID: "o-other"},
+			expectedError: "not a member of AWS Organizations organization o-other",
+		},
+		"parent organizational unit": {
+			config: Config{AllowedOUIds: []string{"ou-example-workloads"}},
+		},
+		"ancestor organizational unit": {
+			config: Config{AllowedOUIds: []string{"ou-example-sandbox", "ou-example-prod"}},
+		},
+		"root": {
+			config: Config{AllowedOUIds: []string{"r-example"}},
+		},
+		"organizational unit not allowed": {
+			config:        Config{AllowedOUIds: []string{"ou-example-sandbox"}},
+			expectedError: "not in an allowed organizational unit",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.config.verifyOrganizationAllowed(organization)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Errorf("error = %v, want %q", err, testCase.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ServiceRateLimit is a client-side limit on the AWS API calls made to a single service.
+type ServiceRateLimit struct {
+	MaxInFlight       int     // Maximum number of concurrent API calls. 0 means unlimited.
+	RequestsPerSecond float64 // Maximum sustained rate of API calls. 0 means unlimited.
+}
+
+// serviceLimiter enforces a ServiceRateLimit.
+type serviceLimiter struct {
+	inFlight chan struct{} // nil if concurrency is unlimited.
+	interval time.Duration // Minimum time between API calls. 0 if the rate is unlimited.
+	lock     sync.Mutex
+	next     time.Time // Earliest time at which the next API call may start.
+}
+
+func newServiceLimiter(limit ServiceRateLimit) *serviceLimiter {
+	l := &serviceLimiter{}
+
+	if limit.MaxInFlight > 0 {
+		l.inFlight = make(chan struct{}, limit.MaxInFlight)
+	}
+
+	if limit.RequestsPerSecond > 0 {
+		l.interval = time.Duration(float64(time.Second) / limit.RequestsPerSecond)
+	}
+
+	return l
+}
+
+// serviceLimiters are the limiters shared by all API clients in the process.
+// Provider configurations that specify the same limit for a service share a limiter.
+var serviceLimiters = struct {
+	lock  sync.Mutex
+	store map[string]*serviceLimiter
+}{
+	store: make(map[string]*serviceLimiter),
+}
+
+func sharedServiceLimiter(servicePackageName string, limit ServiceRateLimit) *serviceLimiter {
+	serviceLimiters.lock.Lock()
+	defer serviceLimiters.lock.Unlock()
+
+	key := fmt.Sprintf("%s/%d/%g", servicePackageName, limit.MaxInFlight, limit.RequestsPerSecond)
+	l, ok := serviceLimiters.store[key]
+	if !ok {
+		l = newServiceLimiter(limit)
+		serviceLimiters.store[key] = l
+	}
+
+	return l
+}
+
+// reserve reserves the next API call slot and returns how long the caller must wait before using it.
+func (l *serviceLimiter) reserve(now time.Time) time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+
+	return delay
+}
+
+// wait blocks until an API call may be made, returning a function that must be called once the call completes.
+func (l *serviceLimiter) wait(ctx context.Context) (func(), error) {
+	release := func() {}
+
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		default:
+			tflog.Debug(ctx, "Delaying AWS API call: maximum in-flight calls reached", map[string]any{
+				"max_in_flight": cap(l.inFlight),
+			})
+
+			select {
+			case l.inFlight <- struct{}{}:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		release = func() { <-l.inFlight }
+	}
+
+	if l.interval > 0 {
+		if delay := l.reserve(time.Now()); delay > 0 {
+			tflog.Debug(ctx, "Delaying AWS API call: requests per second limit reached", map[string]any{
+				"delay": delay.String(),
+			})
+
+			timer := time.NewTimer(delay)
+			defer timer.Stop()
+
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				release()
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	return release, nil
+}
+
+// apiOptions returns AWS SDK for Go v2 API options that apply the limiter to each API call attempt.
+func (l *serviceLimiter) apiOptions() []func(*middleware.Stack) error {
+	return []func(*middleware.Stack) error{
+		func(stack *middleware.Stack) error {
+			// Run inside the retry loop so that retried attempts are also limited.
+			return stack.Finalize.Insert(&serviceLimiterMiddleware{limiter: l}, "Retry", middleware.After)
+		},
+	}
+}
+
+type serviceLimiterMiddleware struct {
+	limiter *serviceLimiter
+}
+
+func (*serviceLimiterMiddleware) ID() string {
+	return "TF_AWS_ServiceRateLimit"
+}
+
+func (m *serviceLimiterMiddleware) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	waitCtx := tflog.SetField(ctx, "rpc.service", awsmiddleware.GetServiceID(ctx))
+	waitCtx = tflog.SetField(waitCtx, "rpc.method", awsmiddleware.GetOperationName(ctx))
+
+	release, err := m.limiter.wait(waitCtx)
+	if err != nil {
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+	}
+	defer release()
+
+	return next.HandleFinalize(ctx, in)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+func TestServiceLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	l := newServiceLimiter(ServiceRateLimit{RequestsPerSecond: 4})
+	now := time.Now()
+
+	for i, expected := range []time.Duration{0, 250 * time.Millisecond, 500 * time.Millisecond} {
+		if got := l.reserve(now); got != expected {
+			t.Errorf("reserve %d: got %s, want %s", i, got, expected)
+		}
+	}
+
+	// Unused capacity is not accumulated.
+	if got := l.reserve(now.Add(time.Minute)); got != 0 {
+		t.Errorf("reserve after idle: got %s, want 0s", got)
+	}
+}
+
+func TestSharedServiceLimiter(t *testing.T) {
+	t.Parallel()
+
+	a := sharedServiceLimiter("test-shared", ServiceRateLimit{MaxInFlight: 1})
+	b := sharedServiceLimiter("test-shared", ServiceRateLimit{MaxInFlight: 1})
+	c := sharedServiceLimiter("test-shared", ServiceRateLimit{MaxInFlight: 2})
+
+	if a != b {
+		t.Error("expected limiters with the same limit to be shared")
+	}
+	if a == c {
+		t.Error("expected limiters with different limits not to be shared")
+	}
+}
+
+func TestServiceLimiterWait_canceled(t *testing.T) {
+	t.Parallel()
+
+	l := newServiceLimiter(ServiceRateLimit{MaxInFlight: 1})
+
+	release, err := l.wait(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := l.wait(ctx); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+func TestServiceLimiterMiddleware(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+
+		w.Header().Set("Content-Type", "text/xml")
+		w.Write([]byte(testTelemetryGetCallerIdentityResponse)) //nolint:errcheck // Test server.
+	}))
+	t.Cleanup(server.Close)
+
+	l := newServiceLimiter(ServiceRateLimit{MaxInFlight: 2, RequestsPerSecond: 50})
+	cfg := aws.Config{
+		APIOptions:  l.apiOptions(),
+		Credentials: credentials.NewStaticCredentialsProvider("test", "test", ""),
+		Region:      "us-west-2", //lintignore:AWSAT003
+	}
+	conn := sts.NewFromConfig(cfg, func(o *sts.Options) {
+		o.BaseEndpoint = aws.String(server.URL)
+	})
+
+	const n = 10
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, limit := maxInFlight.Load(), int32(2); got > limit {
+		t.Errorf("expected at most %d concurrent calls, got %d", limit, got)
+	}
+
+	// 10 calls at 50 per second take at least 180ms.
+	if got, minimum := time.Since(start), 180*time.Millisecond; got < minimum {
+		t.Errorf("expected calls to take at least %s, took %s", minimum, got)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// ErrReadOnlyOperation is returned, wrapped, when an AWS API operation that is not read-only
+// is attempted by a client configured to be read-only.
+var ErrReadOnlyOperation = errors.New("operation is not permitted in read-only mode")
+
+// readOnlyOperationPrefixes are the AWS API operation name prefixes considered to be read-only.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+}
+
+// isReadOnlyOperation returns whether the named AWS API operation is read-only.
+func isReadOnlyOperation(name string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func readOnlyOperationError(serviceID, operation string) error {
+	return fmt.Errorf("%s.%s: %w", serviceID, operation, ErrReadOnlyOperation)
+}
+
+// readOnlyAPIOptions returns AWS SDK for Go v2 API options that reject any operation that is not read-only.
+func readOnlyAPIOptions() []func(*middleware.Stack) error {
+	return []func(*middleware.Stack) error{
+		func(stack *middleware.Stack) error {
+			// Run after service metadata has been registered and before any request is sent.
+			return stack.Initialize.Add(readOnlyMiddleware{}, middleware.After)
+		},
+	}
+}
+
+type readOnlyMiddleware struct{}
+
+func (readOnlyMiddleware) ID() string {
+	return "TF_AWS_ReadOnly"
+}
+
+func (readOnlyMiddleware) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	if operation := awsmiddleware.GetOperationName(ctx); !isReadOnlyOperation(operation) {
+		return middleware.InitializeOutput{}, middleware.Metadata{}, readOnlyOperationError(awsmiddleware.GetServiceID(ctx), operation)
+	}
+
+	return next.HandleInitialize(ctx, in)
+}
+
+// addReadOnlySDKv1Handlers adds AWS SDK for Go v1 handlers that reject any operation that is not read-only.
+func addReadOnlySDKv1Handlers(handlers *request_sdkv1.Handlers) {
+	handlers.Validate.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: "tf_aws.ReadOnly",
+		Fn: func(r *request_sdkv1.Request) {
+			if operation := r.Operation.Name; !isReadOnlyOperation(operation) {
+				r.Error = readOnlyOperationError(r.ClientInfo.ServiceID, operation)
+			}
+		},
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"AssumeRole":         false,
+		"BatchGetItem":       true,
+		"CreateTopic":        false,
+		"DeleteBucket":       false,
+		"DescribeInstances":  true,
+		"GetCallerIdentity":  true,
+		"HeadObject":         true,
+		"ListTopics":         true,
+		"LookupEvents":       true,
+		"PutObject":          false,
+		"Query":              true,
+		"Scan":               true,
+		"SearchResources":    true,
+		"TagResource":        false,
+		"UpdateFunctionCode": false,
+		"getCallerIdentity":  false,
+		"":                   false,
+	}
+
+	for operation, expected := range testCases {
+		if got := isReadOnlyOperation(operation); got != expected {
+			t.Errorf("isReadOnlyOperation(%q) = %t, want %t", operation, got, expected)
+		}
+	}
+}
+
+func TestReadOnlyMiddleware(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var calls atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "text/xml")
+		w.Write([]byte(testTelemetryGetCallerIdentityResponse)) //nolint:errcheck // Test server.
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := aws.Config{
+		APIOptions:  readOnlyAPIOptions(),
+		Credentials: credentials.NewStaticCredentialsProvider("test", "test", ""),
+		Region:      "us-west-2", //lintignore:AWSAT003
+	}
+	conn := sts.NewFromConfig(cfg, func(o *sts.Options) {
+		o.BaseEndpoint = aws.String(server.URL)
+	})
+
+	output, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+
+	if err != nil {
+		t.Fatalf("GetCallerIdentity: unexpected error: %s", err)
+	}
+	if got, want := aws.ToString(output.Account), "123456789012"; got != want {
+		t.Errorf("Account = %q, want %q", got, want)
+	}
+
+	_, err = conn.AssumeRole(ctx, &sts.AssumeRoleInput{
+		RoleArn:         aws.String("arn:aws:iam::123456789012:role/test"), //lintignore:AWSAT005
+		RoleSessionName: aws.String("test"),
+	})
+
+	if err == nil {
+		t.Fatal("AssumeRole: expected error, got none")
+	}
+	if got, want := err.Error(), "operation is not permitted in read-only mode"; !strings.Contains(got, want) {
+		t.Errorf("AssumeRole: error %q does not contain %q", got, want)
+	}
+	if !errors.Is(err, ErrReadOnlyOperation) {
+		t.Errorf("AssumeRole: error %q is not ErrReadOnlyOperation", err)
+	}
+
+	if got, want := calls.Load(), int64(1); got != want {
+		t.Errorf("server calls = %d, want %d", got, want)
+	}
+}
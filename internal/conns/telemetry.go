@@ -0,0 +1,339 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const (
+	// EnvVarOTLPTracesEndpoint is the environment variable that enables export of client-side API call telemetry
+	// as OpenTelemetry traces. Its value is either an OTLP/HTTP collector URL or the path of a local file.
+	EnvVarOTLPTracesEndpoint = "TF_AWS_OTLP_TRACES_ENDPOINT"
+)
+
+// TelemetryStats are aggregated client-side metrics for a single resource or AWS API operation.
+type TelemetryStats struct {
+	Count         int           // Number of operations
+	Errors        int           // Number of operations that failed
+	APICalls      int           // Number of AWS API calls made (resource operations only)
+	Retries       int           // Number of AWS API call retries
+	Throttles     int           // Number of AWS API call attempts that were throttled
+	TotalDuration time.Duration // Total wall-clock time
+	MaxDuration   time.Duration // Longest single operation
+}
+
+func (s *TelemetryStats) add(d time.Duration, failed bool, apiCalls, retries, throttles int) {
+	s.Count++
+	if failed {
+		s.Errors++
+	}
+	s.APICalls += apiCalls
+	s.Retries += retries
+	s.Throttles += throttles
+	s.TotalDuration += d
+	s.MaxDuration = max(s.MaxDuration, d)
+}
+
+func (s TelemetryStats) String() string {
+	return fmt.Sprintf("count=%d errors=%d api_calls=%d retries=%d throttles=%d total=%s max=%s", s.Count, s.Errors, s.APICalls, s.Retries, s.Throttles, s.TotalDuration, s.MaxDuration)
+}
+
+// Telemetry records client-side metrics for resource operations and the AWS API calls they make,
+// optionally exporting them as OpenTelemetry spans.
+type Telemetry struct {
+	shutdown func(context.Context) error
+	tracer   trace.Tracer
+
+	lock      sync.Mutex
+	apiCalls  map[string]*TelemetryStats // Keyed by "<service ID>.<operation>", e.g. "EC2.DescribeVpcs"
+	resources map[string]*TelemetryStats // Keyed by "<type name>.<operation>", e.g. "aws_vpc.Read"
+}
+
+func newTelemetry(tracer trace.Tracer, shutdown func(context.Context) error) *Telemetry {
+	return &Telemetry{
+		apiCalls:  make(map[string]*TelemetryStats),
+		resources: make(map[string]*TelemetryStats),
+		shutdown:  shutdown,
+		tracer:    tracer,
+	}
+}
+
+// NewTelemetry returns a Telemetry that records metrics without exporting any traces.
+func NewTelemetry() *Telemetry {
+	return newTelemetry(noop.NewTracerProvider().Tracer(""), nil)
+}
+
+var (
+	globalTelemetry = sync.OnceValues(func() (*Telemetry, error) {
+		return newTelemetryFromEnv(context.Background())
+	})
+)
+
+// ShutdownTelemetry flushes any pending trace spans and logs aggregated metrics.
+// It should be called once, when the provider process exits.
+func ShutdownTelemetry(ctx context.Context) error {
+	t, err := globalTelemetry()
+	if err != nil || t == nil {
+		return err
+	}
+
+	return t.Shutdown(ctx)
+}
+
+// Shutdown flushes any pending trace spans and logs aggregated metrics.
+func (t *Telemetry) Shutdown(ctx context.Context) error {
+	for _, v := range []struct {
+		kind  string
+		stats map[string]TelemetryStats
+	}{
+		{"resource", t.ResourceStats()},
+		{"API", t.APICallStats()},
+	} {
+		keys := tfmaps.Keys(v.stats)
+		slices.Sort(keys)
+		for _, k := range keys {
+			log.Printf("[DEBUG] Telemetry (%s operation %s): %s", v.kind, k, v.stats[k])
+		}
+	}
+
+	if t.shutdown == nil {
+		return nil
+	}
+
+	return t.shutdown(ctx)
+}
+
+// APICallStats returns a snapshot of the aggregated metrics for each AWS API operation.
+func (t *Telemetry) APICallStats() map[string]TelemetryStats {
+	return t.snapshot(t.apiCalls)
+}
+
+// ResourceStats returns a snapshot of the aggregated metrics for each resource operation.
+func (t *Telemetry) ResourceStats() map[string]TelemetryStats {
+	return t.snapshot(t.resources)
+}
+
+func (t *Telemetry) snapshot(m map[string]*TelemetryStats) map[string]TelemetryStats {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	s := make(map[string]TelemetryStats, len(m))
+	for k, v := range m {
+		s[k] = *v
+	}
+
+	return s
+}
+
+func (t *Telemetry) record(m map[string]*TelemetryStats, key string, d time.Duration, failed bool, apiCalls, retries, throttles int) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	s, ok := m[key]
+	if !ok {
+		s = &TelemetryStats{}
+		m[key] = s
+	}
+	s.add(d, failed, apiCalls, retries, throttles)
+}
+
+// telemetryOperation tracks an in-progress resource operation.
+type telemetryOperation struct {
+	apiCalls  atomic.Int64
+	retries   atomic.Int64
+	throttles atomic.Int64
+	key       string
+	span      trace.Span
+	start     time.Time
+}
+
+// StartResourceOperation starts recording a resource (or data source) operation, e.g. "Read".
+// The returned Context must be used for all AWS API calls made by the operation and passed to EndResourceOperation.
+func (t *Telemetry) StartResourceOperation(ctx context.Context, typeName, operation string) context.Context {
+	ctx, span := t.tracer.Start(ctx, typeName+"."+operation,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			attribute.String("tf_aws.resource_type", typeName),
+			attribute.String("tf_aws.operation", operation),
+		),
+	)
+
+	op := &telemetryOperation{
+		key:   typeName + "." + operation,
+		span:  span,
+		start: time.Now(),
+	}
+
+	return context.WithValue(ctx, contextKeyTelemetry, op)
+}
+
+// EndResourceOperation finishes recording the resource operation started in Context.
+func (t *Telemetry) EndResourceOperation(ctx context.Context, failed bool) {
+	op, ok := ctx.Value(contextKeyTelemetry).(*telemetryOperation)
+	if !ok {
+		return
+	}
+
+	d := time.Since(op.start)
+	apiCalls, retries, throttles := int(op.apiCalls.Load()), int(op.retries.Load()), int(op.throttles.Load())
+
+	op.span.SetAttributes(
+		attribute.Int("tf_aws.api_calls", apiCalls),
+		attribute.Int("tf_aws.retries", retries),
+		attribute.Int("tf_aws.throttles", throttles),
+	)
+	if failed {
+		op.span.SetStatus(codes.Error, "")
+	}
+	op.span.End()
+
+	t.record(t.resources, op.key, d, failed, apiCalls, retries, throttles)
+
+	tflog.Debug(ctx, "Resource operation telemetry", map[string]any{
+		"tf_aws.api_calls":   apiCalls,
+		"tf_aws.duration_ms": d.Milliseconds(),
+		"tf_aws.retries":     retries,
+		"tf_aws.throttles":   throttles,
+	})
+}
+
+// endAPICall records a completed AWS API call against both the API operation and any enclosing resource operation.
+func (t *Telemetry) endAPICall(ctx context.Context, span trace.Span, key string, d time.Duration, err error, retries, throttles int) {
+	span.SetAttributes(
+		attribute.Int("tf_aws.retries", retries),
+		attribute.Int("tf_aws.throttles", throttles),
+	)
+	if err != nil {
+		if apiErr, ok := errs.As[smithy.APIError](err); ok {
+			span.SetAttributes(attribute.String("aws.error_code", apiErr.ErrorCode()))
+		} else if awsErr, ok := errs.As[awserr.Error](err); ok {
+			span.SetAttributes(attribute.String("aws.error_code", awsErr.Code()))
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+
+	t.record(t.apiCalls, key, d, err != nil, 0, retries, throttles)
+
+	if op, ok := ctx.Value(contextKeyTelemetry).(*telemetryOperation); ok {
+		op.apiCalls.Add(1)
+		op.retries.Add(int64(retries))
+		op.throttles.Add(int64(throttles))
+	}
+}
+
+func (t *Telemetry) startAPICall(ctx context.Context, serviceID, operation, region string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	opts = append(opts,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "aws-api"),
+			attribute.String("rpc.service", serviceID),
+			attribute.String("rpc.method", operation),
+			attribute.String("cloud.region", region),
+		),
+	)
+
+	return t.tracer.Start(ctx, serviceID+"."+operation, opts...)
+}
+
+// apiOptions returns AWS SDK for Go v2 API options that add the telemetry middleware to every API client.
+func (t *Telemetry) apiOptions() []func(*middleware.Stack) error {
+	return []func(*middleware.Stack) error{
+		func(stack *middleware.Stack) error {
+			// Run after service metadata has been registered but outside the retry loop.
+			return stack.Initialize.Add(&telemetryMiddleware{telemetry: t}, middleware.After)
+		},
+	}
+}
+
+type telemetryMiddleware struct {
+	telemetry *Telemetry
+}
+
+func (*telemetryMiddleware) ID() string {
+	return "TF_AWS_Telemetry"
+}
+
+func (m *telemetryMiddleware) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	serviceID, operation := awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx)
+	ctx, span := m.telemetry.startAPICall(ctx, serviceID, operation, awsmiddleware.GetRegion(ctx))
+	start := time.Now()
+
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	d := time.Since(start)
+	var retries, throttles int
+	if results, ok := retry.GetAttemptResults(metadata); ok {
+		retries = max(len(results.Results)-1, 0)
+		for _, v := range results.Results {
+			if v.Err != nil && isThrottleErrorV2(v.Err) {
+				throttles++
+			}
+		}
+	}
+	if requestID, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		span.SetAttributes(attribute.String("aws.request_id", requestID))
+	}
+	m.telemetry.endAPICall(ctx, span, serviceID+"."+operation, d, err, retries, throttles)
+
+	return out, metadata, err
+}
+
+func isThrottleErrorV2(err error) bool {
+	return retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err).Bool()
+}
+
+// addSDKv1Handlers adds AWS SDK for Go v1 request handlers that record telemetry for every API call.
+func (t *Telemetry) addSDKv1Handlers(handlers *request_sdkv1.Handlers) {
+	var throttles sync.Map // *request.Request -> int
+
+	handlers.CompleteAttempt.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "tf_aws.TelemetryAttempt",
+		Fn: func(r *request_sdkv1.Request) {
+			if r.Error != nil && request_sdkv1.IsErrorThrottle(r.Error) {
+				n, _ := throttles.LoadOrStore(r, 0)
+				throttles.Store(r, n.(int)+1)
+			}
+		},
+	})
+	handlers.Complete.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "tf_aws.Telemetry",
+		Fn: func(r *request_sdkv1.Request) {
+			ctx := r.Context()
+			serviceID, operation := r.ClientInfo.ServiceID, r.Operation.Name
+			_, span := t.startAPICall(ctx, serviceID, operation, r.ClientInfo.SigningRegion, trace.WithTimestamp(r.Time))
+			if r.RequestID != "" {
+				span.SetAttributes(attribute.String("aws.request_id", r.RequestID))
+			}
+
+			var n int
+			if v, ok := throttles.LoadAndDelete(r); ok {
+				n = v.(int)
+			}
+			t.endAPICall(ctx, span, serviceID+"."+operation, time.Since(r.Time), r.Error, r.RetryCount, n)
+		},
+	})
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/version"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+	"go.opentelemetry.io/otel/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	otlpTracesPath = "/v1/traces"
+	tracerName     = "github.com/hashicorp/terraform-provider-aws"
+)
+
+// newTelemetryFromEnv returns a Telemetry that exports traces to the destination configured
+// in the TF_AWS_OTLP_TRACES_ENDPOINT environment variable.
+// If the variable is not set, traces are not exported.
+func newTelemetryFromEnv(ctx context.Context) (*Telemetry, error) {
+	v := os.Getenv(EnvVarOTLPTracesEndpoint)
+	if v == "" {
+		return NewTelemetry(), nil
+	}
+
+	exporter, err := newOTLPTraceExporter(ctx, v)
+	if err != nil {
+		return NewTelemetry(), fmt.Errorf("configuring OpenTelemetry trace export (%s=%q): %w", EnvVarOTLPTracesEndpoint, v, err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceName("terraform-provider-aws"),
+			semconv.ServiceVersion(version.ProviderVersion),
+			semconv.ProcessPID(os.Getpid()),
+		)),
+	)
+
+	return newTelemetry(tp.Tracer(tracerName, trace.WithInstrumentationVersion(version.ProviderVersion)), tp.Shutdown), nil
+}
+
+// newOTLPTraceExporter returns an OTLP span exporter.
+// An http:// or https:// endpoint is an OTLP/HTTP collector, e.g. a local OpenTelemetry Collector or Jaeger.
+// If the endpoint has no path, the standard traces path is used.
+// Any other value is the path of a local file (optionally as a file:// URL) to which spans are appended
+// in OTLP JSON format, one export request per line.
+func newOTLPTraceExporter(ctx context.Context, endpoint string) (*otlptrace.Exporter, error) {
+	if u, err := url.Parse(endpoint); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		if u.Path == "" || u.Path == "/" {
+			u.Path = otlpTracesPath
+		}
+
+		return otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(u.String()))
+	}
+
+	return otlptrace.New(ctx, &otlpFileClient{path: strings.TrimPrefix(endpoint, "file://")})
+}
+
+// otlpFileClient is an otlptrace.Client that writes OTLP JSON Lines to a local file.
+type otlpFileClient struct {
+	file *os.File
+	lock sync.Mutex
+	path string
+}
+
+var _ otlptrace.Client = (*otlpFileClient)(nil)
+
+func (c *otlpFileClient) Start(context.Context) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	f, err := os.OpenFile(c.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	c.file = f
+
+	return nil
+}
+
+func (c *otlpFileClient) Stop(context.Context) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.file == nil {
+		return nil
+	}
+
+	err := c.file.Close()
+	c.file = nil
+
+	return err
+}
+
+func (c *otlpFileClient) UploadTraces(_ context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	line, err := marshalOTLPJSON(&coltracepb.ExportTraceServiceRequest{ResourceSpans: protoSpans})
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.file == nil {
+		return fmt.Errorf("writing OTLP traces to %s: file is closed", c.path)
+	}
+
+	_, err = c.file.Write(append(line, '\n'))
+
+	return err
+}
+
+// marshalOTLPJSON marshals an OTLP export request using the OTLP JSON encoding,
+// which differs from the canonical protobuf JSON mapping in that enums are integers
+// and trace and span IDs are hex (not base64) encoded.
+func marshalOTLPJSON(request *coltracepb.ExportTraceServiceRequest) ([]byte, error) {
+	b, err := protojson.MarshalOptions{UseEnumNumbers: true}.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var v any
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	if err := hexEncodeOTLPIDs(v); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(v)
+}
+
+func hexEncodeOTLPIDs(v any) error {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			switch k {
+			case "traceId", "spanId", "parentSpanId":
+				if s, ok := e.(string); ok {
+					b, err := base64.StdEncoding.DecodeString(s)
+					if err != nil {
+						return fmt.Errorf("decoding %s: %w", k, err)
+					}
+					v[k] = hex.EncodeToString(b)
+				}
+			default:
+				if err := hexEncodeOTLPIDs(e); err != nil {
+					return err
+				}
+			}
+		}
+	case []any:
+		for _, e := range v {
+			if err := hexEncodeOTLPIDs(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
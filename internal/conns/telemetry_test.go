@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+const (
+	testTelemetryGetCallerIdentityResponse = `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::123456789012:user/test</Arn>
+    <UserId>AIDACKCEVSQ6C2EXAMPLE</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`
+	testTelemetryThrottlingResponse = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>Throttling</Code>
+    <Message>Rate exceeded</Message>
+  </Error>
+  <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+</ErrorResponse>`
+)
+
+// testTelemetrySTSClient returns an STS client whose API calls are recorded by telemetry.
+// The first `throttles` calls to the mock endpoint are throttled.
+func testTelemetrySTSClient(t *testing.T, telemetry *Telemetry, throttles int) *sts.Client {
+	t.Helper()
+
+	var calls atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		if calls.Add(1) <= int64(throttles) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(testTelemetryThrottlingResponse)) //nolint:errcheck // Test server.
+			return
+		}
+		w.Write([]byte(testTelemetryGetCallerIdentityResponse)) //nolint:errcheck // Test server.
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := aws.Config{
+		APIOptions:  telemetry.apiOptions(),
+		Credentials: credentials.NewStaticCredentialsProvider("test", "test", ""),
+		Region:      "us-west-2", //lintignore:AWSAT003
+		Retryer: func() aws.Retryer {
+			return retry.NewStandard(func(o *retry.StandardOptions) {
+				o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) { return 0, nil })
+				o.RateLimiter = noRateLimiter{}
+			})
+		},
+	}
+
+	return sts.NewFromConfig(cfg, func(o *sts.Options) {
+		o.BaseEndpoint = aws.String(server.URL)
+	})
+}
+
+type noRateLimiter struct{}
+
+func (noRateLimiter) GetToken(context.Context, uint) (func() error, error) {
+	return func() error { return nil }, nil
+}
+
+func (noRateLimiter) AddTokens(uint) error {
+	return nil
+}
+
+func TestTelemetryMiddleware(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	telemetry := NewTelemetry()
+	conn := testTelemetrySTSClient(t, telemetry, 1)
+
+	ctx = telemetry.StartResourceOperation(ctx, "aws_test", "Read")
+	for range 2 {
+		if _, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	telemetry.EndResourceOperation(ctx, false)
+
+	ignoreDurations := cmpopts.IgnoreFields(TelemetryStats{}, "TotalDuration", "MaxDuration")
+
+	if diff := cmp.Diff(telemetry.APICallStats(), map[string]TelemetryStats{
+		"STS.GetCallerIdentity": {Count: 2, Retries: 1, Throttles: 1},
+	}, ignoreDurations); diff != "" {
+		t.Errorf("unexpected API call stats diff (+wanted, -got): %s", diff)
+	}
+
+	if diff := cmp.Diff(telemetry.ResourceStats(), map[string]TelemetryStats{
+		"aws_test.Read": {Count: 1, APICalls: 2, Retries: 1, Throttles: 1},
+	}, ignoreDurations); diff != "" {
+		t.Errorf("unexpected resource stats diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestTelemetryMiddleware_error(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	telemetry := NewTelemetry()
+	conn := testTelemetrySTSClient(t, telemetry, 100)
+
+	ctx = telemetry.StartResourceOperation(ctx, "aws_test", "Create")
+	_, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	telemetry.EndResourceOperation(ctx, true)
+
+	stats := telemetry.APICallStats()["STS.GetCallerIdentity"]
+	if got, want := stats.Errors, 1; got != want {
+		t.Errorf("Errors = %d, want %d", got, want)
+	}
+	if got, want := stats.Throttles, retry.DefaultMaxAttempts; got != want {
+		t.Errorf("Throttles = %d, want %d", got, want)
+	}
+
+	if got, want := telemetry.ResourceStats()["aws_test.Create"].Errors, 1; got != want {
+		t.Errorf("resource Errors = %d, want %d", got, want)
+	}
+}
+
+func TestTelemetryOTLPFileExport(t *testing.T) { //nolint:paralleltest // Sets environment variable.
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	t.Setenv(EnvVarOTLPTracesEndpoint, "file://"+path)
+
+	telemetry, err := newTelemetryFromEnv(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn := testTelemetrySTSClient(t, telemetry, 0)
+
+	ctx = telemetry.StartResourceOperation(ctx, "aws_test", "Read")
+	if _, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatal(err)
+	}
+	telemetry.EndResourceOperation(ctx, false)
+
+	if err := telemetry.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var spans []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(string(b)), "\n") {
+		var request struct {
+			ResourceSpans []struct {
+				ScopeSpans []struct {
+					Spans []map[string]any `json:"spans"`
+				} `json:"scopeSpans"`
+			} `json:"resourceSpans"`
+		}
+		if err := json.Unmarshal([]byte(line), &request); err != nil {
+			t.Fatal(err)
+		}
+		for _, v := range request.ResourceSpans {
+			for _, v := range v.ScopeSpans {
+				spans = append(spans, v.Spans...)
+			}
+		}
+	}
+
+	names := make(map[string]map[string]any)
+	for _, v := range spans {
+		names[v["name"].(string)] = v
+	}
+
+	resourceSpan, ok := names["aws_test.Read"]
+	if !ok {
+		t.Fatalf("no resource span in %v", spans)
+	}
+	apiSpan, ok := names["STS.GetCallerIdentity"]
+	if !ok {
+		t.Fatalf("no API call span in %v", spans)
+	}
+
+	if got, want := apiSpan["parentSpanId"], resourceSpan["spanId"]; got != want {
+		t.Errorf("API call span parent = %v, want %v", got, want)
+	}
+	if got, want := len(resourceSpan["traceId"].(string)), 32; got != want {
+		t.Errorf("len(traceId) = %d, want %d (hex encoded)", got, want)
+	}
+}
@@ -186,6 +186,128 @@ func (g *Graph) OverallOrder() ([]string, error) {
 	return order, nil
 }
 
+// Cycles returns every dependency cycle in the graph.
+// Each strongly connected component containing a cycle is reported once, as a path
+// that starts and ends at the component's first node (in insertion order).
+func (g *Graph) Cycles() [][]string {
+	cycles := make([][]string, 0)
+
+	for _, component := range stronglyConnectedComponents(g.nodes, g.outgoingEdges) {
+		start := component[0]
+
+		if len(component) == 1 && !slices.Contains(g.outgoingEdges[start], start) {
+			continue
+		}
+
+		cycles = append(cycles, shortestCycle(start, component, g.outgoingEdges))
+	}
+
+	return cycles
+}
+
+// stronglyConnectedComponents returns the strongly connected components of a set of edges
+// using Tarjan's algorithm. Components, and the nodes within them, are in node order.
+func stronglyConnectedComponents(nodes []string, edges map[string][]string) [][]string {
+	var (
+		index      int
+		indices    = make(map[string]int)
+		lowLinks   = make(map[string]int)
+		onStack    = make(map[string]bool)
+		stack      = make([]string, 0)
+		components = make([][]string, 0)
+		order      = make(map[string]int, len(nodes))
+	)
+
+	for i, node := range nodes {
+		order[node] = i
+	}
+
+	var strongConnect func(string)
+	strongConnect = func(node string) {
+		indices[node] = index
+		lowLinks[node] = index
+		index++
+		stack = append(stack, node)
+		onStack[node] = true
+
+		for _, edge := range edges[node] {
+			if _, ok := indices[edge]; !ok {
+				strongConnect(edge)
+				lowLinks[node] = min(lowLinks[node], lowLinks[edge])
+			} else if onStack[edge] {
+				lowLinks[node] = min(lowLinks[node], indices[edge])
+			}
+		}
+
+		if lowLinks[node] == indices[node] {
+			component := make([]string, 0)
+
+			for {
+				n := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[n] = false
+				component = append(component, n)
+
+				if n == node {
+					break
+				}
+			}
+
+			slices.SortFunc(component, func(a, b string) int {
+				return order[a] - order[b]
+			})
+			components = append(components, component)
+		}
+	}
+
+	for _, node := range nodes {
+		if _, ok := indices[node]; !ok {
+			strongConnect(node)
+		}
+	}
+
+	slices.SortFunc(components, func(a, b []string) int {
+		return order[a[0]] - order[b[0]]
+	})
+
+	return components
+}
+
+// shortestCycle returns the shortest path from start back to start through the specified component.
+func shortestCycle(start string, component []string, edges map[string][]string) []string {
+	previous := make(map[string]string)
+	queue := []string{start}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for _, edge := range edges[node] {
+			if !slices.Contains(component, edge) {
+				continue
+			}
+
+			if edge == start {
+				path := []string{start}
+				for n := node; n != start; n = previous[n] {
+					path = append(path, n)
+				}
+				path = append(path, start)
+				slices.Reverse(path[1 : len(path)-1])
+
+				return path
+			}
+
+			if _, ok := previous[edge]; !ok {
+				previous[edge] = node
+				queue = append(queue, edge)
+			}
+		}
+	}
+
+	return []string{start}
+}
+
 // depthFirstSearch returns a Topological Sort using Depth-First-Search on a set of edges.
 // Returns an error if a dependency cycle is detected.
 func depthFirstSearch(edges map[string][]string) func(s string) ([]string, error) {
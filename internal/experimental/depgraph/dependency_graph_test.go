@@ -417,3 +417,53 @@ func TestDependencyGraphOverallOrder(t *testing.T) {
 		t.Fatalf("incorrect overall order. Expected: %v, got: %v", expected, got)
 	}
 }
+
+func TestDependencyGraphCycles(t *testing.T) {
+	t.Parallel()
+
+	g := New()
+
+	if got, expected := g.Cycles(), [][]string{}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("incorrect cycles. Expected: %v, got: %v", expected, got)
+	}
+
+	g.AddNode("a")
+	g.AddNode("b")
+	g.AddNode("c")
+	g.AddNode("d")
+	g.AddNode("e")
+
+	err := g.AddDependency("a", "b")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = g.AddDependency("b", "c")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := g.Cycles(), [][]string{}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("incorrect cycles. Expected: %v, got: %v", expected, got)
+	}
+
+	err = g.AddDependency("c", "a")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = g.AddDependency("d", "d")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = g.AddDependency("e", "a")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := [][]string{
+		{"a", "b", "c", "a"},
+		{"d", "d"},
+	}
+	if diff := cmp.Diff(g.Cycles(), expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
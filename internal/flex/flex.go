@@ -343,14 +343,20 @@ func FlattenInt32List(list []*int32) []interface{} {
 // Takes a string of resource attributes separated by the ResourceIdSeparator constant, an expected number of Id Parts, and a boolean specifying if empty parts are to be allowed
 // Returns a list of the resource attributes strings used to construct the unique Id or an error message if the resource id does not parse properly
 func ExpandResourceId(id string, partCount int, allowEmptyPart bool) ([]string, error) {
-	idParts := strings.Split(id, ResourceIdSeparator)
+	return ExpandResourceIdWithSeparator(id, ResourceIdSeparator, partCount, allowEmptyPart)
+}
+
+// ExpandResourceIdWithSeparator is ExpandResourceId for resource Ids whose parts are separated by separator.
+// It is also used by identity.Identity to split composite IDs.
+func ExpandResourceIdWithSeparator(id, separator string, partCount int, allowEmptyPart bool) ([]string, error) {
+	idParts := strings.Split(id, separator)
 
 	if len(idParts) <= 1 {
 		return nil, fmt.Errorf("unexpected format for ID (%v), expected more than one part", idParts)
 	}
 
 	if len(idParts) != partCount {
-		return nil, fmt.Errorf("unexpected format for ID (%s), expected (%d) parts separated by (%s)", id, partCount, ResourceIdSeparator)
+		return nil, fmt.Errorf("unexpected format for ID (%s), expected (%d) parts separated by (%s)", id, partCount, separator)
 	}
 
 	if !allowEmptyPart {
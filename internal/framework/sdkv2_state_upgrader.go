@@ -0,0 +1,520 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+// SDKv2StateUpgrader returns a state upgrader which translates state written by the Plugin SDK V2 implementation of a resource
+// into the resource's Plugin Framework schema.
+// The Plugin Framework schema's version must be greater than the Plugin SDK V2 schema's version for the upgrader to be called.
+//
+// The following Plugin SDK V2 state encodings are translated:
+//   - Flatmapped state, e.g. `tags.%` and `rule.1234567.name`, written by Terraform CLI 0.11 and earlier
+//   - `TypeList` `MaxItems: 1` blocks, which become a single nested block or object attribute if the Plugin Framework schema declares one
+//   - `timeouts`, whose attributes are dropped if they are no longer declared
+//
+// Attributes that are not declared in the Plugin Framework schema are dropped, and missing attributes are null.
+func SDKv2StateUpgrader() resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: upgradeSDKv2State,
+	}
+}
+
+func upgradeSDKv2State(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+	if request.RawState == nil {
+		response.Diagnostics.AddError("Upgrading Plugin SDK V2 resource state", "missing raw state")
+
+		return
+	}
+
+	typ := response.State.Schema.Type().TerraformType(ctx)
+	var raw any
+
+	switch rawState := request.RawState; {
+	case rawState.JSON != nil:
+		decoder := json.NewDecoder(bytes.NewReader(rawState.JSON))
+		decoder.UseNumber()
+
+		if err := decoder.Decode(&raw); err != nil {
+			response.Diagnostics.AddError("Upgrading Plugin SDK V2 resource state", fmt.Sprintf("decoding JSON state: %s", err))
+
+			return
+		}
+	case rawState.Flatmap != nil:
+		raw = unflattenSDKv2State(typ, rawState.Flatmap, "")
+	}
+
+	value, err := sdkv2StateValue(typ, raw, tftypes.NewAttributePath())
+
+	if err != nil {
+		response.Diagnostics.AddError("Upgrading Plugin SDK V2 resource state", err.Error())
+
+		return
+	}
+
+	response.State.Raw = value
+}
+
+// sdkv2StateValue converts a decoded Plugin SDK V2 state value to a value of the specified type.
+func sdkv2StateValue(typ tftypes.Type, v any, path *tftypes.AttributePath) (tftypes.Value, error) {
+	if v == nil {
+		switch typ := typ.(type) {
+		case tftypes.List:
+			// Plugin SDK V2 does not distinguish between null and empty nested blocks.
+			if _, ok := typ.ElementType.(tftypes.Object); ok {
+				return tftypes.NewValue(typ, []tftypes.Value{}), nil
+			}
+		case tftypes.Set:
+			if _, ok := typ.ElementType.(tftypes.Object); ok {
+				return tftypes.NewValue(typ, []tftypes.Value{}), nil
+			}
+		}
+
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		switch v := v.(type) {
+		case bool:
+			return tftypes.NewValue(typ, v), nil
+		case string:
+			if v == "" {
+				return tftypes.NewValue(typ, nil), nil
+			}
+
+			b, err := strconv.ParseBool(v)
+
+			if err != nil {
+				return tftypes.Value{}, path.NewErrorf("parsing bool: %w", err)
+			}
+
+			return tftypes.NewValue(typ, b), nil
+		}
+	case typ.Is(tftypes.Number):
+		var s string
+
+		switch v := v.(type) {
+		case json.Number:
+			s = v.String()
+		case string:
+			if v == "" {
+				return tftypes.NewValue(typ, nil), nil
+			}
+
+			s = v
+		default:
+			return tftypes.Value{}, path.NewErrorf("unexpected %T value for number", v)
+		}
+
+		f, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return tftypes.Value{}, path.NewErrorf("parsing number: %w", err)
+		}
+
+		return tftypes.NewValue(typ, f), nil
+	case typ.Is(tftypes.String):
+		switch v := v.(type) {
+		case bool:
+			return tftypes.NewValue(typ, strconv.FormatBool(v)), nil
+		case json.Number:
+			return tftypes.NewValue(typ, v.String()), nil
+		case string:
+			return tftypes.NewValue(typ, v), nil
+		}
+	}
+
+	switch typ := typ.(type) {
+	case tftypes.List:
+		return sdkv2StateCollectionValue(typ, typ.ElementType, v, path)
+	case tftypes.Set:
+		return sdkv2StateCollectionValue(typ, typ.ElementType, v, path)
+	case tftypes.Map:
+		m, ok := v.(map[string]any)
+
+		if !ok {
+			return tftypes.Value{}, path.NewErrorf("unexpected %T value for map", v)
+		}
+
+		elems := make(map[string]tftypes.Value, len(m))
+		for key, v := range m {
+			elem, err := sdkv2StateValue(typ.ElementType, v, path.WithElementKeyString(key))
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			elems[key] = elem
+		}
+
+		return tftypes.NewValue(typ, elems), nil
+	case tftypes.Object:
+		// A TypeList MaxItems:1 block migrated to a single nested block or object attribute.
+		if l, ok := v.([]any); ok {
+			if len(l) == 0 {
+				return tftypes.NewValue(typ, nil), nil
+			}
+
+			v = l[0]
+		}
+
+		m, ok := v.(map[string]any)
+
+		if !ok {
+			return tftypes.Value{}, path.NewErrorf("unexpected %T value for object", v)
+		}
+
+		attributes := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+		for name, attributeType := range typ.AttributeTypes {
+			attribute, err := sdkv2StateValue(attributeType, m[name], path.WithAttributeName(name))
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			attributes[name] = attribute
+		}
+
+		return tftypes.NewValue(typ, attributes), nil
+	}
+
+	return tftypes.Value{}, path.NewErrorf("unexpected %T value for %s", v, typ)
+}
+
+func sdkv2StateCollectionValue(typ, elemType tftypes.Type, v any, path *tftypes.AttributePath) (tftypes.Value, error) {
+	l, ok := v.([]any)
+
+	if !ok {
+		// A single nested block migrated to a list or set nested block.
+		if _, isObject := v.(map[string]any); !isObject {
+			return tftypes.Value{}, path.NewErrorf("unexpected %T value for %s", v, typ)
+		}
+
+		l = []any{v}
+	}
+
+	elems := make([]tftypes.Value, 0, len(l))
+	for i, v := range l {
+		elem, err := sdkv2StateValue(elemType, v, path.WithElementKeyInt(i))
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elems = append(elems, elem)
+	}
+
+	return tftypes.NewValue(typ, elems), nil
+}
+
+// unflattenSDKv2State decodes flatmapped Plugin SDK V2 state for the specified type.
+// Primitive values are returned as strings.
+func unflattenSDKv2State(typ tftypes.Type, m map[string]string, prefix string) any {
+	switch typ := typ.(type) {
+	case tftypes.List:
+		n, ok := flatmapCount(m, prefix+".#")
+
+		if !ok {
+			return nil
+		}
+
+		l := make([]any, n)
+		for i := range l {
+			l[i] = unflattenSDKv2State(typ.ElementType, m, prefix+"."+strconv.Itoa(i))
+		}
+
+		return l
+	case tftypes.Set:
+		if _, ok := flatmapCount(m, prefix+".#"); !ok {
+			return nil
+		}
+
+		// Set elements are keyed by hash code.
+		l := make([]any, 0)
+		for _, key := range flatmapKeys(m, prefix) {
+			l = append(l, unflattenSDKv2State(typ.ElementType, m, prefix+"."+key))
+		}
+
+		return l
+	case tftypes.Map:
+		_, ok := flatmapCount(m, prefix+".%")
+
+		if !ok {
+			if _, ok = flatmapCount(m, prefix+".#"); !ok {
+				return nil
+			}
+		}
+
+		result := make(map[string]any)
+		for k, v := range m {
+			if key, ok := strings.CutPrefix(k, prefix+"."); ok && key != "%" && key != "#" {
+				result[key] = v
+			}
+		}
+
+		return result
+	case tftypes.Object:
+		if prefix != "" {
+			// A TypeList MaxItems:1 block migrated to a single nested block or object attribute.
+			if n, ok := flatmapCount(m, prefix+".#"); ok {
+				if n == 0 {
+					return nil
+				}
+
+				prefix += ".0"
+			}
+
+			prefix += "."
+
+			if !flatmapHasPrefix(m, prefix) {
+				return nil
+			}
+		}
+
+		result := make(map[string]any, len(typ.AttributeTypes))
+		for name, attributeType := range typ.AttributeTypes {
+			result[name] = unflattenSDKv2State(attributeType, m, prefix+name)
+		}
+
+		return result
+	}
+
+	if v, ok := m[prefix]; ok {
+		return v
+	}
+
+	return nil
+}
+
+// flatmapCount returns the element count stored at the specified flatmap key.
+func flatmapCount(m map[string]string, key string) (int, bool) {
+	v, ok := m[key]
+
+	if !ok {
+		return 0, false
+	}
+
+	n, err := strconv.Atoi(v)
+
+	if err != nil {
+		return 0, false
+	}
+
+	return n, true
+}
+
+// flatmapHasPrefix returns whether any flatmap key has the specified prefix.
+func flatmapHasPrefix(m map[string]string, prefix string) bool {
+	for k := range m {
+		if strings.HasPrefix(k, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// flatmapKeys returns the sorted, distinct element keys under the specified flatmap prefix.
+func flatmapKeys(m map[string]string, prefix string) []string {
+	var keys []string
+
+	for k := range m {
+		rest, ok := strings.CutPrefix(k, prefix+".")
+
+		if !ok {
+			continue
+		}
+
+		key, _, _ := strings.Cut(rest, ".")
+
+		if key == "#" || slices.Contains(keys, key) {
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+// sdkv2StateFixture is a captured Plugin SDK V2 resource instance's state, in Terraform state file format.
+type sdkv2StateFixture struct {
+	Attributes     json.RawMessage   `json:"attributes,omitempty"`
+	AttributesFlat map[string]string `json:"attributes_flat,omitempty"`
+	SchemaVersion  int64             `json:"schema_version"`
+
+	// Expected holds the upgraded state's expected top-level string, number and bool attribute values.
+	Expected map[string]string `json:"expected,omitempty"`
+}
+
+// UpgradeSDKv2StateFixture replays a captured Plugin SDK V2 resource instance's state through the resource's state upgraders.
+// The fixture is a resource instance object from a Terraform state file, e.g.
+// `{"schema_version": 0, "attributes": {"id": "example"}}`.
+func UpgradeSDKv2StateFixture(ctx context.Context, r resource.Resource, fixture []byte) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var instance sdkv2StateFixture
+	if err := json.Unmarshal(fixture, &instance); err != nil {
+		diags.AddError("Reading Plugin SDK V2 state fixture", err.Error())
+
+		return tfsdk.State{}, diags
+	}
+
+	v, ok := r.(resource.ResourceWithUpgradeState)
+
+	if !ok {
+		diags.AddError("Reading Plugin SDK V2 state fixture", "resource does not implement UpgradeState")
+
+		return tfsdk.State{}, diags
+	}
+
+	upgrader, ok := v.UpgradeState(ctx)[instance.SchemaVersion]
+
+	if !ok || upgrader.PriorSchema != nil {
+		diags.AddError("Reading Plugin SDK V2 state fixture", fmt.Sprintf("no raw state upgrader for schema version %d", instance.SchemaVersion))
+
+		return tfsdk.State{}, diags
+	}
+
+	var schemaResponse resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+	diags.Append(schemaResponse.Diagnostics...)
+
+	if diags.HasError() {
+		return tfsdk.State{}, diags
+	}
+
+	request := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{
+			JSON:    instance.Attributes,
+			Flatmap: instance.AttributesFlat,
+		},
+	}
+	response := resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResponse.Schema,
+		},
+	}
+
+	upgrader.StateUpgrader(ctx, request, &response)
+	diags.Append(response.Diagnostics...)
+
+	return response.State, diags
+}
+
+// VerifySDKv2StateFixture replays a captured Plugin SDK V2 resource instance's state through the resource's state upgraders
+// and compares the upgraded state with the fixture's expected attribute values, e.g.
+// `{"schema_version": 0, "attributes": {"id": "example"}, "expected": {"id": "example"}}`.
+// Fixtures without expected attribute values are rejected.
+func VerifySDKv2StateFixture(ctx context.Context, r resource.Resource, fixture []byte) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var instance sdkv2StateFixture
+	if err := json.Unmarshal(fixture, &instance); err != nil {
+		diags.AddError("Reading Plugin SDK V2 state fixture", err.Error())
+
+		return tfsdk.State{}, diags
+	}
+
+	if len(instance.Expected) == 0 {
+		diags.AddError("Reading Plugin SDK V2 state fixture", "fixture has no expected attribute values")
+
+		return tfsdk.State{}, diags
+	}
+
+	state, d := UpgradeSDKv2StateFixture(ctx, r, fixture)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return state, diags
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := state.Raw.As(&attributes); err != nil {
+		diags.AddError("Verifying upgraded state", err.Error())
+
+		return state, diags
+	}
+
+	attributeNames := tfmaps.Keys(instance.Expected)
+	slices.Sort(attributeNames)
+
+	for _, name := range attributeNames {
+		expected := instance.Expected[name]
+		v, ok := attributes[name]
+
+		if !ok {
+			diags.AddAttributeError(path.Root(name), "Verifying upgraded state", "attribute is not declared in the schema")
+
+			continue
+		}
+
+		got, err := sdkv2StateFixtureValue(v)
+
+		if err != nil {
+			diags.AddAttributeError(path.Root(name), "Verifying upgraded state", err.Error())
+
+			continue
+		}
+
+		if got != expected {
+			diags.AddAttributeError(path.Root(name), "Verifying upgraded state", fmt.Sprintf("expected %q, got %q", expected, got))
+		}
+	}
+
+	return state, diags
+}
+
+// sdkv2StateFixtureValue returns a primitive state value as written in a state fixture's expected attribute values.
+func sdkv2StateFixtureValue(v tftypes.Value) (string, error) {
+	if !v.IsKnown() || v.IsNull() {
+		return "", fmt.Errorf("expected a value, got %s", v)
+	}
+
+	switch typ := v.Type(); {
+	case typ.Is(tftypes.String):
+		var s string
+		if err := v.As(&s); err != nil {
+			return "", err
+		}
+
+		return s, nil
+	case typ.Is(tftypes.Number):
+		var n big.Float
+		if err := v.As(&n); err != nil {
+			return "", err
+		}
+
+		return n.Text('f', -1), nil
+	case typ.Is(tftypes.Bool):
+		var b bool
+		if err := v.As(&b); err != nil {
+			return "", err
+		}
+
+		return strconv.FormatBool(b), nil
+	default:
+		return "", fmt.Errorf("unsupported type %s", typ)
+	}
+}
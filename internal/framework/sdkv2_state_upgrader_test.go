@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type testSDKv2Resource struct {
+	WithNoOpUpdate[testSDKv2ResourceData]
+	WithNoOpDelete
+}
+
+type testSDKv2ResourceData struct {
+	Config   []testSDKv2ResourceConfigData `tfsdk:"config"`
+	Count    types.Int64                   `tfsdk:"count"`
+	Enabled  types.Bool                    `tfsdk:"enabled"`
+	ID       types.String                  `tfsdk:"id"`
+	Rules    []testSDKv2ResourceRuleData   `tfsdk:"rule"`
+	Tags     map[string]string             `tfsdk:"tags"`
+	Timeouts timeouts.Value                `tfsdk:"timeouts"`
+}
+
+type testSDKv2ResourceConfigData struct {
+	Value types.String `tfsdk:"value"`
+}
+
+type testSDKv2ResourceRuleData struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func (r *testSDKv2Resource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_test"
+}
+
+func (r *testSDKv2Resource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"count":   schema.Int64Attribute{Optional: true},
+			"enabled": schema.BoolAttribute{Optional: true},
+			"id":      schema.StringAttribute{Computed: true},
+			"tags":    schema.MapAttribute{ElementType: types.StringType, Optional: true},
+		},
+		Blocks: map[string]schema.Block{
+			// Was a TypeList MaxItems:1 block.
+			"config": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrValue: schema.StringAttribute{Optional: true},
+					},
+				},
+			},
+			"rule": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{Optional: true},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *testSDKv2Resource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+}
+
+func (r *testSDKv2Resource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+}
+
+func (r *testSDKv2Resource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: SDKv2StateUpgrader(),
+	}
+}
+
+func TestUpgradeSDKv2StateFixture(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		fixture       string
+		expected      testSDKv2ResourceData
+		expectedError bool
+	}{
+		"JSON": {
+			fixture: `{
+  "schema_version": 0,
+  "attributes": {
+    "config": [{"value": "x"}],
+    "count": 2,
+    "enabled": true,
+    "id": "example",
+    "obsolete": "removed",
+    "rule": [{"name": "a"}, {"name": "b"}],
+    "tags": {"Name": "example"},
+    "timeouts": {"create": "10m", "delete": null}
+  }
+}`,
+			expected: testSDKv2ResourceData{
+				Config:   []testSDKv2ResourceConfigData{{Value: types.StringValue("x")}},
+				Count:    types.Int64Value(2),
+				Enabled:  types.BoolValue(true),
+				ID:       types.StringValue("example"),
+				Rules:    []testSDKv2ResourceRuleData{{Name: types.StringValue("a")}, {Name: types.StringValue("b")}},
+				Tags:     map[string]string{"Name": "example"},
+				Timeouts: timeoutsValue(ctx, "10m"),
+			},
+		},
+		"JSON null blocks": {
+			fixture: `{
+  "schema_version": 0,
+  "attributes": {
+    "config": null,
+    "id": "example",
+    "timeouts": null
+  }
+}`,
+			expected: testSDKv2ResourceData{
+				Config:   []testSDKv2ResourceConfigData{},
+				Count:    types.Int64Null(),
+				Enabled:  types.BoolNull(),
+				ID:       types.StringValue("example"),
+				Rules:    []testSDKv2ResourceRuleData{},
+				Timeouts: timeoutsNull(ctx),
+			},
+		},
+		"flatmap": {
+			fixture: `{
+  "schema_version": 0,
+  "attributes_flat": {
+    "config.#": "1",
+    "config.0.value": "x",
+    "count": "2",
+    "enabled": "true",
+    "id": "example",
+    "rule.#": "2",
+    "rule.1234.name": "a",
+    "rule.5678.name": "b",
+    "tags.%": "1",
+    "tags.Name": "example"
+  }
+}`,
+			expected: testSDKv2ResourceData{
+				Config:   []testSDKv2ResourceConfigData{{Value: types.StringValue("x")}},
+				Count:    types.Int64Value(2),
+				Enabled:  types.BoolValue(true),
+				ID:       types.StringValue("example"),
+				Rules:    []testSDKv2ResourceRuleData{{Name: types.StringValue("a")}, {Name: types.StringValue("b")}},
+				Tags:     map[string]string{"Name": "example"},
+				Timeouts: timeoutsNull(ctx),
+			},
+		},
+		"unknown schema version": {
+			fixture:       `{"schema_version": 1, "attributes": {"id": "example"}}`,
+			expectedError: true,
+		},
+		"invalid value": {
+			fixture:       `{"schema_version": 0, "attributes": {"count": "two", "id": "example"}}`,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state, diags := UpgradeSDKv2StateFixture(ctx, &testSDKv2Resource{}, []byte(testCase.fixture))
+
+			if testCase.expectedError {
+				if !diags.HasError() {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			var got testSDKv2ResourceData
+			if diags := state.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("reading upgraded state: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestVerifySDKv2StateFixture(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		fixture       string
+		expectedError string
+	}{
+		"JSON": {
+			fixture: `{"schema_version": 0, "attributes": {"count": 2, "enabled": true, "id": "example"}, "expected": {"count": "2", "enabled": "true", "id": "example"}}`,
+		},
+		"flatmap": {
+			fixture: `{"schema_version": 0, "attributes_flat": {"count": "2", "id": "example"}, "expected": {"count": "2", "id": "example"}}`,
+		},
+		"no expected values": {
+			fixture:       `{"schema_version": 0, "attributes": {"id": "example"}}`,
+			expectedError: "fixture has no expected attribute values",
+		},
+		"mismatch": {
+			fixture:       `{"schema_version": 0, "attributes": {"id": "example"}, "expected": {"id": "other"}}`,
+			expectedError: `expected "other", got "example"`,
+		},
+		"null": {
+			fixture:       `{"schema_version": 0, "attributes": {"id": "example"}, "expected": {"count": "2", "id": "example"}}`,
+			expectedError: "expected a value",
+		},
+		"undeclared attribute": {
+			fixture:       `{"schema_version": 0, "attributes": {"id": "example", "obsolete": "removed"}, "expected": {"id": "example", "obsolete": "removed"}}`,
+			expectedError: "attribute is not declared in the schema",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, diags := VerifySDKv2StateFixture(ctx, &testSDKv2Resource{}, []byte(testCase.fixture))
+
+			if testCase.expectedError == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatal("expected error, got none")
+			}
+
+			if got := diags.Errors()[0].Detail(); !strings.Contains(got, testCase.expectedError) {
+				t.Errorf("incorrect error. Expected to contain: %q, got: %q", testCase.expectedError, got)
+			}
+		})
+	}
+}
+
+func TestUnflattenSDKv2State_singleNestedObject(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := schema.Schema{
+		Blocks: map[string]schema.Block{
+			// Was a TypeList MaxItems:1 block.
+			"config": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					names.AttrValue: schema.StringAttribute{Optional: true},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		flatmap  map[string]string
+		expected any
+	}{
+		"present": {
+			flatmap:  map[string]string{"config.#": "1", "config.0.value": "x"},
+			expected: map[string]any{"config": map[string]any{names.AttrValue: "x"}},
+		},
+		"empty": {
+			flatmap:  map[string]string{"config.#": "0"},
+			expected: map[string]any{"config": nil},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := unflattenSDKv2State(s.Type().TerraformType(ctx), testCase.flatmap, "")
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func timeoutsNull(ctx context.Context) timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(timeoutsAttributeTypes(ctx)),
+	}
+}
+
+func timeoutsValue(ctx context.Context, create string) timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectValueMust(timeoutsAttributeTypes(ctx), map[string]attr.Value{
+			"create": types.StringValue(create),
+		}),
+	}
+}
+
+func timeoutsAttributeTypes(context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"create": types.StringType,
+	}
+}
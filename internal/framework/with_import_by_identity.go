@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// WithImportByIdentity is intended to be embedded in resources which import state via their identity attributes.
+// The import ID is either the resource's composite ID or a JSON object keyed by identity attribute name,
+// e.g. `id = jsonencode({ aws_account_id = "123456789012", namespace = "example" })` in an import block.
+// See https://developer.hashicorp.com/terraform/plugin/framework/resources/import.
+type WithImportByIdentity struct {
+	identity identity.Identity
+}
+
+// SetIdentity sets the resource's identity.
+func (w *WithImportByIdentity) SetIdentity(identity identity.Identity) {
+	w.identity = identity
+}
+
+// Identity returns the resource's identity.
+func (w *WithImportByIdentity) Identity() identity.Identity {
+	return w.identity
+}
+
+func (w *WithImportByIdentity) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	values, err := w.identity.Parse(request.ID)
+
+	if err != nil {
+		response.Diagnostics.AddError("Resource Import Invalid ID", err.Error())
+
+		return
+	}
+
+	attributes := w.identity.Attributes()
+	for _, attribute := range attributes {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(attribute), values[attribute])...)
+	}
+
+	// Resources whose "id" attribute is not an identity attribute store the composite ID.
+	if _, ok := response.State.Schema.GetAttributes()[names.AttrID]; ok && !slices.Contains(attributes, names.AttrID) {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), w.identity.IDFromMap(values))...)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestWithImportByIdentity(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{Required: true},
+			"environment_id": schema.StringAttribute{Computed: true},
+			names.AttrID:     schema.StringAttribute{Computed: true},
+		},
+	}
+
+	testCases := map[string]struct {
+		id            string
+		expectedError bool
+	}{
+		"composite": {
+			id: "env:app",
+		},
+		"attribute map": {
+			id: `{"application_id":"app","environment_id":"env"}`,
+		},
+		"invalid": {
+			id:            "env",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var w WithImportByIdentity
+			w.SetIdentity(identity.New(":", "environment_id", "application_id"))
+
+			request := resource.ImportStateRequest{ID: testCase.id}
+			response := resource.ImportStateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+					Schema: s,
+				},
+			}
+
+			w.ImportState(ctx, request, &response)
+
+			if testCase.expectedError {
+				if !response.Diagnostics.HasError() {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			for attribute, expected := range map[string]string{
+				"application_id": "app",
+				"environment_id": "env",
+				names.AttrID:     "env:app",
+			} {
+				var got string
+				response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root(attribute), &got)...)
+				if got != expected {
+					t.Errorf("%s = %q, want %q", attribute, got, expected)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = cidrSubnetsForAZsFunction{}
+
+func NewCIDRSubnetsForAZsFunction() function.Function {
+	return &cidrSubnetsForAZsFunction{}
+}
+
+type cidrSubnetsForAZsFunction struct{}
+
+func (f cidrSubnetsForAZsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets_for_azs"
+}
+
+func (f cidrSubnetsForAZsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_subnets_for_azs Function",
+		MarkdownDescription: "Divides a CIDR block into consecutive subnets, one per Availability Zone. " +
+			"The result maps each Availability Zone to its subnet CIDR block.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block to divide",
+			},
+			function.ListParameter{
+				ElementType:         types.StringType,
+				Name:                "availability_zones",
+				MarkdownDescription: "Availability Zones to allocate subnets to, in order",
+			},
+			function.Int64Parameter{
+				Name:                "newbits",
+				MarkdownDescription: "Number of additional bits with which to extend the prefix of each subnet",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrSubnetsForAZsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var availabilityZones []string
+	var newbits int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &availabilityZones, &newbits))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := subnetsForAZs(cidrBlock, availabilityZones, newbits)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// subnetsForAZs allocates consecutive subnets of cidrBlock extended by newbits,
+// in Availability Zone order
+func subnetsForAZs(cidrBlock string, availabilityZones []string, newbits int64) (map[string]string, error) {
+	prefix, err := netip.ParsePrefix(cidrBlock)
+	if err != nil {
+		return nil, err
+	}
+	prefix = prefix.Masked()
+
+	addrBits := prefix.Addr().BitLen()
+	if maxNewbits := int64(addrBits - prefix.Bits()); newbits < 0 || newbits > maxNewbits {
+		return nil, fmt.Errorf("newbits (%d) must be between 0 and %d for %s", newbits, maxNewbits, prefix)
+	}
+	newLen := prefix.Bits() + int(newbits)
+
+	if capacity := new(big.Int).Lsh(big.NewInt(1), uint(newbits)); big.NewInt(int64(len(availabilityZones))).Cmp(capacity) > 0 {
+		return nil, fmt.Errorf("cannot allocate %d subnets of /%d within %s", len(availabilityZones), newLen, prefix)
+	}
+
+	base := new(big.Int).SetBytes(prefix.Addr().AsSlice())
+	result := make(map[string]string, len(availabilityZones))
+
+	for i, az := range availabilityZones {
+		if _, ok := result[az]; ok {
+			return nil, fmt.Errorf("duplicate Availability Zone (%s)", az)
+		}
+
+		offset := new(big.Int).Lsh(big.NewInt(int64(i)), uint(addrBits-newLen))
+		addr, ok := netip.AddrFromSlice(new(big.Int).Add(base, offset).FillBytes(make([]byte, addrBits/8)))
+		if !ok {
+			return nil, fmt.Errorf("computing subnet %d of %s", i, prefix)
+		}
+
+		result[az] = netip.PrefixFrom(addr, newLen).String()
+	}
+
+	return result, nil
+}
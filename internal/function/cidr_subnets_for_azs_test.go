@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSubnetsForAZsFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsForAZsFunctionConfig("10.0.0.0/16", 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("a", "10.0.0.0/20"),
+					resource.TestCheckOutput("b", "10.0.16.0/20"),
+					resource.TestCheckOutput("c", "10.0.32.0/20"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsForAZsFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsForAZsFunctionConfig("2001:db8::/56", 8),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("a", "2001:db8::/64"),
+					resource.TestCheckOutput("b", "2001:db8:0:1::/64"),
+					resource.TestCheckOutput("c", "2001:db8:0:2::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsForAZsFunction_insufficientSpace(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsForAZsFunctionConfig("10.0.0.0/16", 1),
+				ExpectError: regexache.MustCompile(`cannot[\s\n]*allocate[\s\n]*3[\s\n]*subnets`),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsForAZsFunction_invalidNewbits(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsForAZsFunctionConfig("10.0.0.0/30", 3),
+				ExpectError: regexache.MustCompile(`newbits[\s\n]*\(3\)[\s\n]*must[\s\n]*be[\s\n]*between`),
+			},
+		},
+	})
+}
+
+func testCIDRSubnetsForAZsFunctionConfig(cidrBlock string, newbits int) string {
+	return fmt.Sprintf(`
+locals {
+  subnets = provider::aws::cidr_subnets_for_azs(%[1]q, ["a", "b", "c"], %[2]d)
+}
+
+output "a" {
+  value = local.subnets["a"]
+}
+
+output "b" {
+  value = local.subnets["b"]
+}
+
+output "c" {
+  value = local.subnets["c"]
+}
+`, cidrBlock, newbits)
+}
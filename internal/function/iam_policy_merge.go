@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// policyVersion is the current IAM policy language version
+	policyVersion = "2012-10-17"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Deep merges the statements of one or more IAM policy documents into a single policy document. " +
+			"Equivalent statements are included once. Statements that differ only in their `Action` or `Resource` element " +
+			"are combined. Statements that share a `Sid` but cannot be combined are an error.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				ElementType:         types.StringType,
+				Name:                "policies",
+				MarkdownDescription: "IAM policy documents (JSON) to merge, in order",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policies []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policies))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := mergePolicies(policies)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// policyDocument is the top-level structure of an IAM policy document.
+// Fields are ordered so that Version is first in the encoded JSON.
+type policyDocument struct {
+	Version   string           `json:",omitempty"`
+	ID        string           `json:"Id,omitempty"`
+	Statement []map[string]any `json:",omitempty"`
+}
+
+// mergePolicies deep merges the statements of policies in order.
+// A statement that is equivalent to an earlier statement is dropped. A statement that differs from an
+// earlier statement with the same Sid (or from an earlier statement without a Sid, if neither has one)
+// only in its Action or Resource element is merged into the earlier statement by taking the union of the
+// element's values. Statements that share a Sid but cannot be merged are a conflict.
+func mergePolicies(policies []string) (string, error) {
+	var merged policyDocument
+
+	for i, policy := range policies {
+		if policy == "" {
+			continue
+		}
+
+		doc, err := decodePolicyDocument(policy)
+		if err != nil {
+			return "", fmt.Errorf("policy %d: %w", i, err)
+		}
+
+		if doc.Version != "" {
+			if merged.Version != "" && merged.Version != doc.Version {
+				return "", fmt.Errorf("policy %d: conflicting Version (%s, %s)", i, merged.Version, doc.Version)
+			}
+			merged.Version = doc.Version
+		}
+
+		if doc.ID != "" {
+			if merged.ID != "" && merged.ID != doc.ID {
+				return "", fmt.Errorf("policy %d: conflicting Id (%s, %s)", i, merged.ID, doc.ID)
+			}
+			merged.ID = doc.ID
+		}
+
+		for _, statement := range doc.Statement {
+			if merged.Statement, err = mergeStatement(merged.Statement, statement); err != nil {
+				return "", fmt.Errorf("policy %d: %w", i, err)
+			}
+		}
+	}
+
+	if merged.Version == "" {
+		merged.Version = policyVersion
+	}
+
+	return encodePolicyJSON(merged)
+}
+
+// mergeStatement merges statement into statements.
+func mergeStatement(statements []map[string]any, statement map[string]any) ([]map[string]any, error) {
+	sid, _ := statement["Sid"].(string)
+
+	for _, existing := range statements {
+		if v, _ := existing["Sid"].(string); v != sid {
+			continue
+		}
+
+		equivalent, err := statementsEquivalent(existing, statement)
+		if err != nil {
+			return nil, err
+		}
+
+		if equivalent {
+			return statements, nil
+		}
+
+		for _, k := range []string{"Action", "Resource"} {
+			ok, err := mergeStatementElement(existing, statement, k)
+			if err != nil {
+				return nil, err
+			}
+
+			if ok {
+				return statements, nil
+			}
+		}
+
+		if sid != "" {
+			return nil, fmt.Errorf("conflicting statements with Sid (%s)", sid)
+		}
+	}
+
+	return append(statements, statement), nil
+}
+
+// mergeStatementElement merges the values of element k of statement into existing if the
+// statements are otherwise equivalent. It returns whether the statements were merged.
+func mergeStatementElement(existing, statement map[string]any, k string) (bool, error) {
+	ours, ok := stringSetElement(existing[k])
+	if !ok {
+		return false, nil
+	}
+
+	theirs, ok := stringSetElement(statement[k])
+	if !ok {
+		return false, nil
+	}
+
+	equivalent, err := statementsEquivalent(withoutElement(existing, k), withoutElement(statement, k))
+	if err != nil || !equivalent {
+		return false, err
+	}
+
+	for _, v := range theirs {
+		if !slices.Contains(ours, v) {
+			ours = append(ours, v)
+		}
+	}
+
+	if len(ours) == 1 {
+		existing[k] = ours[0]
+	} else {
+		existing[k] = ours
+	}
+
+	return true, nil
+}
+
+// stringSetElement returns the values of a statement element that is a string or an array of strings.
+func stringSetElement(v any) ([]string, bool) {
+	switch v := v.(type) {
+	case string:
+		return []string{v}, true
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, v := range v {
+			s, ok := v.(string)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, s)
+		}
+		return values, true
+	case []string:
+		return v, true
+	default:
+		return nil, false
+	}
+}
+
+// withoutElement returns a copy of statement without element k.
+func withoutElement(statement map[string]any, k string) map[string]any {
+	statement = maps.Clone(statement)
+	delete(statement, k)
+
+	return statement
+}
+
+// statementsEquivalent returns whether two statements are equivalent.
+func statementsEquivalent(a, b map[string]any) (bool, error) {
+	pa, err := singleStatementPolicy(a)
+	if err != nil {
+		return false, err
+	}
+
+	pb, err := singleStatementPolicy(b)
+	if err != nil {
+		return false, err
+	}
+
+	return verify.PolicyStringsEquivalent(pa, pb), nil
+}
+
+// encodePolicyJSON returns the compact JSON encoding of v.
+// HTML characters such as `&` are not escaped, so condition values and ARNs are unchanged.
+func encodePolicyJSON(v any) (string, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// decodePolicyDocument decodes a JSON IAM policy document, accepting either a
+// single statement object or an array of statements
+func decodePolicyDocument(policy string) (*policyDocument, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, fmt.Errorf("decoding JSON: %w", err)
+	}
+
+	doc := &policyDocument{}
+
+	for k, v := range raw {
+		var err error
+
+		switch k {
+		case "Version":
+			err = json.Unmarshal(v, &doc.Version)
+		case "Id":
+			err = json.Unmarshal(v, &doc.ID)
+		case "Statement":
+			var statements []map[string]any
+			if err = json.Unmarshal(v, &statements); err != nil {
+				var statement map[string]any
+				if err = json.Unmarshal(v, &statement); err == nil {
+					statements = []map[string]any{statement}
+				}
+			}
+			doc.Statement = statements
+		default:
+			err = errors.New("unsupported element")
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+	}
+
+	return doc, nil
+}
+
+// singleStatementPolicy wraps a statement in a policy document so that it can
+// be compared for equivalence
+func singleStatementPolicy(statement map[string]any) (string, error) {
+	return encodePolicyJSON(policyDocument{
+		Version:   policyVersion,
+		Statement: []map[string]any{statement},
+	})
+}
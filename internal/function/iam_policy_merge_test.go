@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyMergeFunction_basic(t *testing.T) {
+	t.Parallel()
+	policy1 := `{"Version":"2012-10-17","Statement":{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`
+	policy2 := `{"Statement":[{"Sid":"Read","Effect":"Allow","Action":["s3:GetObject"],"Resource":"*"},{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`
+	expected := `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*","Sid":"Read"},{"Action":"s3:DeleteObject","Effect":"Deny","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig(policy1, policy2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_mergeStatements(t *testing.T) {
+	t.Parallel()
+	policy1 := `{"Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a&b/*","Condition":{"StringLike":{"s3:prefix":"<home>/"}}},{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"arn:aws:sqs:us-west-2:123456789012:one"}]}` //lintignore:AWSAT003,AWSAT005
+
+	policy2 := `{"Statement":[{"Sid":"Read","Effect":"Allow","Action":["s3:GetObjectVersion","s3:GetObject"],"Resource":"arn:aws:s3:::a&b/*","Condition":{"StringLike":{"s3:prefix":["<home>/"]}}},{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"arn:aws:sqs:us-west-2:123456789012:two"}]}` //lintignore:AWSAT003,AWSAT005
+
+	expected := `{"Version":"2012-10-17","Statement":[{"Action":["s3:GetObject","s3:GetObjectVersion"],"Condition":{"StringLike":{"s3:prefix":"<home>/"}},"Effect":"Allow","Resource":"arn:aws:s3:::a&b/*","Sid":"Read"},{"Action":"sqs:SendMessage","Effect":"Allow","Resource":["arn:aws:sqs:us-west-2:123456789012:one","arn:aws:sqs:us-west-2:123456789012:two"]}]}` //lintignore:AWSAT003,AWSAT005
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig(policy1, policy2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_conflictingSid(t *testing.T) {
+	t.Parallel()
+	policy1 := `{"Statement":{"Sid":"Write","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}}`
+	policy2 := `{"Statement":{"Sid":"Write","Effect":"Deny","Action":"s3:PutObject","Resource":"*"}}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig(policy1, policy2),
+				ExpectError: regexache.MustCompile(`conflicting[\s\n]*statements[\s\n]*with[\s\n]*Sid[\s\n]*\(Write\)`),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_invalidJSON(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig(`{"Version":"2012-10-17"}`, "invalid"),
+				ExpectError: regexache.MustCompile(`policy[\s\n]*1:[\s\n]*decoding[\s\n]*JSON`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyMergeFunctionConfig(policy1, policy2 string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_merge([%[1]q, %[2]q])
+}
+`, policy1, policy2)
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var s3URIParseResultAttrTypes = map[string]attr.Type{
+	"bucket": types.StringType,
+	"key":    types.StringType,
+	"region": types.StringType,
+}
+
+var _ function.Function = s3URIParseFunction{}
+
+func NewS3URIParseFunction() function.Function {
+	return &s3URIParseFunction{}
+}
+
+type s3URIParseFunction struct{}
+
+func (f s3URIParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_uri_parse"
+}
+
+func (f s3URIParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "s3_uri_parse Function",
+		MarkdownDescription: "Parses an S3 URI (`s3://bucket/key`) or S3 object URL (virtual-hosted or path-style) " +
+			"into its bucket, key and, where present, Region",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: "S3 URI or object URL to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: s3URIParseResultAttrTypes,
+		},
+	}
+}
+
+func (f s3URIParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	parts, err := parseS3URI(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"bucket": types.StringValue(parts.bucket),
+		"key":    types.StringValue(parts.key),
+		"region": types.StringValue(parts.region),
+	}
+
+	result, d := types.ObjectValue(s3URIParseResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type s3URI struct {
+	bucket string
+	key    string
+	region string
+}
+
+// parseS3URI parses an s3:// URI or an https:// S3 object URL
+func parseS3URI(s string) (s3URI, error) {
+	var result s3URI
+
+	if rest, ok := strings.CutPrefix(s, "s3://"); ok {
+		// Keys in s3:// URIs are not URL-encoded.
+		result.bucket, result.key, _ = strings.Cut(rest, "/")
+		if result.bucket == "" {
+			return result, errors.New("s3 uri: missing bucket")
+		}
+
+		return result, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return result, err
+	}
+
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return result, errors.New(`s3 uri: scheme must be "s3", "https" or "http"`)
+	}
+
+	host := u.Hostname()
+	for _, suffix := range []string{".amazonaws.com", ".amazonaws.com.cn"} {
+		if v, ok := strings.CutSuffix(host, suffix); ok {
+			host = v
+			break
+		}
+	}
+	if host == u.Hostname() {
+		return result, errors.New("s3 uri: not an Amazon S3 endpoint")
+	}
+
+	path := strings.TrimPrefix(u.Path, "/")
+
+	// Path-style: s3[.-]region.amazonaws.com/bucket/key.
+	if endpoint, ok := s3Endpoint(host); ok {
+		result.region = endpoint
+		result.bucket, result.key, _ = strings.Cut(path, "/")
+	} else {
+		// Virtual-hosted-style: bucket.s3[.-]region.amazonaws.com/key.
+		i := strings.LastIndex(host, ".s3")
+		if i < 0 {
+			return result, errors.New("s3 uri: not an Amazon S3 endpoint")
+		}
+		endpoint, ok := s3Endpoint(host[i+1:])
+		if !ok {
+			return result, errors.New("s3 uri: not an Amazon S3 endpoint")
+		}
+		result.region = endpoint
+		result.bucket, result.key = host[:i], path
+	}
+
+	if result.bucket == "" {
+		return result, errors.New("s3 uri: missing bucket")
+	}
+
+	return result, nil
+}
+
+// s3Endpoint reports whether host (without the DNS suffix) is an S3 endpoint
+// and returns the endpoint's Region, if any
+func s3Endpoint(host string) (string, bool) {
+	switch {
+	case host == "s3":
+		return "", true
+	case strings.HasPrefix(host, "s3-"):
+		return strings.TrimPrefix(host, "s3-"), true
+	case strings.HasPrefix(host, "s3."):
+		region := strings.TrimPrefix(host, "s3.")
+		region = strings.TrimPrefix(region, "dualstack.")
+		if strings.Contains(region, ".") {
+			return "", false
+		}
+		return region, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+var tagsMergeIgnoreTagsAttrTypes = map[string]attr.Type{
+	"keys":         types.SetType{ElemType: types.StringType},
+	"key_prefixes": types.SetType{ElemType: types.StringType},
+}
+
+var _ function.Function = tagsMergeFunction{}
+
+func NewTagsMergeFunction() function.Function {
+	return &tagsMergeFunction{}
+}
+
+type tagsMergeFunction struct{}
+
+func (f tagsMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_merge"
+}
+
+func (f tagsMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_merge Function",
+		MarkdownDescription: "Merges tag maps, with later values taking precedence. Tags matching the `ignore_tags` " +
+			"configuration and AWS system tags (`aws:` prefix) are removed, as the provider does when reading resource tags.",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				AllowNullValue:      true,
+				AttributeTypes:      tagsMergeIgnoreTagsAttrTypes,
+				Name:                "ignore_tags",
+				MarkdownDescription: "Tag keys and key prefixes to ignore, with the same structure as the provider `ignore_tags` configuration block",
+			},
+		},
+		VariadicParameter: function.MapParameter{
+			ElementType:         types.StringType,
+			Name:                "tags",
+			MarkdownDescription: "Tag maps to merge, in order of increasing precedence",
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f tagsMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ignoreTags types.Object
+	var tagMaps []map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &ignoreTags, &tagMaps))
+	if resp.Error != nil {
+		return
+	}
+
+	var ignoreConfig *tftags.IgnoreConfig
+	if !ignoreTags.IsNull() {
+		var data tagsMergeIgnoreTagsModel
+		if d := ignoreTags.As(ctx, &data, basetypes.ObjectAsOptions{}); d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+
+		ignoreConfig = &tftags.IgnoreConfig{
+			Keys:        tftags.New(ctx, data.Keys),
+			KeyPrefixes: tftags.New(ctx, data.KeyPrefixes),
+		}
+	}
+
+	tags := tftags.New(ctx, nil)
+	for _, m := range tagMaps {
+		tags = tags.Merge(tftags.New(ctx, m))
+	}
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreConfig)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, tags.Map()))
+}
+
+type tagsMergeIgnoreTagsModel struct {
+	Keys        []string `tfsdk:"keys"`
+	KeyPrefixes []string `tfsdk:"key_prefixes"`
+}
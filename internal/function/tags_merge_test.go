@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestTagsMergeFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsMergeFunctionConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Environment":"production","Owner":"team-b","Project":"example"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestTagsMergeFunction_ignoreTags(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsMergeFunctionConfig_ignoreTags,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Project":"example"}`),
+				),
+			},
+		},
+	})
+}
+
+const testTagsMergeFunctionConfig_basic = `
+output "test" {
+  value = jsonencode(provider::aws::tags_merge(
+    null,
+    { Environment = "staging", Owner = "team-a" },
+    { Environment = "production", Owner = "team-b", Project = "example" },
+    { "aws:cloudformation:stack-name" = "example" },
+  ))
+}
+`
+
+const testTagsMergeFunctionConfig_ignoreTags = `
+output "test" {
+  value = jsonencode(provider::aws::tags_merge(
+    {
+      keys         = ["Owner"]
+      key_prefixes = ["kubernetes.io/"]
+    },
+    { Owner = "team-a", Project = "example" },
+    { "kubernetes.io/cluster/example" = "owned" },
+  ))
+}
+`
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = userIDFromARNFunction{}
+
+func NewUserIDFromARNFunction() function.Function {
+	return &userIDFromARNFunction{}
+}
+
+type userIDFromARNFunction struct{}
+
+func (f userIDFromARNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "user_id_from_arn"
+}
+
+func (f userIDFromARNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "user_id_from_arn Function",
+		MarkdownDescription: "Returns the name identifying an IAM or STS principal from its Amazon Resource Name (ARN). " +
+			"This is the user or role name without a path, the session name of an assumed role, the name of a " +
+			"federated user, or the account ID of the root user.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "IAM or STS principal Amazon Resource Name (ARN)",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f userIDFromARNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := userIDFromARN(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// userIDFromARN returns the principal name from an IAM or STS principal ARN
+func userIDFromARN(s string) (string, error) {
+	parts, err := arn.Parse(s)
+	if err != nil {
+		return "", err
+	}
+
+	resourceType, resource, _ := strings.Cut(parts.Resource, "/")
+
+	switch {
+	case parts.Service == "iam" && resourceType == "root" && resource == "":
+		return parts.AccountID, nil
+	case parts.Service == "iam" && (resourceType == "user" || resourceType == "role"):
+		// Strip any path.
+		resource = resource[strings.LastIndex(resource, "/")+1:]
+	case parts.Service == "sts" && resourceType == "assumed-role":
+		// assumed-role/role-name/role-session-name.
+		_, resource, _ = strings.Cut(resource, "/")
+	case parts.Service == "sts" && resourceType == "federated-user":
+	default:
+		return "", fmt.Errorf("unsupported principal ARN resource (%s:%s)", parts.Service, parts.Resource)
+	}
+
+	if resource == "" {
+		return "", fmt.Errorf("missing principal name in ARN resource (%s)", parts.Resource)
+	}
+
+	return resource, nil
+}
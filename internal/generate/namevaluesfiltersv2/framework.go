@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package namevaluesfiltersv2
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// FilterModel represents a single filter configured in a Block.
+type FilterModel struct {
+	Name   types.String                     `tfsdk:"name"`
+	Values fwtypes.SetValueOf[types.String] `tfsdk:"values"`
+}
+
+// Block is the Plugin Framework variant of Schema.
+// If any filter names are specified, only those names can be configured.
+func Block(ctx context.Context, filterNames ...string) schema.Block {
+	var validators []validator.String
+	if len(filterNames) > 0 {
+		validators = append(validators, stringvalidator.OneOf(filterNames...))
+	}
+
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[FilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Required:   true,
+					Validators: validators,
+				},
+				"values": schema.SetAttribute{
+					CustomType:  fwtypes.SetOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// Combinations returns every combination of a single value for each filter name.
+// AWS APIs that take a single value per server-side filter are called once per combination.
+// If there are no filters a single empty combination is returned.
+func (filters NameValuesFilters) Combinations() []map[string]string {
+	m := filters.Map()
+	filterNames := make([]string, 0, len(m))
+	for name := range m {
+		filterNames = append(filterNames, name)
+	}
+	slices.Sort(filterNames)
+
+	combinations := []map[string]string{{}}
+	for _, name := range filterNames {
+		var next []map[string]string
+		for _, combination := range combinations {
+			for _, v := range m[name] {
+				c := make(map[string]string, len(combination)+1)
+				for k, v := range combination {
+					c[k] = v
+				}
+				c[name] = v
+				next = append(next, c)
+			}
+		}
+		combinations = next
+	}
+
+	return combinations
+}
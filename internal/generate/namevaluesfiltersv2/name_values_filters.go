@@ -4,6 +4,7 @@
 package namevaluesfiltersv2
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,8 +14,8 @@ import (
 // across all these Go types, we convert them into this Go type.
 type NameValuesFilters map[string][]string
 
-// Add adds missing and updates existing filters from common Terraform Provider SDK types.
-// Supports map[string]string, map[string][]string, *schema.Set.
+// Add adds missing and updates existing filters from common Terraform Provider SDK and Plugin Framework types.
+// Supports map[string]string, map[string][]string, *schema.Set, []*FilterModel.
 func (filters NameValuesFilters) Add(i interface{}) NameValuesFilters {
 	switch value := i.(type) {
 	case map[string]string:
@@ -42,6 +43,18 @@ func (filters NameValuesFilters) Add(i interface{}) NameValuesFilters {
 			}
 		}
 
+	case []*FilterModel:
+		// The set of filters described by Block().
+		for _, filter := range value {
+			name := filter.Name.ValueString()
+
+			for _, v := range filter.Values.Elements() {
+				if v, ok := v.(types.String); ok {
+					filters[name] = append(filters[name], v.ValueString())
+				}
+			}
+		}
+
 	case *schema.Set:
 		// The set of filters described by Schema().
 		for _, filter := range value.List() {
@@ -95,8 +108,8 @@ func (filters NameValuesFilters) Map() map[string][]string {
 	return result
 }
 
-// New creates NameValuesFilters from common Terraform Provider SDK types.
-// Supports map[string]string, map[string][]string, *schema.Set.
+// New creates NameValuesFilters from common Terraform Provider SDK and Plugin Framework types.
+// Supports map[string]string, map[string][]string, *schema.Set, []*FilterModel.
 func New(i interface{}) NameValuesFilters {
 	return make(NameValuesFilters).Add(i)
 }
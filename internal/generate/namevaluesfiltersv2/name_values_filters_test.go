@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfilters"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfiltersv2"
 )
 
 func TestNameValuesFiltersMap(t *testing.T) {
@@ -182,3 +183,54 @@ func testNameValuesFiltersHashSet(v interface{}) int {
 	m := v.(map[string]interface{})
 	return create.StringHashcode(m["name"].(string))
 }
+
+func TestNameValuesFiltersCombinations(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		filters namevaluesfiltersv2.NameValuesFilters
+		want    []map[string]string
+	}{
+		{
+			name:    "empty",
+			filters: namevaluesfiltersv2.New(map[string][]string{}),
+			want:    []map[string]string{{}},
+		},
+		{
+			name: "single",
+			filters: namevaluesfiltersv2.New(map[string][]string{
+				"name1": {"value1", "", "value1"},
+			}),
+			want: []map[string]string{
+				{"name1": "value1"},
+			},
+		},
+		{
+			name: "multiple",
+			filters: namevaluesfiltersv2.New(map[string][]string{
+				"name1": {"value1a", "value1b"},
+				"name2": {"value2a", "value2b"},
+			}),
+			want: []map[string]string{
+				{"name1": "value1a", "name2": "value2a"},
+				{"name1": "value1a", "name2": "value2b"},
+				{"name1": "value1b", "name2": "value2a"},
+				{"name1": "value1b", "name2": "value2b"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.filters.Combinations()
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
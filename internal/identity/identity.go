@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// Identity describes the attributes that uniquely identify a resource and
+// how their values are combined into the resource's composite ID.
+type Identity struct {
+	attributes []string
+	separator  string
+
+	// separatorInFinalValue allows the final attribute's value to contain the separator.
+	separatorInFinalValue bool
+}
+
+// New returns an Identity for the specified attributes, in the order in which they appear in the composite ID.
+func New(separator string, attributes ...string) Identity {
+	return Identity{
+		attributes: attributes,
+		separator:  separator,
+	}
+}
+
+// WithSeparatorInFinalValue returns a copy of the Identity whose final attribute's value may contain the separator,
+// for resources whose composite IDs have always been parsed that way.
+func (i Identity) WithSeparatorInFinalValue() Identity {
+	i.separatorInFinalValue = true
+	return i
+}
+
+// Attributes returns the identity attributes, in composite ID order.
+func (i Identity) Attributes() []string {
+	return slices.Clone(i.attributes)
+}
+
+// Separator returns the composite ID separator.
+func (i Identity) Separator() string {
+	return i.separator
+}
+
+// ID returns the composite ID for the specified attribute values, in composite ID order.
+func (i Identity) ID(values ...string) string {
+	return strings.Join(values, i.separator)
+}
+
+// IDFromMap returns the composite ID for attribute values keyed by attribute name.
+func (i Identity) IDFromMap(values map[string]string) string {
+	parts := make([]string, len(i.attributes))
+	for j, attribute := range i.attributes {
+		parts[j] = values[attribute]
+	}
+
+	return i.ID(parts...)
+}
+
+// Split splits a composite ID into attribute values, in composite ID order.
+// Values must not be empty or contain the separator, following flex.ExpandResourceId,
+// unless the Identity was returned by WithSeparatorInFinalValue.
+func (i Identity) Split(id string) ([]string, error) {
+	var parts []string
+	var ok bool
+
+	if i.separatorInFinalValue {
+		parts = strings.SplitN(id, i.separator, len(i.attributes))
+		ok = len(parts) == len(i.attributes) && !slices.Contains(parts, "")
+	} else {
+		var err error
+		parts, err = flex.ExpandResourceIdWithSeparator(id, i.separator, len(i.attributes), false)
+		ok = err == nil
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected format for ID (%s), expected %s", id, i.format())
+	}
+
+	return parts, nil
+}
+
+// Parse parses an import ID into attribute values keyed by attribute name.
+// The import ID is either a composite ID or a JSON object whose keys are the identity attributes,
+// e.g. `{"aws_account_id":"123456789012","namespace":"default"}`.
+// In an import block the latter can be written as `id = jsonencode({...})`.
+func (i Identity) Parse(id string) (map[string]string, error) {
+	if !strings.HasPrefix(strings.TrimSpace(id), "{") {
+		parts, err := i.Split(id)
+		if err != nil {
+			return nil, err
+		}
+
+		values := make(map[string]string, len(parts))
+		for j, attribute := range i.attributes {
+			values[attribute] = parts[j]
+		}
+
+		return values, nil
+	}
+
+	var values map[string]string
+	if err := json.Unmarshal([]byte(id), &values); err != nil {
+		return nil, fmt.Errorf("unexpected format for ID (%s), expected a JSON object with string values for %s: %w", id, i.names(), err)
+	}
+
+	for key := range values {
+		if !slices.Contains(i.attributes, key) {
+			return nil, fmt.Errorf("unexpected identity attribute %q in ID (%s), expected %s", key, id, i.names())
+		}
+	}
+
+	for j, attribute := range i.attributes {
+		if values[attribute] == "" {
+			return nil, fmt.Errorf("missing identity attribute %q in ID (%s), expected %s", attribute, id, i.names())
+		}
+
+		if final := j == len(i.attributes)-1; !(final && i.separatorInFinalValue) && strings.Contains(values[attribute], i.separator) {
+			return nil, fmt.Errorf("identity attribute %q in ID (%s) must not contain %q", attribute, id, i.separator)
+		}
+	}
+
+	return values, nil
+}
+
+// format returns a description of the composite ID format, e.g. `aws_account_id,namespace`.
+func (i Identity) format() string {
+	return strings.Join(i.attributes, i.separator)
+}
+
+// names returns a description of the identity attribute names, e.g. `"aws_account_id", "namespace"`.
+func (i Identity) names() string {
+	quoted := make([]string, len(i.attributes))
+	for j, attribute := range i.attributes {
+		quoted[j] = fmt.Sprintf("%q", attribute)
+	}
+
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIdentitySplit(t *testing.T) {
+	t.Parallel()
+
+	identity := New(",", "aws_account_id", "template_id", "alias_name")
+
+	testCases := map[string]struct {
+		id            string
+		expected      []string
+		expectedError string
+	}{
+		"valid": {
+			id:       "123456789012,template,alias",
+			expected: []string{"123456789012", "template", "alias"},
+		},
+		"separator in final value": {
+			id:            "123456789012,template,alias,with,commas",
+			expectedError: "unexpected format for ID (123456789012,template,alias,with,commas), expected aws_account_id,template_id,alias_name",
+		},
+		"too few parts": {
+			id:            "123456789012,template",
+			expectedError: "unexpected format for ID (123456789012,template), expected aws_account_id,template_id,alias_name",
+		},
+		"empty part": {
+			id:            "123456789012,,alias",
+			expectedError: "unexpected format for ID (123456789012,,alias), expected aws_account_id,template_id,alias_name",
+		},
+		"empty": {
+			id:            "",
+			expectedError: "unexpected format for ID (), expected aws_account_id,template_id,alias_name",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := identity.Split(testCase.id)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				if got, expected := err.Error(), testCase.expectedError; got != expected {
+					t.Errorf("incorrect error. Expected: %q, got: %q", expected, got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if got, expected := identity.ID(got...), testCase.id; got != expected {
+				t.Errorf("ID() = %q, want %q", got, expected)
+			}
+		})
+	}
+}
+
+func TestIdentitySplit_separatorInFinalValue(t *testing.T) {
+	t.Parallel()
+
+	identity := New(",", "aws_account_id", "template_id", "alias_name").WithSeparatorInFinalValue()
+
+	got, err := identity.Split("123456789012,template,alias,with,commas")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got, []string{"123456789012", "template", "alias,with,commas"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if _, err := identity.Split("123456789012,,alias"); err == nil {
+		t.Error("expected error for empty part, got none")
+	}
+}
+
+func TestIdentityParse(t *testing.T) {
+	t.Parallel()
+
+	identity := New(":", "environment_id", "application_id")
+
+	testCases := map[string]struct {
+		id            string
+		expected      map[string]string
+		expectedError string
+	}{
+		"composite": {
+			id:       "env:app",
+			expected: map[string]string{"application_id": "app", "environment_id": "env"},
+		},
+		"attribute map": {
+			id:       `{"application_id":"app","environment_id":"env"}`,
+			expected: map[string]string{"application_id": "app", "environment_id": "env"},
+		},
+		"attribute map with whitespace": {
+			id:       ` { "environment_id": "env", "application_id": "app" }`,
+			expected: map[string]string{"application_id": "app", "environment_id": "env"},
+		},
+		"invalid composite": {
+			id:            "env",
+			expectedError: "unexpected format for ID (env), expected environment_id:application_id",
+		},
+		"invalid JSON": {
+			id:            `{"environment_id":1}`,
+			expectedError: `unexpected format for ID ({"environment_id":1}), expected a JSON object with string values for "environment_id", "application_id"`,
+		},
+		"unexpected attribute": {
+			id:            `{"application_id":"app","environment_id":"env","name":"x"}`,
+			expectedError: `unexpected identity attribute "name" in ID ({"application_id":"app","environment_id":"env","name":"x"}), expected "environment_id", "application_id"`,
+		},
+		"missing attribute": {
+			id:            `{"environment_id":"env"}`,
+			expectedError: `missing identity attribute "application_id" in ID ({"environment_id":"env"}), expected "environment_id", "application_id"`,
+		},
+		"attribute containing separator": {
+			id:            `{"application_id":"app:extra","environment_id":"env"}`,
+			expectedError: `identity attribute "application_id" in ID ({"application_id":"app:extra","environment_id":"env"}) must not contain ":"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := identity.Parse(testCase.id)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				if got, expected := err.Error(), testCase.expectedError; !strings.HasPrefix(got, expected) {
+					t.Errorf("incorrect error. Expected prefix: %q, got: %q", expected, got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if got, expected := identity.IDFromMap(got), "env:app"; got != expected {
+				t.Errorf("IDFromMap() = %q, want %q", got, expected)
+			}
+		})
+	}
+}
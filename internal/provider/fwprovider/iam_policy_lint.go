@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+// iamPolicyAttributeNames returns the names of all top-level attributes in a resource schema that hold IAM policy documents.
+func iamPolicyAttributeNames(attributes map[string]schema.Attribute) []string {
+	var attrNames []string
+
+	for k, v := range attributes {
+		if v.GetType().Equal(fwtypes.IAMPolicyType) {
+			attrNames = append(attrNames, k)
+		}
+	}
+
+	slices.Sort(attrNames)
+
+	return attrNames
+}
+
+// iamPolicyLintPlanModifier statically analyzes a resource's new or changed IAM policy documents
+// and reports any findings as warnings if policy linting is enabled.
+type iamPolicyLintPlanModifier struct {
+	typeName  string
+	attrNames []string
+}
+
+func (m iamPolicyLintPlanModifier) modifyPlan(ctx context.Context, meta *conns.AWSClient, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if meta == nil || !meta.LintIAMPolicies {
+		return
+	}
+
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() || response.Plan.Raw.IsNull() {
+		return
+	}
+
+	for _, attrName := range m.attrNames {
+		var planned fwtypes.IAMPolicy
+		if diags := response.Plan.GetAttribute(ctx, path.Root(attrName), &planned); diags.HasError() {
+			continue
+		}
+
+		if planned.IsNull() || planned.IsUnknown() {
+			continue
+		}
+
+		if !request.State.Raw.IsNull() {
+			var current fwtypes.IAMPolicy
+			if diags := request.State.GetAttribute(ctx, path.Root(attrName), &current); !diags.HasError() && current.Equal(planned) {
+				continue
+			}
+		}
+
+		findings, err := tfiam.LintPolicy(planned.ValueString(), tfiam.PolicyLintTypeForAttribute(m.typeName, attrName))
+
+		if err != nil {
+			tflog.Debug(ctx, "Skipping IAM policy lint", map[string]any{
+				"tf_aws.iam_policy_lint.attribute": attrName,
+				"error":                            err.Error(),
+			})
+			continue
+		}
+
+		for _, v := range findings {
+			response.Diagnostics.AddAttributeWarning(path.Root(attrName), "IAM policy lint finding", v.String())
+		}
+	}
+}
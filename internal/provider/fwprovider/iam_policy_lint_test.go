@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestIAMPolicyLintPlanModifier(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+			"policy": schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Required:   true,
+			},
+		},
+	}
+
+	attrNames := iamPolicyAttributeNames(s.Attributes)
+
+	if diff := cmp.Diff(attrNames, []string{"policy"}); diff != "" {
+		t.Fatalf("unexpected attribute names diff (+wanted, -got): %s", diff)
+	}
+
+	objectType := s.Type().TerraformType(ctx)
+	value := func(policy tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name":   tftypes.NewValue(tftypes.String, nil),
+			"policy": policy,
+		})
+	}
+	wildcard := tftypes.NewValue(tftypes.String, `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "*", "Resource": "*"}]}`)
+	clean := tftypes.NewValue(tftypes.String, `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`)
+
+	testCases := map[string]struct {
+		meta             *conns.AWSClient
+		state            tftypes.Value
+		plan             tftypes.Value
+		expectedWarnings int
+	}{
+		"disabled": {
+			meta:  &conns.AWSClient{},
+			state: tftypes.NewValue(objectType, nil),
+			plan:  value(wildcard),
+		},
+		"create": {
+			meta:             &conns.AWSClient{LintIAMPolicies: true},
+			state:            tftypes.NewValue(objectType, nil),
+			plan:             value(wildcard),
+			expectedWarnings: 1,
+		},
+		"create clean": {
+			meta:  &conns.AWSClient{LintIAMPolicies: true},
+			state: tftypes.NewValue(objectType, nil),
+			plan:  value(clean),
+		},
+		"update": {
+			meta:             &conns.AWSClient{LintIAMPolicies: true},
+			state:            value(clean),
+			plan:             value(wildcard),
+			expectedWarnings: 1,
+		},
+		"unchanged": {
+			meta:  &conns.AWSClient{LintIAMPolicies: true},
+			state: value(wildcard),
+			plan:  value(wildcard),
+		},
+		"unknown": {
+			meta:  &conns.AWSClient{LintIAMPolicies: true},
+			state: tftypes.NewValue(objectType, nil),
+			plan:  value(tftypes.NewValue(tftypes.String, tftypes.UnknownValue)),
+		},
+		"destroy": {
+			meta:  &conns.AWSClient{LintIAMPolicies: true},
+			state: value(wildcard),
+			plan:  tftypes.NewValue(objectType, nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: s, Raw: testCase.state},
+				Plan:  tfsdk.Plan{Schema: s, Raw: testCase.plan},
+			}
+			response := resource.ModifyPlanResponse{
+				Plan: tfsdk.Plan{Schema: s, Raw: testCase.plan},
+			}
+
+			iamPolicyLintPlanModifier{typeName: "aws_test", attrNames: attrNames}.modifyPlan(ctx, testCase.meta, request, &response)
+
+			if got := response.Diagnostics.ErrorsCount(); got != 0 {
+				t.Errorf("unexpected errors: %v", response.Diagnostics)
+			}
+			if got, want := response.Diagnostics.WarningsCount(), testCase.expectedWarnings; got != want {
+				t.Errorf("warnings: got %d, want %d: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
@@ -8,9 +8,12 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -107,7 +110,7 @@ const (
 	Before  when = 1 << iota // Interceptor is invoked before call to method in schema
 	After                    // Interceptor is invoked after successful call to method in schema
 	OnError                  // Interceptor is invoked after unsuccessful call to method in schema
-	Finally                  // Interceptor is invoked after After or OnError
+	Finally                  // Interceptor is invoked after After or OnError, or after a later Before interceptor errors
 )
 
 // TODO Share the intercepted handler logic between data sources and resources..
@@ -120,11 +123,17 @@ func interceptedDataSourceReadHandler(interceptors []dataSourceInterceptorReadFu
 		forward := interceptors
 
 		when := Before
-		for _, v := range forward {
+		for i, v := range forward {
 			ctx, diags = v(ctx, request, response, meta, when, diags)
 
 			// Short circuit if any Before interceptor errors.
+			// Interceptors whose Before interceptor has run are run with Finally, last to first.
 			if diags.HasError() {
+				when = Finally
+				for _, v := range slices.Reverse(forward[:i]) {
+					_, diags = v(ctx, request, response, meta, when, diags)
+				}
+
 				return diags
 			}
 		}
@@ -159,11 +168,17 @@ func interceptedResourceHandler[Request resourceCRUDRequest, Response resourceCR
 		forward := interceptors
 
 		when := Before
-		for _, v := range forward {
+		for i, v := range forward {
 			ctx, diags = v(ctx, request, response, meta, when, diags)
 
 			// Short circuit if any Before interceptor errors.
+			// Interceptors whose Before interceptor has run are run with Finally, last to first.
 			if diags.HasError() {
+				when = Finally
+				for _, v := range slices.Reverse(forward[:i]) {
+					_, diags = v(ctx, request, response, meta, when, diags)
+				}
+
 				return diags
 			}
 		}
@@ -200,13 +215,16 @@ type wrappedDataSource struct {
 	inner            datasource.DataSourceWithConfigure
 	interceptors     dataSourceInterceptors
 	meta             *conns.AWSClient
+	// regionOverride is whether the per-resource `region` attribute is injected into the schema.
+	regionOverride bool
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors, regionOverride bool) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		regionOverride:   regionOverride,
 	}
 }
 
@@ -218,10 +236,21 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regionOverride {
+		if response.Schema.Attributes == nil {
+			response.Schema.Attributes = make(map[string]datasourceschema.Attribute)
+		}
+		response.Schema.Attributes[names.AttrRegion] = dataSourceRegionAttribute()
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
+		if w.regionOverride {
+			return w.readWithoutRegion(ctx, request, response)
+		}
+
 		w.inner.Read(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -255,13 +284,22 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	// regionOverride is whether the per-resource `region` attribute is injected into the schema.
+	regionOverride bool
+	// tagPolicy is non-nil for resources that have opted in to transparent tagging.
+	tagPolicy *tagPolicyPlanModifier
+	// iamPolicyLint is non-nil for resources with IAM policy document attributes.
+	iamPolicyLint *iamPolicyLintPlanModifier
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, regionOverride bool, tagPolicy *tagPolicyPlanModifier, iamPolicyLint *iamPolicyLintPlanModifier) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		regionOverride:   regionOverride,
+		tagPolicy:        tagPolicy,
+		iamPolicyLint:    iamPolicyLint,
 	}
 }
 
@@ -273,10 +311,21 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regionOverride {
+		if response.Schema.Attributes == nil {
+			response.Schema.Attributes = make(map[string]resourceschema.Attribute)
+		}
+		response.Schema.Attributes[names.AttrRegion] = resourceRegionAttribute(func() *conns.AWSClient { return w.meta })
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
+		if w.regionOverride {
+			return w.createWithoutRegion(ctx, request, response)
+		}
+
 		w.inner.Create(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -287,6 +336,10 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
+		if w.regionOverride {
+			return w.readWithoutRegion(ctx, request, response)
+		}
+
 		w.inner.Read(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -297,6 +350,10 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
+		if w.regionOverride {
+			return w.updateWithoutRegion(ctx, request, response)
+		}
+
 		w.inner.Update(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -307,6 +364,10 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
+		if w.regionOverride {
+			return w.deleteWithoutRegion(ctx, request, response)
+		}
+
 		w.inner.Delete(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -326,7 +387,11 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ImportState(ctx, request, response)
+		if w.regionOverride {
+			w.importStateWithoutRegion(ctx, v, request, response)
+		} else {
+			v.ImportState(ctx, request, response)
+		}
 
 		return
 	}
@@ -338,9 +403,23 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ModifyPlan(ctx, request, response)
+		if w.regionOverride {
+			w.modifyPlanWithoutRegion(ctx, v, request, response)
+		} else {
+			v.ModifyPlan(ctx, request, response)
+		}
+	}
+
+	// The tag policy is evaluated whether or not the resource modifies its plan.
+	if w.tagPolicy != nil && !response.Diagnostics.HasError() {
+		w.tagPolicy.modifyPlan(ctx, w.meta, request, response)
+	}
+
+	if w.iamPolicyLint != nil && !response.Diagnostics.HasError() {
+		w.iamPolicyLint.modifyPlan(ctx, w.meta, request, response)
 	}
 }
 
@@ -356,13 +435,20 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ValidateConfig(ctx, request, response)
+		if w.regionOverride {
+			w.validateConfigWithoutRegion(ctx, v, request, response)
+		} else {
+			v.ValidateConfig(ctx, request, response)
+		}
 	}
 }
 
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.regionOverride {
+			return w.stateUpgradersWithoutRegion(ctx, v.UpgradeState(ctx))
+		}
 
 		return v.UpgradeState(ctx)
 	}
@@ -380,6 +466,75 @@ func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 	return nil
 }
 
+// wrappedEphemeralResource represents a dispatcher for a Plugin Framework ephemeral resource.
+// Ephemeral resources are never persisted so no interceptors (e.g. transparent tagging) are run.
+type wrappedEphemeralResource struct {
+	// bootstrapContext is run on all wrapped methods.
+	bootstrapContext contextFunc
+	inner            ephemeral.EphemeralResourceWithConfigure
+	meta             *conns.AWSClient
+}
+
+func newWrappedEphemeralResource(bootstrapContext contextFunc, inner ephemeral.EphemeralResourceWithConfigure) ephemeral.EphemeralResourceWithConfigure {
+	return &wrappedEphemeralResource{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+	}
+}
+
+func (w *wrappedEphemeralResource) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Schema(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Open(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithRenew); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.Renew(ctx, request, response)
+	}
+}
+
+func (w *wrappedEphemeralResource) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithClose); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.Close(ctx, request, response)
+	}
+}
+
+func (w *wrappedEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithConfigValidators); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		return v.ConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithValidateConfig); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.ValidateConfig(ctx, request, response)
+	}
+}
+
 // tagsResourceInterceptor implements transparent tagging for resources.
 type tagsResourceInterceptor struct {
 	tags *types.ServicePackageResourceTags
@@ -653,3 +808,63 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+// deletionProtectionResourceInterceptor prevents the deletion of resources protected by the provider's deletion protection rules.
+type deletionProtectionResourceInterceptor struct {
+	typeName string
+}
+
+func (r deletionProtectionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r deletionProtectionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r deletionProtectionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r deletionProtectionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when != Before || meta == nil || meta.DeletionProtectionConfig == nil {
+		return ctx, diags
+	}
+
+	// Use `tags_all` (or, if not present, `tags`) from state.
+	var tags map[string]string
+	for _, name := range []string{names.AttrTagsAll, names.AttrTags} {
+		if _, ok := request.State.Schema.GetAttributes()[name]; !ok {
+			continue
+		}
+
+		var v fwtypes.Map
+		if d := request.State.GetAttribute(ctx, path.Root(name), &v); d.HasError() || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		tags = flex.ExpandFrameworkStringValueMap(ctx, v)
+		break
+	}
+
+	rule := meta.DeletionProtectionConfig.BlockingRule(r.typeName, tags)
+	if rule == "" {
+		return ctx, diags
+	}
+
+	if conns.DeletionProtectionOverridden() {
+		tflog.Warn(ctx, "Deletion protection overridden", map[string]any{
+			"tf_aws.deletion_protection.rule": rule,
+		})
+		return ctx, diags
+	}
+
+	var id fwtypes.String
+	if _, ok := request.State.Schema.GetAttributes()[names.AttrID]; ok {
+		request.State.GetAttribute(ctx, path.Root(names.AttrID), &id)
+	}
+
+	diags.AddError("Deletion protection", conns.DeletionProtectionErrorDetail(r.typeName, id.ValueString(), rule))
+
+	return ctx, diags
+}
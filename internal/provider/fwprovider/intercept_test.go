@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestInterceptedResourceHandler_beforeError(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+	interceptor := func(name string, err bool) resourceInterceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
+		return func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+			switch when {
+			case Before:
+				calls = append(calls, name+".Before")
+				if err {
+					diags.AddError(name+" error", "")
+				}
+			case Finally:
+				calls = append(calls, name+".Finally")
+			default:
+				calls = append(calls, name+".Other")
+			}
+			return ctx, diags
+		}
+	}
+
+	interceptors := []resourceInterceptorFunc[resource.DeleteRequest, resource.DeleteResponse]{
+		interceptor("first", false),
+		interceptor("second", true),
+		interceptor("third", false),
+	}
+
+	var called bool
+	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
+		called = true
+		return nil
+	}
+
+	diags := interceptedResourceHandler(interceptors, f, nil)(context.Background(), resource.DeleteRequest{}, &resource.DeleteResponse{})
+
+	if got, want := diags.ErrorsCount(), 1; got != want {
+		t.Errorf("errors = %v, want %v", got, want)
+	}
+	if called {
+		t.Error("resource method called")
+	}
+	if got, want := strings.Join(calls, ","), "first.Before,second.Before,first.Finally"; got != want {
+		t.Errorf("calls = %s, want %s", got, want)
+	}
+}
+
+func TestDeletionProtectionResourceInterceptor(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType: fwtypes.StringType,
+				Optional:    true,
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType: fwtypes.StringType,
+				Computed:    true,
+			},
+		},
+	}
+	objectType := s.Type().TerraformType(ctx)
+	mapType := tftypes.Map{ElementType: tftypes.String}
+	state := func(tagsAll map[string]tftypes.Value) tfsdk.State {
+		return tfsdk.State{
+			Schema: s,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"id":       tftypes.NewValue(tftypes.String, "example"),
+				"tags":     tftypes.NewValue(mapType, nil),
+				"tags_all": tftypes.NewValue(mapType, tagsAll),
+			}),
+		}
+	}
+	meta := &conns.AWSClient{
+		DeletionProtectionConfig: &conns.DeletionProtectionConfig{
+			ResourceTypes: []string{"aws_s3_bucket"},
+			Tags:          map[string]string{"Protected": "true"},
+		},
+	}
+
+	testCases := map[string]struct {
+		meta          *conns.AWSClient
+		typeName      string
+		state         tfsdk.State
+		override      string
+		expectedError string
+	}{
+		"no config": {
+			meta:     &conns.AWSClient{},
+			typeName: "aws_s3_bucket",
+			state:    state(nil),
+		},
+		"protected type": {
+			meta:          meta,
+			typeName:      "aws_s3_bucket",
+			state:         state(nil),
+			expectedError: `prevent_destroy_types = "aws_s3_bucket"`,
+		},
+		"protected tag": {
+			meta:     meta,
+			typeName: "aws_route53_zone",
+			state: state(map[string]tftypes.Value{
+				"Protected": tftypes.NewValue(tftypes.String, "true"),
+			}),
+			expectedError: `prevent_destroy_tags = { Protected = "true" }`,
+		},
+		"not protected": {
+			meta:     meta,
+			typeName: "aws_route53_zone",
+			state: state(map[string]tftypes.Value{
+				"Name": tftypes.NewValue(tftypes.String, "example"),
+			}),
+		},
+		"overridden": {
+			meta:     meta,
+			typeName: "aws_s3_bucket",
+			state:    state(nil),
+			override: "1",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(conns.DeletionProtectionOverrideEnvVar, testCase.override)
+
+			request := resource.DeleteRequest{State: testCase.state}
+			response := resource.DeleteResponse{State: testCase.state}
+			_, diags := deletionProtectionResourceInterceptor{typeName: testCase.typeName}.delete(ctx, request, &response, testCase.meta, Before, nil)
+
+			if testCase.expectedError == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+				return
+			}
+
+			if got, want := diags.ErrorsCount(), 1; got != want {
+				t.Fatalf("errors: got %d, want %d", got, want)
+			}
+			if detail := diags[0].Detail(); !strings.Contains(detail, testCase.expectedError) || !strings.Contains(detail, "(example)") {
+				t.Errorf("unexpected detail: %s", detail)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+type testPrimary struct {
+	meta *conns.AWSClient
+}
+
+func (p testPrimary) Meta() interface{} {
+	return p.meta
+}
+
+type testServicePackage struct{}
+
+func (testServicePackage) FrameworkDataSources(context.Context) []*types.ServicePackageFrameworkDataSource {
+	return nil
+}
+
+func (testServicePackage) FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource {
+	return nil
+}
+
+func (testServicePackage) SDKDataSources(context.Context) []*types.ServicePackageSDKDataSource {
+	return nil
+}
+
+func (testServicePackage) SDKResources(context.Context) []*types.ServicePackageSDKResource {
+	return nil
+}
+
+func (testServicePackage) ServicePackageName() string {
+	return "test"
+}
+
+type testServicePackageWithEphemeralResources struct {
+	testServicePackage
+}
+
+func (testServicePackageWithEphemeralResources) EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource {
+	return []*types.ServicePackageEphemeralResource{
+		{
+			Factory: newTestEphemeralResource,
+			Name:    "Test",
+		},
+	}
+}
+
+type testEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+
+	inContext *conns.InContext
+}
+
+func newTestEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &testEphemeralResource{}, nil
+}
+
+func (e *testEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_test"
+}
+
+func (e *testEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{}
+}
+
+func (e *testEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	e.inContext, _ = conns.FromContext(ctx)
+}
+
+func TestEphemeralResources(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p := &fwprovider{
+		Primary: testPrimary{
+			meta: &conns.AWSClient{
+				ServicePackages: map[string]conns.ServicePackage{
+					"test":  testServicePackageWithEphemeralResources{},
+					"other": testServicePackage{},
+				},
+			},
+		},
+	}
+
+	ephemeralResources := p.EphemeralResources(ctx)
+
+	if got, want := len(ephemeralResources), 1; got != want {
+		t.Fatalf("got %d ephemeral resources, want %d", got, want)
+	}
+
+	e := ephemeralResources[0]()
+
+	metadataResponse := ephemeral.MetadataResponse{}
+	e.Metadata(ctx, ephemeral.MetadataRequest{}, &metadataResponse)
+
+	if got, want := metadataResponse.TypeName, "aws_test"; got != want {
+		t.Errorf("got type name %q, want %q", got, want)
+	}
+
+	e.Open(ctx, ephemeral.OpenRequest{}, &ephemeral.OpenResponse{})
+
+	inner := e.(*wrappedEphemeralResource).inner.(*testEphemeralResource)
+
+	if inner.inContext == nil {
+		t.Fatal("no resource information in Context")
+	}
+	if !inner.inContext.IsEphemeral {
+		t.Error("expected ephemeral resource Context")
+	}
+	if got, want := inner.inContext.ResourceName, "Test"; got != want {
+		t.Errorf("got resource name %q, want %q", got, want)
+	}
+}
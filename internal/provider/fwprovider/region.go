@@ -0,0 +1,523 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var (
+	regionValidators = []validator.String{
+		stringvalidator.RegexMatches(regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`), "must be a valid AWS Region Code"),
+	}
+)
+
+// isRegionOverrideEnabled returns whether the per-resource `region` attribute is injected into a schema.
+// Resources in global services and resources that already define a `region` attribute are excluded.
+func isRegionOverrideEnabled[T any](servicePackageName string, attributes map[string]T) bool {
+	if names.IsGlobalService(servicePackageName) {
+		return false
+	}
+
+	if _, ok := attributes[names.AttrRegion]; ok {
+		return false
+	}
+
+	return true
+}
+
+func dataSourceRegionAttribute() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Validators:  regionValidators,
+		Description: "The AWS Region in which this data source is read. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+func resourceRegionAttribute(meta func() *conns.AWSClient) resourceschema.StringAttribute {
+	return resourceschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			regionPlanModifier{meta: meta},
+		},
+		Validators:  regionValidators,
+		Description: "The AWS Region in which this resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionPlanModifier plans the per-resource `region` attribute.
+// If no AWS Region is configured the provider's configured Region is used. Any change forces replacement.
+type regionPlanModifier struct {
+	meta func() *conns.AWSClient
+}
+
+func (m regionPlanModifier) Description(context.Context) string {
+	return "Defaults to the Region set in the provider configuration. Changes force replacement."
+}
+
+func (m regionPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m regionPlanModifier) PlanModifyString(ctx context.Context, request planmodifier.StringRequest, response *planmodifier.StringResponse) {
+	// Destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	if request.ConfigValue.IsNull() {
+		c := m.meta()
+		if c == nil {
+			return
+		}
+
+		// Resources created before the attribute was introduced have no Region in state until refreshed.
+		if !request.State.Raw.IsNull() && request.StateValue.IsNull() {
+			response.PlanValue = request.StateValue
+			return
+		}
+
+		response.PlanValue = fwtypes.StringValue(c.Region)
+	}
+
+	if c := m.meta(); c != nil && !response.PlanValue.IsNull() && !response.PlanValue.IsUnknown() {
+		if err := c.VerifyRegionAllowed(response.PlanValue.ValueString()); err != nil {
+			response.Diagnostics.AddAttributeError(request.Path, "Invalid AWS Region", err.Error())
+			return
+		}
+	}
+
+	if request.State.Raw.IsNull() || request.StateValue.IsNull() || response.PlanValue.IsUnknown() {
+		return
+	}
+
+	if !request.StateValue.Equal(response.PlanValue) {
+		response.RequiresReplace = true
+	}
+}
+
+// regionContext returns a Context containing any AWS Region override read from the specified attribute value.
+func regionContext(ctx context.Context, v fwtypes.String) context.Context {
+	if v.IsNull() || v.IsUnknown() {
+		return ctx
+	}
+
+	return conns.NewRegionContext(ctx, v.ValueString())
+}
+
+// withoutRegion returns the specified object value without the `region` attribute, along with that attribute's value.
+func withoutRegion(raw tftypes.Value, typ tftypes.Type) (tftypes.Value, tftypes.Value, error) {
+	region := tftypes.NewValue(tftypes.String, nil)
+
+	if raw.IsNull() {
+		return tftypes.NewValue(typ, nil), region, nil
+	}
+
+	if !raw.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := raw.As(&attributes); err != nil {
+		return raw, region, fmt.Errorf("removing %s attribute: %w", names.AttrRegion, err)
+	}
+
+	if v, ok := attributes[names.AttrRegion]; ok {
+		region = v
+		delete(attributes, names.AttrRegion)
+	}
+
+	return tftypes.NewValue(typ, attributes), region, nil
+}
+
+// withRegion returns the specified object value with the `region` attribute.
+func withRegion(raw tftypes.Value, typ tftypes.Type, region tftypes.Value) (tftypes.Value, error) {
+	if raw.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if !raw.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := raw.As(&attributes); err != nil {
+		return raw, fmt.Errorf("adding %s attribute: %w", names.AttrRegion, err)
+	}
+
+	attributes[names.AttrRegion] = region
+
+	return tftypes.NewValue(typ, attributes), nil
+}
+
+// knownRegionOr returns the specified `region` attribute value if known, otherwise the AWS Region for Context.
+func knownRegionOr(ctx context.Context, region tftypes.Value, meta *conns.AWSClient) tftypes.Value {
+	if region.IsKnown() {
+		return region
+	}
+
+	return regionForContext(ctx, meta)
+}
+
+// regionForContext returns the AWS Region for Context as a `region` attribute value.
+func regionForContext(ctx context.Context, meta *conns.AWSClient) tftypes.Value {
+	if meta == nil {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	return tftypes.NewValue(tftypes.String, meta.RegionForContext(ctx))
+}
+
+// regionDataSourceInterceptor implements the per-resource `region` attribute for data sources.
+type regionDataSourceInterceptor struct{}
+
+func (r regionDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		if meta != nil && !region.IsNull() && !region.IsUnknown() {
+			if err := meta.VerifyRegionAllowed(region.ValueString()); err != nil {
+				diags.AddAttributeError(path.Root(names.AttrRegion), "Invalid AWS Region", err.Error())
+				return ctx, diags
+			}
+		}
+		ctx = regionContext(ctx, region)
+	}
+
+	return ctx, diags
+}
+
+// regionResourceInterceptor implements the per-resource `region` attribute for resources.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		ctx = regionContext(ctx, region)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		ctx = regionContext(ctx, region)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		if region.IsNull() {
+			diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		}
+		ctx = regionContext(ctx, region)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		ctx = regionContext(ctx, region)
+	}
+
+	return ctx, diags
+}
+
+// regionSchemas converts object values between the wrapped data source's or resource's own schema
+// and the schema with the per-resource `region` attribute.
+// The wrapped data source or resource never sees the `region` attribute.
+type regionSchemas struct {
+	innerType tftypes.Type
+	outerType tftypes.Type
+	diags     diag.Diagnostics
+}
+
+func newRegionSchemas(ctx context.Context, inner, outer interface{ Type() attr.Type }) *regionSchemas {
+	return &regionSchemas{
+		innerType: inner.Type().TerraformType(ctx),
+		outerType: outer.Type().TerraformType(ctx),
+	}
+}
+
+// strip returns the specified value without the `region` attribute, along with that attribute's value.
+func (s *regionSchemas) strip(raw tftypes.Value) (tftypes.Value, tftypes.Value) {
+	v, region, err := withoutRegion(raw, s.innerType)
+	if err != nil {
+		s.diags.AddError("Provider-injected attribute", err.Error())
+	}
+
+	return v, region
+}
+
+// add returns the specified value with the `region` attribute.
+func (s *regionSchemas) add(raw, region tftypes.Value) tftypes.Value {
+	v, err := withRegion(raw, s.outerType, region)
+	if err != nil {
+		s.diags.AddError("Provider-injected attribute", err.Error())
+	}
+
+	return v
+}
+
+func (w *wrappedDataSource) innerSchema(ctx context.Context) datasourceschema.Schema {
+	var response datasource.SchemaResponse
+	w.inner.Schema(ctx, datasource.SchemaRequest{}, &response)
+	return response.Schema
+}
+
+func (w *wrappedDataSource) readWithoutRegion(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
+	inner, outer := w.innerSchema(ctx), response.State.Schema
+	s := newRegionSchemas(ctx, inner, outer)
+
+	request.Config.Raw, _ = s.strip(request.Config.Raw)
+	request.Config.Schema = inner
+	response.State.Raw, _ = s.strip(response.State.Raw)
+	response.State.Schema = inner
+	if s.diags.HasError() {
+		return s.diags
+	}
+
+	w.inner.Read(ctx, request, response)
+
+	response.State.Raw = s.add(response.State.Raw, regionForContext(ctx, w.meta))
+	response.State.Schema = outer
+	response.Diagnostics.Append(s.diags...)
+
+	return response.Diagnostics
+}
+
+func (w *wrappedResource) innerSchema(ctx context.Context) resourceschema.Schema {
+	var response resource.SchemaResponse
+	w.inner.Schema(ctx, resource.SchemaRequest{}, &response)
+	return response.Schema
+}
+
+func (w *wrappedResource) createWithoutRegion(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
+	inner, outer := w.innerSchema(ctx), response.State.Schema
+	s := newRegionSchemas(ctx, inner, outer)
+
+	var region tftypes.Value
+	request.Config.Raw, _ = s.strip(request.Config.Raw)
+	request.Config.Schema = inner
+	request.Plan.Raw, region = s.strip(request.Plan.Raw)
+	request.Plan.Schema = inner
+	response.State.Raw, _ = s.strip(response.State.Raw)
+	response.State.Schema = inner
+	if s.diags.HasError() {
+		return s.diags
+	}
+
+	w.inner.Create(ctx, request, response)
+
+	response.State.Raw = s.add(response.State.Raw, knownRegionOr(ctx, region, w.meta))
+	response.State.Schema = outer
+	response.Diagnostics.Append(s.diags...)
+
+	return response.Diagnostics
+}
+
+func (w *wrappedResource) readWithoutRegion(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
+	inner, outer := w.innerSchema(ctx), response.State.Schema
+	s := newRegionSchemas(ctx, inner, outer)
+
+	request.State.Raw, _ = s.strip(request.State.Raw)
+	request.State.Schema = inner
+	response.State.Raw, _ = s.strip(response.State.Raw)
+	response.State.Schema = inner
+	if s.diags.HasError() {
+		return s.diags
+	}
+
+	w.inner.Read(ctx, request, response)
+
+	// Refresh always sets the AWS Region, including for resources created before the attribute was introduced.
+	response.State.Raw = s.add(response.State.Raw, regionForContext(ctx, w.meta))
+	response.State.Schema = outer
+	response.Diagnostics.Append(s.diags...)
+
+	return response.Diagnostics
+}
+
+func (w *wrappedResource) updateWithoutRegion(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
+	inner, outer := w.innerSchema(ctx), response.State.Schema
+	s := newRegionSchemas(ctx, inner, outer)
+
+	var region tftypes.Value
+	request.Config.Raw, _ = s.strip(request.Config.Raw)
+	request.Config.Schema = inner
+	request.Plan.Raw, region = s.strip(request.Plan.Raw)
+	request.Plan.Schema = inner
+	request.State.Raw, _ = s.strip(request.State.Raw)
+	request.State.Schema = inner
+	response.State.Raw, _ = s.strip(response.State.Raw)
+	response.State.Schema = inner
+	if s.diags.HasError() {
+		return s.diags
+	}
+
+	w.inner.Update(ctx, request, response)
+
+	response.State.Raw = s.add(response.State.Raw, knownRegionOr(ctx, region, w.meta))
+	response.State.Schema = outer
+	response.Diagnostics.Append(s.diags...)
+
+	return response.Diagnostics
+}
+
+func (w *wrappedResource) deleteWithoutRegion(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
+	inner, outer := w.innerSchema(ctx), response.State.Schema
+	s := newRegionSchemas(ctx, inner, outer)
+
+	var region tftypes.Value
+	request.State.Raw, region = s.strip(request.State.Raw)
+	request.State.Schema = inner
+	response.State.Raw, _ = s.strip(response.State.Raw)
+	response.State.Schema = inner
+	if s.diags.HasError() {
+		return s.diags
+	}
+
+	w.inner.Delete(ctx, request, response)
+
+	response.State.Raw = s.add(response.State.Raw, region)
+	response.State.Schema = outer
+	response.Diagnostics.Append(s.diags...)
+
+	return response.Diagnostics
+}
+
+func (w *wrappedResource) importStateWithoutRegion(ctx context.Context, inner resource.ResourceWithImportState, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	innerSchema, outer := w.innerSchema(ctx), response.State.Schema
+	s := newRegionSchemas(ctx, innerSchema, outer)
+
+	region := tftypes.NewValue(tftypes.String, nil)
+	if id, v, ok := conns.SplitImportIDRegion(request.ID); ok {
+		request.ID = id
+		region = tftypes.NewValue(tftypes.String, v)
+		ctx = conns.NewRegionContext(ctx, v)
+	}
+
+	response.State.Raw, _ = s.strip(response.State.Raw)
+	response.State.Schema = innerSchema
+	if s.diags.HasError() {
+		response.Diagnostics.Append(s.diags...)
+		return
+	}
+
+	inner.ImportState(ctx, request, response)
+
+	response.State.Raw = s.add(response.State.Raw, region)
+	response.State.Schema = outer
+	response.Diagnostics.Append(s.diags...)
+}
+
+func (w *wrappedResource) modifyPlanWithoutRegion(ctx context.Context, inner resource.ResourceWithModifyPlan, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	innerSchema, outer := w.innerSchema(ctx), response.Plan.Schema
+	s := newRegionSchemas(ctx, innerSchema, outer)
+
+	// The `region` attribute has already been planned.
+	var region tftypes.Value
+	request.Config.Raw, _ = s.strip(request.Config.Raw)
+	request.Config.Schema = innerSchema
+	request.Plan.Raw, _ = s.strip(request.Plan.Raw)
+	request.Plan.Schema = innerSchema
+	request.State.Raw, _ = s.strip(request.State.Raw)
+	request.State.Schema = innerSchema
+	response.Plan.Raw, region = s.strip(response.Plan.Raw)
+	response.Plan.Schema = innerSchema
+	if s.diags.HasError() {
+		response.Diagnostics.Append(s.diags...)
+		return
+	}
+
+	if region.IsKnown() && !region.IsNull() {
+		var v string
+		if err := region.As(&v); err == nil {
+			ctx = conns.NewRegionContext(ctx, v)
+		}
+	}
+
+	inner.ModifyPlan(ctx, request, response)
+
+	response.Plan.Raw = s.add(response.Plan.Raw, region)
+	response.Plan.Schema = outer
+	response.Diagnostics.Append(s.diags...)
+}
+
+func (w *wrappedResource) validateConfigWithoutRegion(ctx context.Context, inner resource.ResourceWithValidateConfig, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	innerSchema := w.innerSchema(ctx)
+	s := newRegionSchemas(ctx, innerSchema, request.Config.Schema)
+
+	request.Config.Raw, _ = s.strip(request.Config.Raw)
+	request.Config.Schema = innerSchema
+	if s.diags.HasError() {
+		response.Diagnostics.Append(s.diags...)
+		return
+	}
+
+	inner.ValidateConfig(ctx, request, response)
+}
+
+func (w *wrappedResource) stateUpgradersWithoutRegion(ctx context.Context, stateUpgraders map[int64]resource.StateUpgrader) map[int64]resource.StateUpgrader {
+	innerSchema := w.innerSchema(ctx)
+
+	for k, v := range stateUpgraders {
+		f := v.StateUpgrader
+		v.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+			outer := response.State.Schema
+			s := newRegionSchemas(ctx, innerSchema, outer)
+
+			response.State.Raw, _ = s.strip(response.State.Raw)
+			response.State.Schema = innerSchema
+			if s.diags.HasError() {
+				response.Diagnostics.Append(s.diags...)
+				return
+			}
+
+			f(ctx, request, response)
+
+			// The AWS Region is set on the next refresh.
+			response.State.Raw = s.add(response.State.Raw, tftypes.NewValue(tftypes.String, nil))
+			response.State.Schema = outer
+			response.Diagnostics.Append(s.diags...)
+		}
+		stateUpgraders[k] = v
+	}
+
+	return stateUpgraders
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWithoutRegionWithRegion(t *testing.T) {
+	t.Parallel()
+
+	innerType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id": tftypes.String,
+	}}
+	outerType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":     tftypes.String,
+		"region": tftypes.String,
+	}}
+
+	testCases := map[string]struct {
+		raw            tftypes.Value
+		expectedInner  tftypes.Value
+		expectedRegion tftypes.Value
+	}{
+		"null": {
+			raw:            tftypes.NewValue(outerType, nil),
+			expectedInner:  tftypes.NewValue(innerType, nil),
+			expectedRegion: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			raw:            tftypes.NewValue(outerType, tftypes.UnknownValue),
+			expectedInner:  tftypes.NewValue(innerType, tftypes.UnknownValue),
+			expectedRegion: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"known": {
+			raw: tftypes.NewValue(outerType, map[string]tftypes.Value{
+				"id":     tftypes.NewValue(tftypes.String, "id-1"),
+				"region": tftypes.NewValue(tftypes.String, "eu-west-1"), //lintignore:AWSAT003
+			}),
+			expectedInner: tftypes.NewValue(innerType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "id-1"),
+			}),
+			expectedRegion: tftypes.NewValue(tftypes.String, "eu-west-1"), //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			inner, region, err := withoutRegion(testCase.raw, innerType)
+			if err != nil {
+				t.Fatalf("withoutRegion: unexpected error: %s", err)
+			}
+
+			if !inner.Equal(testCase.expectedInner) {
+				t.Errorf("withoutRegion: got %s, expected %s", inner, testCase.expectedInner)
+			}
+			if !region.Equal(testCase.expectedRegion) {
+				t.Errorf("withoutRegion: got region %s, expected %s", region, testCase.expectedRegion)
+			}
+
+			outer, err := withRegion(inner, outerType, region)
+			if err != nil {
+				t.Fatalf("withRegion: unexpected error: %s", err)
+			}
+
+			if !outer.Equal(testCase.raw) {
+				t.Errorf("withRegion: got %s, expected %s", outer, testCase.raw)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// tagPolicyPlanModifier evaluates the provider's tag policy against a resource's planned `tags_all`.
+// Violations are reported as errors or warnings depending on the policy's severity.
+type tagPolicyPlanModifier struct {
+	typeName string
+}
+
+func (m tagPolicyPlanModifier) modifyPlan(ctx context.Context, meta *conns.AWSClient, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if meta == nil || meta.TagPolicyConfig == nil {
+		return
+	}
+
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() || response.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tagsAll fwtypes.Map
+	if diags := response.Plan.GetAttribute(ctx, path.Root(names.AttrTagsAll), &tagsAll); diags.HasError() {
+		return
+	}
+
+	if tagsAll.IsNull() || tagsAll.IsUnknown() {
+		return
+	}
+
+	for _, v := range tagsAll.Elements() {
+		if v.IsUnknown() {
+			return
+		}
+	}
+
+	policyConfig := meta.TagPolicyConfig
+	for _, v := range policyConfig.Violations(m.typeName, tftags.New(ctx, tagsAll).IgnoreAWS()) {
+		if policyConfig.IsWarning() {
+			response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), "Tag policy violation", v)
+		} else {
+			response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), "Tag policy violation", v)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestTagPolicyPlanModifier(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.MapAttribute{
+				ElementType: fwtypes.StringType,
+				Optional:    true,
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType: fwtypes.StringType,
+				Computed:    true,
+			},
+		},
+	}
+	objectType := s.Type().TerraformType(ctx)
+	mapType := tftypes.Map{ElementType: tftypes.String}
+	plan := func(tagsAll tftypes.Value) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: s,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"tags":     tftypes.NewValue(mapType, nil),
+				"tags_all": tagsAll,
+			}),
+		}
+	}
+	tags := func(tags map[string]tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(mapType, tags)
+	}
+	rules := []tftags.PolicyRule{
+		{
+			Key:      "CostCenter",
+			Required: true,
+		},
+	}
+
+	testCases := map[string]struct {
+		policyConfig     *tftags.PolicyConfig
+		tagsAll          tftypes.Value
+		expectedErrors   int
+		expectedWarnings int
+	}{
+		"no policy": {
+			tagsAll: tags(map[string]tftypes.Value{}),
+		},
+		"compliant": {
+			policyConfig: &tftags.PolicyConfig{Severity: tftags.PolicySeverityError, Rules: rules},
+			tagsAll: tags(map[string]tftypes.Value{
+				"CostCenter": tftypes.NewValue(tftypes.String, "1234"),
+			}),
+		},
+		"error": {
+			policyConfig: &tftags.PolicyConfig{Severity: tftags.PolicySeverityError, Rules: rules},
+			tagsAll: tags(map[string]tftypes.Value{
+				"Name": tftypes.NewValue(tftypes.String, "test"),
+			}),
+			expectedErrors: 1,
+		},
+		"warning": {
+			policyConfig: &tftags.PolicyConfig{Severity: tftags.PolicySeverityWarning, Rules: rules},
+			tagsAll: tags(map[string]tftypes.Value{
+				"Name": tftypes.NewValue(tftypes.String, "test"),
+			}),
+			expectedWarnings: 1,
+		},
+		"unknown": {
+			policyConfig: &tftags.PolicyConfig{Severity: tftags.PolicySeverityError, Rules: rules},
+			tagsAll:      tftypes.NewValue(mapType, tftypes.UnknownValue),
+		},
+		"unknown value": {
+			policyConfig: &tftags.PolicyConfig{Severity: tftags.PolicySeverityError, Rules: rules},
+			tagsAll: tags(map[string]tftypes.Value{
+				"Name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := resource.ModifyPlanRequest{
+				Plan: plan(testCase.tagsAll),
+			}
+			response := resource.ModifyPlanResponse{
+				Plan: plan(testCase.tagsAll),
+			}
+			meta := &conns.AWSClient{
+				TagPolicyConfig: testCase.policyConfig,
+			}
+
+			tagPolicyPlanModifier{typeName: "aws_test"}.modifyPlan(ctx, meta, request, &response)
+
+			if got, want := response.Diagnostics.ErrorsCount(), testCase.expectedErrors; got != want {
+				t.Errorf("errors: got %d, want %d: %v", got, want, response.Diagnostics)
+			}
+			if got, want := response.Diagnostics.WarningsCount(), testCase.expectedWarnings; got != want {
+				t.Errorf("warnings: got %d, want %d: %v", got, want, response.Diagnostics)
+			}
+			for _, d := range response.Diagnostics {
+				if d.Severity() == diag.SeverityError && d.Detail() != `tag "CostCenter" is required` {
+					t.Errorf("unexpected detail: %s", d.Detail())
+				}
+			}
+		})
+	}
+}
+
+// testResourceWithoutModifyPlan is a resource that does not implement resource.ResourceWithModifyPlan.
+type testResourceWithoutModifyPlan struct {
+	resource.ResourceWithConfigure
+}
+
+func TestWrappedResourceModifyPlan_tagPolicyWithoutResourceModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.MapAttribute{
+				ElementType: fwtypes.StringType,
+				Optional:    true,
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType: fwtypes.StringType,
+				Computed:    true,
+			},
+		},
+	}
+	mapType := tftypes.Map{ElementType: tftypes.String}
+	plan := tfsdk.Plan{
+		Schema: s,
+		Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"tags": tftypes.NewValue(mapType, nil),
+			"tags_all": tftypes.NewValue(mapType, map[string]tftypes.Value{
+				"Name": tftypes.NewValue(tftypes.String, "test"),
+			}),
+		}),
+	}
+
+	w := newWrappedResource(
+		func(ctx context.Context, _ *conns.AWSClient) context.Context { return ctx },
+		testResourceWithoutModifyPlan{},
+		nil,
+		false,
+		&tagPolicyPlanModifier{typeName: "aws_test"},
+		nil,
+	).(*wrappedResource)
+	w.meta = &conns.AWSClient{
+		TagPolicyConfig: &tftags.PolicyConfig{
+			Severity: tftags.PolicySeverityError,
+			Rules: []tftags.PolicyRule{
+				{
+					Key:      "CostCenter",
+					Required: true,
+				},
+			},
+		},
+	}
+
+	request := resource.ModifyPlanRequest{Plan: plan}
+	response := resource.ModifyPlanResponse{Plan: plan}
+	w.ModifyPlan(ctx, request, &response)
+
+	if got, want := response.Diagnostics.ErrorsCount(), 1; got != want {
+		t.Errorf("errors: got %d, want %d: %v", got, want, response.Diagnostics)
+	}
+}
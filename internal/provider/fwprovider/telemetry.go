@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// telemetryInterceptor records client-side metrics (and optionally a trace span) for each CRUD operation.
+// It should be the first interceptor so that AWS API calls made by all other interceptors are included.
+type telemetryInterceptor struct {
+	typeName string
+}
+
+func (r telemetryInterceptor) run(ctx context.Context, meta *conns.AWSClient, operation string, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		ctx = meta.Telemetry().StartResourceOperation(ctx, r.typeName, operation)
+	case Finally:
+		// Finally is also run if a later Before interceptor errors.
+		meta.Telemetry().EndResourceOperation(ctx, diags.HasError())
+	}
+
+	return ctx, diags
+}
+
+// telemetryDataSourceInterceptor records client-side metrics for data sources.
+type telemetryDataSourceInterceptor struct {
+	telemetryInterceptor
+}
+
+func (r telemetryDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, "Read", when, diags)
+}
+
+// telemetryResourceInterceptor records client-side metrics for resources.
+type telemetryResourceInterceptor struct {
+	telemetryInterceptor
+}
+
+func (r telemetryResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, "Create", when, diags)
+}
+
+func (r telemetryResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, "Read", when, diags)
+}
+
+func (r telemetryResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, "Update", when, diags)
+}
+
+func (r telemetryResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, "Delete", when, diags)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strings"
+
+	orgtypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// iamPolicyAttributesWithoutPolicySchema lists, by resource type name, the attributes of SDKv2 resources that hold
+// IAM policy documents but that neither suppress diffs between equivalent policies nor validate IAM policy JSON.
+var iamPolicyAttributesWithoutPolicySchema = map[string][]string{
+	"aws_organizations_policy": {names.AttrContent},
+}
+
+// iamPolicyAttributePaths returns the paths of all attributes in a resource schema that hold IAM policy documents.
+// An attribute holds an IAM policy document if it suppresses diffs between equivalent policies or validates IAM policy JSON.
+func iamPolicyAttributePaths(typeName string, s map[string]*schema.Schema) [][]string {
+	var paths [][]string
+
+	for _, v := range iamPolicyAttributesWithoutPolicySchema[typeName] {
+		paths = append(paths, strings.Split(v, "."))
+	}
+
+	var walk func(map[string]*schema.Schema, []string)
+	walk = func(s map[string]*schema.Schema, prefix []string) {
+		for k, v := range s {
+			path := append(slices.Clone(prefix), k)
+
+			switch elem := v.Elem.(type) {
+			case *schema.Resource:
+				walk(elem.SchemaMap(), path)
+			default:
+				if v.Type == schema.TypeString && (isFunc(v.DiffSuppressFunc, verify.SuppressEquivalentPolicyDiffs) || isFunc(v.ValidateFunc, verify.ValidIAMPolicyJSON)) {
+					paths = append(paths, path)
+				}
+			}
+		}
+	}
+	walk(s, nil)
+
+	slices.SortFunc(paths, slices.Compare)
+
+	return slices.CompactFunc(paths, slices.Equal)
+}
+
+// isFunc returns whether f is the function g.
+func isFunc[F any](f F, g F) bool {
+	v := reflect.ValueOf(f)
+	return !v.IsNil() && v.Pointer() == reflect.ValueOf(g).Pointer()
+}
+
+// iamPolicyAttributeValues returns the non-empty values at the specified attribute path.
+func iamPolicyAttributeValues(v any, path []string) []string {
+	if len(path) == 0 {
+		if v, ok := v.(string); ok && v != "" {
+			return []string{v}
+		}
+
+		return nil
+	}
+
+	var elems []any
+	switch v := v.(type) {
+	case []any:
+		elems = v
+	case *schema.Set:
+		elems = v.List()
+	}
+
+	var values []string
+	for _, elem := range elems {
+		if tfMap, ok := elem.(map[string]any); ok {
+			values = append(values, iamPolicyAttributeValues(tfMap[path[0]], path[1:])...)
+		}
+	}
+
+	return values
+}
+
+type iamPolicyLintFinding struct {
+	attrName string
+	tfiam.PolicyLintFinding
+}
+
+// iamPolicyLintFindings statically analyzes a resource's new or changed IAM policy documents if policy linting is enabled.
+// Policy documents that are not yet known or are not valid JSON are skipped.
+func iamPolicyLintFindings(ctx context.Context, d interface {
+	Get(string) any
+	HasChange(string) bool
+	Id() string
+}, meta any, typeName string, paths [][]string) []iamPolicyLintFinding {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok || !c.LintIAMPolicies {
+		return nil
+	}
+
+	// Organizations policies other than service control policies are not IAM policy documents.
+	if typeName == "aws_organizations_policy" && d.Get(names.AttrType).(string) != string(orgtypes.PolicyTypeServiceControlPolicy) {
+		return nil
+	}
+
+	var findings []iamPolicyLintFinding
+
+	for _, path := range paths {
+		attrName := path[0]
+		if d.Id() != "" && !d.HasChange(attrName) {
+			continue
+		}
+
+		policyType := tfiam.PolicyLintTypeForAttribute(typeName, path[len(path)-1])
+		for _, document := range iamPolicyAttributeValues(d.Get(attrName), path[1:]) {
+			v, err := tfiam.LintPolicy(document, policyType)
+
+			if err != nil {
+				tflog.Debug(ctx, "Skipping IAM policy lint", map[string]any{
+					"tf_aws.iam_policy_lint.attribute": strings.Join(path, "."),
+					"error":                            err.Error(),
+				})
+				continue
+			}
+
+			for _, v := range v {
+				findings = append(findings, iamPolicyLintFinding{attrName: attrName, PolicyLintFinding: v})
+			}
+		}
+	}
+
+	return findings
+}
+
+// iamPolicyLintCustomizeDiff reports IAM policy lint findings as plan warnings after calling any resource-specific CustomizeDiff function.
+// Findings are only written to the provider log if CustomizeDiff is not called while planning, e.g. in unit tests.
+func iamPolicyLintCustomizeDiff(typeName string, paths [][]string, f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		if f != nil {
+			if err := f(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+
+		for _, v := range iamPolicyLintFindings(ctx, d, meta, typeName, paths) {
+			if !addPlanWarning(ctx, v.attrName, "IAM policy lint finding", v.String()) {
+				tflog.Warn(ctx, "IAM policy lint finding", map[string]any{
+					"tf_aws.iam_policy_lint.attribute": v.attrName,
+					"tf_aws.iam_policy_lint.finding":   v.String(),
+				})
+			}
+		}
+
+		return nil
+	}
+}
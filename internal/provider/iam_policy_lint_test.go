@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestIAMPolicyLintFindings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := map[string]*schema.Schema{
+		"assume_role_policy": {
+			Type:             schema.TypeString,
+			Required:         true,
+			DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+		},
+		"inline_policy": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrName: {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					names.AttrPolicy: {
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+					},
+				},
+			},
+		},
+		names.AttrName: {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	paths := iamPolicyAttributePaths("aws_iam_role", s)
+
+	if diff := cmp.Diff(paths, [][]string{{"assume_role_policy"}, {"inline_policy", names.AttrPolicy}}); diff != "" {
+		t.Fatalf("unexpected paths diff (+wanted, -got): %s", diff)
+	}
+
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"assume_role_policy": `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Principal": {"Service": "ec2.amazonaws.com"}, "Action": "sts:AssumeRole"}]}`,
+		"inline_policy": []interface{}{
+			map[string]interface{}{
+				names.AttrName:   "test",
+				names.AttrPolicy: `{"Version": "2012-10-17", "Statement": [{"Sid": "A", "Effect": "Allow", "Action": "*", "Resource": "*"}]}`,
+			},
+		},
+	})
+
+	testCases := map[string]struct {
+		meta             any
+		expectedFindings []string
+	}{
+		"disabled": {
+			meta: &conns.AWSClient{},
+		},
+		"enabled": {
+			meta:             &conns.AWSClient{LintIAMPolicies: true},
+			expectedFindings: []string{`inline_policy: WILDCARD_ACTION_AND_RESOURCE: Statement[0] (A): Action "*" with Resource "*" grants full access to all resources`},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, v := range iamPolicyLintFindings(ctx, d, testCase.meta, "aws_iam_role", paths) {
+				got = append(got, v.attrName+": "+v.String())
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedFindings); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyAttributePaths(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resources := make(map[string]*schema.Resource)
+	for _, sp := range []conns.ServicePackage{tfiam.ServicePackage(ctx), tforganizations.ServicePackage(ctx)} {
+		for _, v := range sp.SDKResources(ctx) {
+			resources[v.TypeName] = v.Factory()
+		}
+	}
+
+	testCases := map[string]struct {
+		expectedPaths [][]string
+	}{
+		"aws_iam_role": {
+			expectedPaths: [][]string{{"assume_role_policy"}, {"inline_policy", names.AttrPolicy}},
+		},
+		"aws_iam_user": {},
+		"aws_organizations_policy": {
+			expectedPaths: [][]string{{names.AttrContent}},
+		},
+		"aws_organizations_resource_policy": {
+			expectedPaths: [][]string{{names.AttrContent}},
+		},
+	}
+
+	for typeName, testCase := range testCases {
+		t.Run(typeName, func(t *testing.T) {
+			t.Parallel()
+
+			r, ok := resources[typeName]
+			if !ok {
+				t.Fatalf("resource type %s not found", typeName)
+			}
+
+			if diff := cmp.Diff(iamPolicyAttributePaths(typeName, r.SchemaMap()), testCase.expectedPaths); diff != "" {
+				t.Errorf("unexpected paths diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyLintFindings_organizationsPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := map[string]*schema.Schema{
+		names.AttrContent: {
+			Type:             schema.TypeString,
+			Required:         true,
+			DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+		},
+		names.AttrType: {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+	paths := iamPolicyAttributePaths("aws_organizations_policy", s)
+	meta := &conns.AWSClient{LintIAMPolicies: true}
+
+	testCases := map[string]struct {
+		policyType       string
+		expectedFindings int
+	}{
+		"SERVICE_CONTROL_POLICY": {
+			policyType:       "SERVICE_CONTROL_POLICY",
+			expectedFindings: 1,
+		},
+		"TAG_POLICY": {
+			policyType: "TAG_POLICY",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+				names.AttrContent: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "NotAction": "s3:*", "Resource": "*"}]}`,
+				names.AttrType:    testCase.policyType,
+			})
+
+			if got, want := len(iamPolicyLintFindings(ctx, d, meta, "aws_organizations_policy", paths)), testCase.expectedFindings; got != want {
+				t.Errorf("number of findings = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyLintCustomizeDiff_planWarnings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrPolicy: {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+			},
+		},
+	}
+	r.CustomizeDiff = iamPolicyLintCustomizeDiff("aws_iam_policy", iamPolicyAttributePaths("aws_iam_policy", r.SchemaMap()), nil)
+
+	w := &planWarnings{}
+	ctx = context.WithValue(ctx, planWarningsKey, w)
+
+	d := schema.TestResourceDataRaw(t, r.SchemaMap(), map[string]interface{}{})
+	config := map[string]interface{}{
+		names.AttrPolicy: `{"Version": "2012-10-17", "Statement": [{"Sid": "A", "Effect": "Allow", "Action": "*", "Resource": "*"}]}`,
+	}
+
+	if _, err := r.Diff(ctx, d.State(), terraform.NewResourceConfigRaw(config), &conns.AWSClient{LintIAMPolicies: true}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(w.diags), 1; got != want {
+		t.Fatalf("number of plan warnings = %v, want %v", got, want)
+	}
+	if got, want := w.diags[0].Severity, tfprotov5.DiagnosticSeverityWarning; got != want {
+		t.Errorf("severity = %v, want %v", got, want)
+	}
+}
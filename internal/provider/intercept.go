@@ -36,7 +36,8 @@ type schemaResourceData interface {
 
 // An interceptor is functionality invoked during the CRUD request lifecycle.
 // If a Before interceptor returns Diagnostics indicating an error occurred then
-// no further interceptors in the chain are run and neither is the schema's method.
+// no further interceptors in the chain are run and neither is the schema's method,
+// but Finally interceptors earlier in the chain are run.
 // In other cases all interceptors in the chain are run.
 type interceptor interface {
 	run(context.Context, schemaResourceData, any, when, why, diag.Diagnostics) (context.Context, diag.Diagnostics)
@@ -63,7 +64,7 @@ const (
 	Before  when = 1 << iota // Interceptor is invoked before call to method in schema
 	After                    // Interceptor is invoked after successful call to method in schema
 	OnError                  // Interceptor is invoked after unsuccessful call to method in schema
-	Finally                  // Interceptor is invoked after After or OnError
+	Finally                  // Interceptor is invoked after After or OnError, or after a later Before interceptor errors
 )
 
 // why represents the CRUD operation(s) that an interceptor is run.
@@ -97,12 +98,21 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 		forward := interceptors.why(why)
 
 		when := Before
-		for _, v := range forward {
+		for i, v := range forward {
 			if v.when&when != 0 {
 				ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
 
 				// Short circuit if any Before interceptor errors.
+				// Finally interceptors whose Before interceptor has run are run last to first.
 				if diags.HasError() {
+					ctx = context.WithValue(ctx, contextKeyBeforeError, true)
+					when = Finally
+					for _, v := range slices.Reverse(forward[:i]) {
+						if v.when&when != 0 {
+							_, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
+						}
+					}
+
 					return diags
 				}
 			}
@@ -134,6 +144,19 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 	}
 }
 
+type contextKey int
+
+const (
+	contextKeyBeforeError contextKey = iota
+)
+
+// beforeErrored returns whether Finally interceptors are being run because a Before interceptor errored,
+// in which case the schema's method has not been called.
+func beforeErrored(ctx context.Context) bool {
+	v, _ := ctx.Value(contextKeyBeforeError).(bool)
+	return v
+}
+
 // contextFunc augments Context.
 type contextFunc func(context.Context, any) context.Context
 
@@ -372,6 +395,11 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 			}
 		}
 	case Finally:
+		// Don't update tags if the schema's Update method was not called.
+		if beforeErrored(ctx) {
+			return ctx, diags
+		}
+
 		switch why {
 		case Update:
 			if r.tags.IdentifierAttribute != "" && !d.GetRawPlan().GetAttr(names.AttrTagsAll).IsWhollyKnown() {
@@ -497,3 +525,66 @@ func (r tagsDataSourceInterceptor) run(ctx context.Context, d schemaResourceData
 
 	return ctx, diags
 }
+
+// deletionProtectionInterceptor prevents the deletion of resources protected by the provider's deletion protection rules.
+type deletionProtectionInterceptor struct {
+	typeName string
+}
+
+func (r deletionProtectionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when != Before || why != Delete {
+		return ctx, diags
+	}
+
+	c, ok := meta.(*conns.AWSClient)
+	if !ok || c.DeletionProtectionConfig == nil {
+		return ctx, diags
+	}
+
+	rule := c.DeletionProtectionConfig.BlockingRule(r.typeName, stateTags(d.GetRawState()))
+	if rule == "" {
+		return ctx, diags
+	}
+
+	if conns.DeletionProtectionOverridden() {
+		tflog.Warn(ctx, "Deletion protection overridden", map[string]any{
+			"tf_aws.deletion_protection.rule": rule,
+		})
+		return ctx, diags
+	}
+
+	return ctx, append(diags, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "Deletion protection",
+		Detail:   conns.DeletionProtectionErrorDetail(r.typeName, d.Id(), rule),
+	})
+}
+
+// stateTags returns a resource's `tags_all` (or, if not present, `tags`) from its raw state.
+func stateTags(state cty.Value) map[string]string {
+	if state.IsNull() || !state.IsKnown() || !state.Type().IsObjectType() {
+		return nil
+	}
+
+	for _, name := range []string{names.AttrTagsAll, names.AttrTags} {
+		if !state.Type().HasAttribute(name) {
+			continue
+		}
+
+		v := state.GetAttr(name)
+		if v.IsNull() || !v.IsWhollyKnown() || !v.Type().IsMapType() {
+			continue
+		}
+
+		tags := make(map[string]string, v.LengthInt())
+		for key, v := range v.AsValueMap() {
+			if !v.IsNull() && v.Type() == cty.String {
+				tags[key] = v.AsString()
+			}
+		}
+
+		return tags
+	}
+
+	return nil
+}
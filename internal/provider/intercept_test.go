@@ -5,10 +5,13 @@ package provider
 
 import (
 	"context"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
@@ -93,3 +96,180 @@ func TestInterceptedHandler(t *testing.T) {
 		t.Errorf("length of diags = %v, want %v", got, want)
 	}
 }
+
+func TestInterceptedHandler_beforeError(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+	interceptor := func(name string, err bool) interceptorFunc {
+		return func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+			switch when {
+			case Before:
+				calls = append(calls, name+".Before")
+				if err {
+					diags = sdkdiag.AppendErrorf(diags, "%s error", name)
+				}
+			case Finally:
+				if !beforeErrored(ctx) {
+					t.Errorf("%s.Finally: Before error not in Context", name)
+				}
+				calls = append(calls, name+".Finally")
+			default:
+				calls = append(calls, name+".Other")
+			}
+			return ctx, diags
+		}
+	}
+
+	interceptors := interceptorItems{
+		{
+			when:        Before | After | OnError | Finally,
+			why:         Delete,
+			interceptor: interceptor("first", false),
+		},
+		{
+			when:        Before | Finally,
+			why:         Delete,
+			interceptor: interceptor("second", true),
+		},
+		{
+			when:        Before | Finally,
+			why:         Delete,
+			interceptor: interceptor("third", false),
+		},
+	}
+
+	var called bool
+	var deleteFunc schema.DeleteContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		called = true
+		return nil
+	}
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return ctx
+	}
+
+	diags := interceptedHandler(bootstrapContext, interceptors, deleteFunc, Delete)(context.Background(), nil, 42)
+
+	if got, want := len(diags), 1; got != want {
+		t.Errorf("length of diags = %v, want %v", got, want)
+	}
+	if called {
+		t.Error("schema method called")
+	}
+	if got, want := strings.Join(calls, ","), "first.Before,second.Before,first.Finally"; got != want {
+		t.Errorf("calls = %s, want %s", got, want)
+	}
+}
+
+// deletionProtectionResourceData is a schemaResourceData with only an ID and raw state.
+type deletionProtectionResourceData struct {
+	schemaResourceData
+	id    string
+	state cty.Value
+}
+
+func (d deletionProtectionResourceData) GetRawState() cty.Value {
+	return d.state
+}
+
+func (d deletionProtectionResourceData) Id() string {
+	return d.id
+}
+
+func TestDeletionProtectionInterceptor(t *testing.T) {
+	ctx := context.Background()
+	meta := &conns.AWSClient{
+		DeletionProtectionConfig: &conns.DeletionProtectionConfig{
+			ResourceTypes: []string{"aws_s3_bucket"},
+			Tags:          map[string]string{"Protected": "true"},
+		},
+	}
+	state := func(tagsAll map[string]cty.Value) cty.Value {
+		v := cty.NullVal(cty.Map(cty.String))
+		if tagsAll != nil {
+			v = cty.MapVal(tagsAll)
+		}
+
+		return cty.ObjectVal(map[string]cty.Value{
+			"id":       cty.StringVal("example"),
+			"tags":     cty.NullVal(cty.Map(cty.String)),
+			"tags_all": v,
+		})
+	}
+
+	testCases := map[string]struct {
+		meta          any
+		typeName      string
+		why           why
+		state         cty.Value
+		override      string
+		expectedError string
+	}{
+		"no config": {
+			meta:     &conns.AWSClient{},
+			typeName: "aws_s3_bucket",
+			why:      Delete,
+			state:    state(nil),
+		},
+		"protected type": {
+			meta:          meta,
+			typeName:      "aws_s3_bucket",
+			why:           Delete,
+			state:         state(nil),
+			expectedError: `prevent_destroy_types = "aws_s3_bucket"`,
+		},
+		"protected tag": {
+			meta:     meta,
+			typeName: "aws_route53_zone",
+			why:      Delete,
+			state: state(map[string]cty.Value{
+				"Protected": cty.StringVal("true"),
+			}),
+			expectedError: `prevent_destroy_tags = { Protected = "true" }`,
+		},
+		"not protected": {
+			meta:     meta,
+			typeName: "aws_route53_zone",
+			why:      Delete,
+			state: state(map[string]cty.Value{
+				"Protected": cty.StringVal("false"),
+			}),
+		},
+		"update": {
+			meta:     meta,
+			typeName: "aws_s3_bucket",
+			why:      Update,
+			state:    state(nil),
+		},
+		"overridden": {
+			meta:     meta,
+			typeName: "aws_s3_bucket",
+			why:      Delete,
+			state:    state(nil),
+			override: "true",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(conns.DeletionProtectionOverrideEnvVar, testCase.override)
+
+			d := deletionProtectionResourceData{id: "example", state: testCase.state}
+			_, diags := deletionProtectionInterceptor{typeName: testCase.typeName}.run(ctx, d, testCase.meta, Before, testCase.why, nil)
+
+			if testCase.expectedError == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+				return
+			}
+
+			if got, want := len(diags), 1; got != want {
+				t.Fatalf("length of diags = %v, want %v", got, want)
+			}
+			if detail := diags[0].Detail; !strings.Contains(detail, testCase.expectedError) || !strings.Contains(detail, conns.DeletionProtectionOverrideEnvVar) {
+				t.Errorf("unexpected detail: %s", detail)
+			}
+		})
+	}
+}
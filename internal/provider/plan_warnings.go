@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type planWarningsContextKey int
+
+const (
+	planWarningsKey planWarningsContextKey = iota
+)
+
+// planWarnings collects the warnings raised while a Plugin SDK resource's change is planned.
+// SDKv2 CustomizeDiff functions cannot return warnings, so they are added to the PlanResourceChange response instead.
+type planWarnings struct {
+	diags []*tfprotov5.Diagnostic
+	mutex sync.Mutex
+}
+
+// addPlanWarning adds a warning for the specified top-level attribute to the plan being made in Context.
+// It returns false if no plan is being made, e.g. when CustomizeDiff is called outside PlanResourceChange.
+func addPlanWarning(ctx context.Context, attrName, summary, detail string) bool {
+	w, ok := ctx.Value(planWarningsKey).(*planWarnings)
+	if !ok {
+		return false
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.diags = append(w.diags, &tfprotov5.Diagnostic{
+		Severity:  tfprotov5.DiagnosticSeverityWarning,
+		Summary:   summary,
+		Detail:    detail,
+		Attribute: tftypes.NewAttributePath().WithAttributeName(attrName),
+	})
+
+	return true
+}
+
+// planWarningsProviderServer is a protocol v5 provider server that returns the warnings added by
+// addPlanWarning during PlanResourceChange.
+type planWarningsProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s planWarningsProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	w := &planWarnings{}
+	ctx = context.WithValue(ctx, planWarningsKey, w)
+
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if response != nil {
+		w.mutex.Lock()
+		defer w.mutex.Unlock()
+
+		response.Diagnostics = append(response.Diagnostics, w.diags...)
+	}
+
+	return response, err
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -33,6 +34,27 @@ import (
 // New returns a new, initialized Terraform Plugin SDK v2-style provider instance.
 // The provider instance is fully configured once the `ConfigureContextFunc` has been called.
 func New(ctx context.Context) (*schema.Provider, error) {
+	return newProvider(ctx, servicePackages(ctx))
+}
+
+// NewWithServicePackages returns a new, initialized provider instance, as New does,
+// that also includes the specified service packages.
+// It is used by tests of service packages that may not be built into the provider.
+func NewWithServicePackages(ctx context.Context, sps ...conns.ServicePackage) (*schema.Provider, error) {
+	v := servicePackages(ctx)
+
+	for _, sp := range sps {
+		if !slices.ContainsFunc(v, func(e conns.ServicePackage) bool {
+			return e.ServicePackageName() == sp.ServicePackageName()
+		}) {
+			v = append(v, sp)
+		}
+	}
+
+	return newProvider(ctx, v)
+}
+
+func newProvider(ctx context.Context, servicePackages []conns.ServicePackage) (*schema.Provider, error) {
 	provider := &schema.Provider{
 		// This schema must match exactly the Terraform Protocol v6 (Terraform Plugin Framework) provider's schema.
 		// Notably the attributes can have no Default values.
@@ -49,6 +71,22 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"allowed_ou_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexache.MustCompile(`^(r-[0-9a-z]{4,32}|ou-[0-9a-z]{4,32}-[0-9a-z]{8,32})$`), "must be an AWS Organizations organizational unit or root ID"),
+				},
+				Description: "List of allowed AWS Organizations organizational unit or root IDs. The account must be in one of the organizational units, or one of their descendants. Requires credentials for the organization's management account or a delegated administrator account.",
+			},
+			"allowed_regions": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString, ValidateFunc: verify.ValidRegionName},
+				ConflictsWith: []string{"forbidden_regions"},
+				Description:   "List of allowed AWS Regions to prevent you from mistakenly using an incorrect one.",
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 			"custom_ca_bundle": {
@@ -65,6 +103,37 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Rules that add, override or suppress default tags for matching resource types. Rules are applied in order.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_keys": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Default tag keys to suppress for matching resource types.",
+									},
+									"resource_types": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validDefaultTagsResourceTypePattern,
+										},
+										Description: "Resource type glob patterns, e.g. `aws_iam_*`, that the rule applies to.",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tags to add or override for matching resource types.",
+									},
+								},
+							},
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -74,6 +143,32 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"deletion_protection": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Description: "Configuration block with settings that prevent the provider from deleting matching resources. " +
+					"Can be overridden by setting the `TF_AWS_DELETION_PROTECTION_OVERRIDE` environment variable to `true`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prevent_destroy_tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tags, e.g. `Protected = \"true\"`, that prevent the resource from being deleted. Tag values are matched case-insensitively.",
+						},
+						"prevent_destroy_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validDefaultTagsResourceTypePattern,
+							},
+							Description: "Resource type glob patterns, e.g. `aws_kms_*`, whose resources cannot be deleted.",
+						},
+					},
+				},
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -93,6 +188,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"allowed_account_ids"},
 			},
+			"forbidden_regions": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString, ValidateFunc: verify.ValidRegionName},
+				ConflictsWith: []string{"allowed_regions"},
+				Description:   "List of forbidden AWS Regions to prevent you from mistakenly using the wrong one.",
+			},
 			"http_proxy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -133,6 +235,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 					"default value is `false`",
 			},
+			"lint_iam_policies": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Statically analyze IAM policy documents when planning resources and report any findings as warnings. " +
+					"If omitted, default value is `false`",
+			},
 			"max_retries": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -158,6 +266,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"required_organization_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^o-[0-9a-z]{10,32}$`), "must be an AWS Organizations organization ID"),
+				Description:  "ID of the AWS Organizations organization that the account must be a member of.",
+			},
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -185,6 +299,33 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_rate_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Client-side limits on the AWS API calls made to individual services, shared by all resources of the service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_in_flight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum number of concurrent API calls to the service.",
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0.01),
+							Description:  "Maximum sustained number of API calls per second to the service.",
+						},
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "Service, using the same keys as the `endpoints` block, e.g. `route53`.",
+						},
+					},
+				},
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -228,6 +369,67 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with tag policy settings evaluated when planning resources that support tags.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_file": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Path to an AWS Organizations tag policy JSON document, such as an account's effective tag policy.",
+						},
+						"rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Tag policy rules for individual tag keys.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_values": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Allowed tag values. A value ending in `*` matches any value with that prefix.",
+									},
+									"enforce_case": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Whether the tag key must have the capitalization specified in `key`.",
+									},
+									names.AttrKey: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+										Description:  "Tag key. Tag keys are matched case-insensitively.",
+									},
+									"required": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Whether resources must have the tag.",
+									},
+									"resource_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validDefaultTagsResourceTypePattern,
+										},
+										Description: "Resource type glob patterns, e.g. `aws_iam_*`, that the rule applies to. Defaults to all resources.",
+									},
+								},
+							},
+						},
+						"severity": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(tftags.PolicySeverity_Values(), false),
+							Description:  "Whether tag policy violations are reported as `error` (the default) or `warning`.",
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -265,7 +467,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 	var errs []error
 	servicePackageMap := make(map[string]conns.ServicePackage)
 
-	for _, sp := range servicePackages(ctx) {
+	for _, sp := range servicePackages {
 		servicePackageName := sp.ServicePackageName()
 		servicePackageMap[servicePackageName] = sp
 
@@ -290,13 +492,29 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResourceType(ctx, typeName), v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
 				}
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when:        Before | Finally,
+					why:         Read,
+					interceptor: telemetryInterceptor{typeName: typeName},
+				},
+			}
+
+			if isRegionOverrideEnabled(servicePackageName, r) {
+				addRegionAttribute(r, true)
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -367,13 +585,41 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResourceType(ctx, typeName), v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
 				}
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when:        Before | Finally,
+					why:         AllOps,
+					interceptor: telemetryInterceptor{typeName: typeName},
+				},
+				{
+					when:        Before,
+					why:         Delete,
+					interceptor: deletionProtectionInterceptor{typeName: typeName},
+				},
+			}
+
+			if isRegionOverrideEnabled(servicePackageName, r) {
+				addRegionAttribute(r, false)
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionInterceptor{},
+				})
+
+				r.CustomizeDiff = regionCustomizeDiff(r.CustomizeDiff)
+				if v := r.Importer; v != nil {
+					if v := v.StateContext; v != nil {
+						r.Importer.StateContext = regionImporter(v)
+					}
+				}
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -408,6 +654,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 						readFunc:   tagsReadFunc,
 					},
 				})
+				r.CustomizeDiff = tagPolicyCustomizeDiff(typeName, r.CustomizeDiff)
+			}
+
+			if paths := iamPolicyAttributePaths(typeName, r.SchemaMap()); len(paths) > 0 {
+				r.CustomizeDiff = iamPolicyLintCustomizeDiff(typeName, paths, r.CustomizeDiff)
 			}
 
 			rs := &wrappedResource{
@@ -482,6 +733,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
 		Endpoints:                      make(map[string]string),
 		Insecure:                       d.Get("insecure").(bool),
+		LintIAMPolicies:                d.Get("lint_iam_policies").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
@@ -514,14 +766,31 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("allowed_ou_ids"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedOUIds = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("allowed_regions"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 {
+		for i, tfMapRaw := range v.([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				tfMap = map[string]interface{}{} // Empty configuration block.
+			}
+
+			assumeRole := expandAssumeRole(ctx, tfMap)
+			config.AssumeRole = append(config.AssumeRole, *assumeRole)
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.hop":             i,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -536,6 +805,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("deletion_protection"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.DeletionProtectionConfig = expandDeletionProtection(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -548,6 +821,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("forbidden_regions"); ok && v.(*schema.Set).Len() > 0 {
+		config.ForbiddenRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("required_organization_id"); ok {
+		config.RequiredOrganizationID = v.(string)
+	}
+
 	if v, ok := d.GetOkExists("http_proxy"); ok {
 		if s, sok := v.(string); sok {
 			config.HTTPProxy = aws.String(s)
@@ -567,6 +848,22 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tagPolicyConfig, err := expandTagPolicy(v.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.TagPolicyConfig = tagPolicyConfig
+	}
+
+	if v, ok := d.GetOk("service_rate_limits"); ok && len(v.([]interface{})) > 0 {
+		serviceRateLimits, err := expandServiceRateLimits(v.([]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.ServiceRateLimits = serviceRateLimits
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -605,9 +902,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "IAM Roles to assume prior to making API calls. Multiple blocks are assumed in order, each using the credentials of the previous role (role chaining).",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -849,9 +1146,141 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
 
+	if v, ok := tfMap["rule"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			rule := tftags.DefaultTagsRule{}
+
+			if v, ok := tfMap["exclude_keys"].(*schema.Set); ok {
+				rule.ExcludeKeys = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["resource_types"].(*schema.Set); ok {
+				rule.ResourceTypes = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["tags"].(map[string]interface{}); ok {
+				rule.Tags = tftags.New(ctx, v)
+			}
+
+			defaultConfig.Rules = append(defaultConfig.Rules, rule)
+		}
+	}
+
 	return defaultConfig
 }
 
+func expandServiceRateLimits(tfList []interface{}) (map[string]conns.ServiceRateLimit, error) {
+	serviceRateLimits := make(map[string]conns.ServiceRateLimit)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		service := tfMap["service"].(string)
+		servicePackageName, err := names.ProviderPackageForAlias(service)
+		if err != nil {
+			return nil, fmt.Errorf("service_rate_limits: %w", err)
+		}
+
+		if _, ok := serviceRateLimits[servicePackageName]; ok {
+			return nil, fmt.Errorf("service_rate_limits: duplicate limits for service %s", service)
+		}
+
+		limit := conns.ServiceRateLimit{
+			MaxInFlight:       tfMap["max_in_flight"].(int),
+			RequestsPerSecond: tfMap["requests_per_second"].(float64),
+		}
+
+		if limit.MaxInFlight == 0 && limit.RequestsPerSecond == 0 {
+			return nil, fmt.Errorf("service_rate_limits: one of max_in_flight or requests_per_second must be set for service %s", service)
+		}
+
+		serviceRateLimits[servicePackageName] = limit
+	}
+
+	return serviceRateLimits, nil
+}
+
+func expandDeletionProtection(tfMap map[string]interface{}) *conns.DeletionProtectionConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	deletionProtectionConfig := &conns.DeletionProtectionConfig{}
+
+	if v, ok := tfMap["prevent_destroy_tags"].(map[string]interface{}); ok && len(v) > 0 {
+		deletionProtectionConfig.Tags = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap["prevent_destroy_types"].(*schema.Set); ok && v.Len() > 0 {
+		deletionProtectionConfig.ResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
+	return deletionProtectionConfig
+}
+
+func expandTagPolicy(tfMap map[string]interface{}) (*tftags.PolicyConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{
+		Severity: tftags.PolicySeverityError,
+	}
+
+	if v, ok := tfMap["severity"].(string); ok && v != "" {
+		policyConfig.Severity = v
+	}
+
+	if v, ok := tfMap["policy_file"].(string); ok && v != "" {
+		document, err := os.ReadFile(v)
+		if err != nil {
+			return nil, fmt.Errorf("reading tag policy file (%s): %w", v, err)
+		}
+
+		rules, err := tftags.ExpandOrganizationsTagPolicy(document)
+		if err != nil {
+			return nil, fmt.Errorf("tag policy file (%s): %w", v, err)
+		}
+
+		policyConfig.Rules = append(policyConfig.Rules, rules...)
+	}
+
+	if v, ok := tfMap["rule"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			rule := tftags.PolicyRule{
+				Key:         tfMap[names.AttrKey].(string),
+				EnforceCase: tfMap["enforce_case"].(bool),
+				Required:    tfMap["required"].(bool),
+			}
+
+			if v, ok := tfMap["allowed_values"].(*schema.Set); ok {
+				rule.AllowedValues = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["resource_types"].(*schema.Set); ok {
+				rule.ResourceTypes = flex.ExpandStringValueSet(v)
+			}
+
+			policyConfig.Rules = append(policyConfig.Rules, rule)
+		}
+	}
+
+	return policyConfig, nil
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	if tfMap == nil {
 		return nil
@@ -5,8 +5,12 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"maps"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -380,3 +384,205 @@ func TestProviderConfig_Authentication_SSO(t *testing.T) { //nolint:paralleltest
 func TestProviderConfig_Authentication_LegacySSO(t *testing.T) { //nolint:paralleltest
 	configtesting.LegacySSO(t, &testDriver{})
 }
+
+// testAssumeRoleSTSServer returns a local stub STS endpoint that implements AssumeRole for the specified IAM Roles.
+// Each role may only be assumed using the credentials of its caller and with the expected request parameters.
+func testAssumeRoleSTSServer(t *testing.T, roles map[string]testAssumeRoleSTSRole) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		role, ok := roles[r.PostForm.Get("RoleArn")]
+		if r.PostForm.Get("Action") != "AssumeRole" || !ok || !strings.Contains(r.Header.Get("Authorization"), "Credential="+role.callerAccessKey+"/") {
+			w.Header().Set("Content-Type", "text/xml")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintf(w, `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+<Error>
+  <Type>Sender</Type>
+  <Code>AccessDenied</Code>
+  <Message>not authorized to perform sts:AssumeRole on resource %s</Message>
+</Error>
+<RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+</ErrorResponse>`, r.PostForm.Get("RoleArn"))
+			return
+		}
+
+		for k, v := range role.expectedParameters {
+			if got := r.PostForm.Get(k); got != v {
+				t.Errorf("AssumeRole (%s) request parameter %s = %q, want %q", r.PostForm.Get("RoleArn"), k, got, v)
+			}
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+<AssumeRoleResult>
+  <AssumedRoleUser>
+    <Arn>%[1]s</Arn>
+    <AssumedRoleId>ARO123EXAMPLE123:session</AssumedRoleId>
+  </AssumedRoleUser>
+  <Credentials>
+    <AccessKeyId>%[2]s</AccessKeyId>
+    <SecretAccessKey>%[2]sSecret</SecretAccessKey>
+    <SessionToken>%[2]sToken</SessionToken>
+    <Expiration>2099-12-31T23:59:59Z</Expiration>
+  </Credentials>
+</AssumeRoleResult>
+<ResponseMetadata>
+  <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+</ResponseMetadata>
+</AssumeRoleResponse>`, r.PostForm.Get("RoleArn"), role.accessKey)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+type testAssumeRoleSTSRole struct {
+	accessKey          string            // Access key returned for the assumed role
+	callerAccessKey    string            // Access key that must be used to assume the role
+	expectedParameters map[string]string // Expected AssumeRole request parameters
+}
+
+func TestProviderConfig_AssumeRoleChain(t *testing.T) { //nolint:paralleltest
+	const (
+		hubRoleARN      = "arn:aws:iam::111111111111:role/hub"      //lintignore:AWSAT005
+		workloadRoleARN = "arn:aws:iam::222222222222:role/workload" //lintignore:AWSAT005
+	)
+	roles := map[string]testAssumeRoleSTSRole{
+		hubRoleARN: {
+			accessKey:       "HubAccessKey",
+			callerAccessKey: servicemocks.MockStaticAccessKey,
+			expectedParameters: map[string]string{
+				"ExternalId":                 "hub-external-id",
+				"RoleSessionName":            "hub-session",
+				"Tags.member.1.Key":          "team",
+				"Tags.member.1.Value":        "platform",
+				"TransitiveTagKeys.member.1": "team",
+			},
+		},
+		workloadRoleARN: {
+			accessKey:       "WorkloadAccessKey",
+			callerAccessKey: "HubAccessKey",
+			expectedParameters: map[string]string{
+				"DurationSeconds": "3600",
+				"ExternalId":      "workload-external-id",
+				"RoleSessionName": "workload-session",
+			},
+		},
+	}
+	hub := map[string]any{
+		"external_id":         "hub-external-id",
+		"role_arn":            hubRoleARN,
+		"session_name":        "hub-session",
+		"tags":                map[string]any{"team": "platform"},
+		"transitive_tag_keys": []any{"team"},
+	}
+	workload := map[string]any{
+		"duration":     "1h",
+		"external_id":  "workload-external-id",
+		"role_arn":     workloadRoleARN,
+		"session_name": "workload-session",
+	}
+
+	testcases := map[string]struct {
+		AssumeRole        []any
+		ExpectedAccessKey string
+		ExpectedError     string
+	}{
+		"single hop": {
+			AssumeRole:        []any{hub},
+			ExpectedAccessKey: "HubAccessKey",
+		},
+		"two hops": {
+			AssumeRole:        []any{hub, workload},
+			ExpectedAccessKey: "WorkloadAccessKey",
+		},
+		"first hop fails": {
+			AssumeRole: []any{
+				map[string]any{"role_arn": "arn:aws:iam::333333333333:role/unknown"}, //lintignore:AWSAT005
+				workload,
+			},
+			ExpectedError: "assume_role[0]",
+		},
+		"second hop fails": {
+			AssumeRole: []any{
+				hub,
+				map[string]any{"role_arn": "arn:aws:iam::333333333333:role/unknown"}, //lintignore:AWSAT005
+			},
+			ExpectedError: "assume_role[1]: assuming IAM Role (arn:aws:iam::333333333333:role/unknown)", //lintignore:AWSAT005
+		},
+		"missing role ARN": {
+			AssumeRole:    []any{hub, map[string]any{"session_name": "workload-session"}},
+			ExpectedError: "assume_role[1]: role_arn is required",
+		},
+		"chained duration too long": {
+			AssumeRole:    []any{hub, map[string]any{"role_arn": workloadRoleARN, "duration": "2h"}},
+			ExpectedError: "assume_role[1] (" + workloadRoleARN + "): duration (2h0m0s) must be at most 1h0m0s",
+		},
+	}
+
+	for name, tc := range testcases { //nolint:paralleltest
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			servicemocks.InitSessionTestEnv(t)
+
+			server := testAssumeRoleSTSServer(t, roles)
+			t.Setenv("AWS_ENDPOINT_URL_STS", server.URL)
+
+			config := map[string]any{
+				"access_key":                  servicemocks.MockStaticAccessKey,
+				"assume_role":                 tc.AssumeRole,
+				"region":                      "us-west-2", //lintignore:AWSAT003
+				"secret_key":                  servicemocks.MockStaticSecretKey,
+				"skip_credentials_validation": true,
+				"skip_requesting_account_id":  true,
+			}
+
+			rc := terraformsdk.NewResourceConfigRaw(config)
+
+			p, err := New(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var diags diag.Diagnostics
+			diags = append(diags, p.Validate(rc)...)
+			if diags.HasError() {
+				t.Fatalf("validating: %s", sdkdiag.DiagnosticsString(diags))
+			}
+
+			diags = append(diags, p.Configure(ctx, rc)...)
+
+			if tc.ExpectedError != "" {
+				if !diags.HasError() {
+					t.Fatalf("expected error containing %q, got none", tc.ExpectedError)
+				}
+				if got := sdkdiag.DiagnosticsString(diags); !strings.Contains(got, tc.ExpectedError) {
+					t.Fatalf("expected error containing %q, got %s", tc.ExpectedError, got)
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("configuring: %s", sdkdiag.DiagnosticsString(diags))
+			}
+
+			meta := p.Meta().(*conns.AWSClient)
+			credentials, err := meta.CredentialsProvider(ctx).Retrieve(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := credentials.AccessKeyID, tc.ExpectedAccessKey; got != want {
+				t.Errorf("AccessKeyID = %q, want %q", got, want)
+			}
+		})
+	}
+}
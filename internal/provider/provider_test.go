@@ -12,6 +12,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -235,3 +236,85 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandServiceRateLimits(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         []interface{}
+		expected      map[string]conns.ServiceRateLimit
+		expectedError string
+	}{
+		"valid": {
+			input: []interface{}{
+				map[string]interface{}{
+					"max_in_flight":       2,
+					"requests_per_second": 4.0,
+					"service":             "ec2",
+				},
+			},
+			expected: map[string]conns.ServiceRateLimit{
+				names.EC2: {MaxInFlight: 2, RequestsPerSecond: 4},
+			},
+		},
+		"unknown service": {
+			input: []interface{}{
+				map[string]interface{}{
+					"max_in_flight":       1,
+					"requests_per_second": 0.0,
+					"service":             "not-a-service",
+				},
+			},
+			expectedError: "unable to find service",
+		},
+		"duplicate service": {
+			input: []interface{}{
+				map[string]interface{}{
+					"max_in_flight":       1,
+					"requests_per_second": 0.0,
+					"service":             "ec2",
+				},
+				map[string]interface{}{
+					"max_in_flight":       2,
+					"requests_per_second": 0.0,
+					"service":             "ec2",
+				},
+			},
+			expectedError: "duplicate limits for service ec2",
+		},
+		"no limit": {
+			input: []interface{}{
+				map[string]interface{}{
+					"max_in_flight":       0,
+					"requests_per_second": 0.0,
+					"service":             "ec2",
+				},
+			},
+			expectedError: "one of max_in_flight or requests_per_second must be set",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandServiceRateLimits(testCase.input)
+
+			if testCase.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error containing %q, got %v", testCase.expectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
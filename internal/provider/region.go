@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// isRegionOverrideEnabled returns whether the per-resource `region` attribute is injected into the specified resource's schema.
+// Resources in global services and resources that already define a `region` attribute are excluded.
+func isRegionOverrideEnabled(servicePackageName string, r *schema.Resource) bool {
+	if names.IsGlobalService(servicePackageName) {
+		return false
+	}
+
+	if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+		return false
+	}
+
+	return true
+}
+
+// addRegionAttribute adds the per-resource `region` attribute to the specified resource's schema.
+func addRegionAttribute(r *schema.Resource, isDataSource bool) {
+	attr := &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region in which this resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+	if isDataSource {
+		attr.Description = "The AWS Region in which this data source is read. Defaults to the Region set in the provider configuration."
+	} else {
+		attr.ForceNew = true
+	}
+
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			s := f()
+			s[names.AttrRegion] = attr
+			return s
+		}
+	} else {
+		r.Schema[names.AttrRegion] = attr
+	}
+}
+
+// regionInterceptor implements the per-resource `region` attribute for data sources and resources.
+// The AWS Region is placed in Context so that AWS API clients for that Region are used.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+			if err := c.VerifyRegionAllowed(v); err != nil {
+				return ctx, sdkdiag.AppendFromErr(diags, err)
+			}
+			ctx = conns.NewRegionContext(ctx, v)
+		}
+	case After:
+		switch why {
+		case Read:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			fallthrough
+		case Create, Update:
+			if err := d.Set(names.AttrRegion, c.RegionForContext(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// regionCustomizeDiff plans the per-resource `region` attribute before calling any resource-specific CustomizeDiff function.
+// If no AWS Region is configured the provider's configured Region is used.
+func regionCustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		c, ok := meta.(*conns.AWSClient)
+		if !ok {
+			if f == nil {
+				return nil
+			}
+			return f(ctx, d, meta)
+		}
+
+		if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() && rawConfig.GetAttr(names.AttrRegion).IsNull() {
+			// Resources created before the attribute was introduced have no Region in state until refreshed.
+			if v := d.Get(names.AttrRegion).(string); d.Id() == "" || (v != "" && v != c.Region) {
+				if err := d.SetNew(names.AttrRegion, c.Region); err != nil {
+					return err
+				}
+			}
+		}
+
+		if v := d.Get(names.AttrRegion).(string); v != "" {
+			if err := c.VerifyRegionAllowed(v); err != nil {
+				return err
+			}
+		}
+
+		if f == nil {
+			return nil
+		}
+
+		if v := d.Get(names.AttrRegion).(string); v != "" {
+			ctx = conns.NewRegionContext(ctx, v)
+		}
+
+		return f(ctx, d, meta)
+	}
+}
+
+// regionImporter handles import IDs with an `@<region>` suffix.
+// The suffix is removed before the resource's own importer is called.
+func regionImporter(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if id, region, ok := conns.SplitImportIDRegion(d.Id()); ok {
+			d.SetId(id)
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return nil, err
+			}
+			ctx = conns.NewRegionContext(ctx, region)
+		}
+
+		return f(ctx, d, meta)
+	}
+}
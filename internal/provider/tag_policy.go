@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// tagPolicyViolations evaluates the provider's tag policy against a resource's planned tags.
+// No violations are returned if the planned tags are not yet known.
+func tagPolicyViolations(ctx context.Context, d interface {
+	Get(string) any
+	GetRawPlan() cty.Value
+}, meta any, typeName string) (*tftags.PolicyConfig, []string) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok || c.TagPolicyConfig == nil {
+		return nil, nil
+	}
+
+	if plan := d.GetRawPlan(); plan.IsNull() || !plan.GetAttr(names.AttrTags).IsWhollyKnown() {
+		return c.TagPolicyConfig, nil
+	}
+
+	resourceTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))
+	allTags := c.DefaultTagsConfigForContext(ctx).MergeTags(resourceTags).IgnoreAWS()
+	if tagsInContext, ok := tftags.FromContext(ctx); ok {
+		allTags = allTags.IgnoreConfig(tagsInContext.IgnoreConfig)
+	}
+
+	return c.TagPolicyConfig, c.TagPolicyConfig.Violations(typeName, allTags)
+}
+
+// tagPolicyCustomizeDiff evaluates the provider's tag policy after calling any resource-specific CustomizeDiff function.
+// Violations fail the plan unless the policy's severity is `warning`, in which case they are reported as plan warnings.
+// Warnings are only written to the provider log if CustomizeDiff is not called while planning, e.g. in unit tests.
+func tagPolicyCustomizeDiff(typeName string, f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		if f != nil {
+			if err := f(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+
+		policyConfig, violations := tagPolicyViolations(ctx, d, meta, typeName)
+		if len(violations) == 0 {
+			return nil
+		}
+
+		if policyConfig.IsWarning() {
+			for _, v := range violations {
+				if !addPlanWarning(ctx, names.AttrTags, "Tag policy violation", v) {
+					tflog.Warn(ctx, "Tag policy violation", map[string]any{
+						"tf_aws.tag_policy.violation": v,
+					})
+				}
+			}
+
+			return nil
+		}
+
+		return errors.Join(tfslices.ApplyToAll(violations, func(v string) error {
+			return fmt.Errorf("tag policy violation: %s", v)
+		})...)
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpandTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	policyFile := filepath.Join(t.TempDir(), "policy.json")
+	if err := os.WriteFile(policyFile, []byte(`{"tags": {"costcenter": {"tag_key": {"@@assign": "CostCenter"}}}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := expandTagPolicy(map[string]interface{}{
+		"policy_file": policyFile,
+		"rule": []interface{}{
+			map[string]interface{}{
+				"allowed_values": schema.NewSet(schema.HashString, []interface{}{"production"}),
+				"enforce_case":   false,
+				names.AttrKey:    "Environment",
+				"required":       true,
+				"resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_instance"}),
+			},
+		},
+		"severity": "warning",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &tftags.PolicyConfig{
+		Severity: tftags.PolicySeverityWarning,
+		Rules: []tftags.PolicyRule{
+			{
+				Key:         "CostCenter",
+				EnforceCase: true,
+			},
+			{
+				Key:           "Environment",
+				Required:      true,
+				AllowedValues: []string{"production"},
+				ResourceTypes: []string{"aws_instance"},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if _, err := expandTagPolicy(map[string]interface{}{
+		"policy_file": filepath.Join(t.TempDir(), "missing.json"),
+	}); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestTagPolicyCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: tagPolicyCustomizeDiff("aws_test", nil),
+	}
+	rules := []tftags.PolicyRule{
+		{
+			Key:      "CostCenter",
+			Required: true,
+		},
+	}
+
+	testCases := map[string]struct {
+		policyConfig *tftags.PolicyConfig
+		defaultTags  map[string]string
+		tags         map[string]string
+		expectedErr  string
+	}{
+		"no policy": {},
+		"compliant": {
+			policyConfig: &tftags.PolicyConfig{Severity: tftags.PolicySeverityError, Rules: rules},
+			tags: map[string]string{
+				"CostCenter": "1234",
+			},
+		},
+		"compliant default tags": {
+			policyConfig: &tftags.PolicyConfig{Severity: tftags.PolicySeverityError, Rules: rules},
+			defaultTags: map[string]string{
+				"CostCenter": "1234",
+			},
+		},
+		"error": {
+			policyConfig: &tftags.PolicyConfig{Severity: tftags.PolicySeverityError, Rules: rules},
+			tags: map[string]string{
+				"Name": "test",
+			},
+			expectedErr: `tag policy violation: tag "CostCenter" is required`,
+		},
+		"warning": {
+			policyConfig: &tftags.PolicyConfig{Severity: tftags.PolicySeverityWarning, Rules: rules},
+			tags: map[string]string{
+				"Name": "test",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			meta := &conns.AWSClient{
+				DefaultTagsConfig: &tftags.DefaultConfig{Tags: tftags.New(ctx, testCase.defaultTags)},
+				TagPolicyConfig:   testCase.policyConfig,
+			}
+			config := map[string]interface{}{}
+			tags := cty.MapValEmpty(cty.String)
+			if len(testCase.tags) > 0 {
+				m := make(map[string]cty.Value)
+				for k, v := range testCase.tags {
+					config[k] = v
+					m[k] = cty.StringVal(v)
+				}
+				tags = cty.MapVal(m)
+			}
+			state := &terraform.InstanceState{
+				RawPlan: cty.ObjectVal(map[string]cty.Value{
+					"id":       cty.NullVal(cty.String),
+					"tags":     tags,
+					"tags_all": cty.UnknownVal(cty.Map(cty.String)),
+				}),
+			}
+
+			_, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(map[string]interface{}{"tags": config}), meta)
+
+			if testCase.expectedErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), testCase.expectedErr) {
+				t.Errorf("got error %v, want %q", err, testCase.expectedErr)
+			}
+		})
+	}
+}
+
+func TestTagPolicyCustomizeDiff_planWarnings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: tagPolicyCustomizeDiff("aws_test", nil),
+	}
+
+	w := &planWarnings{}
+	ctx = context.WithValue(ctx, planWarningsKey, w)
+
+	meta := &conns.AWSClient{
+		DefaultTagsConfig: &tftags.DefaultConfig{},
+		TagPolicyConfig: &tftags.PolicyConfig{
+			Severity: tftags.PolicySeverityWarning,
+			Rules: []tftags.PolicyRule{
+				{
+					Key:      "CostCenter",
+					Required: true,
+				},
+			},
+		},
+	}
+	state := &terraform.InstanceState{
+		RawPlan: cty.ObjectVal(map[string]cty.Value{
+			"id":       cty.NullVal(cty.String),
+			"tags":     cty.MapVal(map[string]cty.Value{"Name": cty.StringVal("test")}),
+			"tags_all": cty.UnknownVal(cty.Map(cty.String)),
+		}),
+	}
+
+	if _, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(map[string]interface{}{"tags": map[string]interface{}{"Name": "test"}}), meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(w.diags), 1; got != want {
+		t.Fatalf("number of plan warnings = %v, want %v", got, want)
+	}
+	if got, want := w.diags[0].Severity, tfprotov5.DiagnosticSeverityWarning; got != want {
+		t.Errorf("severity = %v, want %v", got, want)
+	}
+	if got, want := w.diags[0].Detail, `tag "CostCenter" is required`; got != want {
+		t.Errorf("detail = %q, want %q", got, want)
+	}
+}
@@ -107,6 +107,66 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}
 }
 
+func TestTagsResourceInterceptor_updateError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		beforeError     bool
+		expectedUpdated bool
+	}{
+		"Update error": {
+			expectedUpdated: true,
+		},
+		"Before error": {
+			beforeError:     true,
+			expectedUpdated: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var updated bool
+			tags := tagsResourceInterceptor{
+				tags: &types.ServicePackageResourceTags{
+					IdentifierAttribute: "id",
+				},
+				updateFunc: func(ctx context.Context, d schemaResourceData, sp conns.ServicePackage, spt *types.ServicePackageResourceTags, serviceName, resourceName string, meta any, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+					updated = true
+					return ctx, diags
+				},
+				readFunc: func(ctx context.Context, d schemaResourceData, sp conns.ServicePackage, spt *types.ServicePackageResourceTags, serviceName, resourceName string, meta any, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+					return ctx, diags
+				},
+			}
+
+			conn := &conns.AWSClient{
+				ServicePackages: map[string]conns.ServicePackage{
+					"Test": &mockService{},
+				},
+			}
+
+			ctx := conns.NewResourceContext(context.Background(), "Test", "aws_test")
+			ctx = tftags.NewContext(ctx, nil, nil)
+			if testCase.beforeError {
+				ctx = context.WithValue(ctx, contextKeyBeforeError, true)
+			}
+
+			// The resource's tags have changed and its Update method, or a Before interceptor, has errored.
+			diags := diag.Errorf("Update error")
+			_, diags = tags.run(ctx, &resourceData{}, conn, Finally, Update, diags)
+
+			if got, want := len(diags), 1; got != want {
+				t.Errorf("length of diags = %v, want %v", got, want)
+			}
+			if got, want := updated, testCase.expectedUpdated; got != want {
+				t.Errorf("tags updated = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
 type resourceData struct{}
 
 func (d *resourceData) GetRawConfig() cty.Value {
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// telemetryInterceptor records client-side metrics (and optionally a trace span) for each CRUD operation.
+// It should be the first interceptor so that AWS API calls made by all other interceptors are included.
+type telemetryInterceptor struct {
+	typeName string
+}
+
+func (r telemetryInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		ctx = c.Telemetry().StartResourceOperation(ctx, r.typeName, telemetryOperation(why))
+	case Finally:
+		// Finally is also run if a later Before interceptor errors.
+		c.Telemetry().EndResourceOperation(ctx, diags.HasError())
+	}
+
+	return ctx, diags
+}
+
+func telemetryOperation(why why) string {
+	switch why {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	default:
+		return ""
+	}
+}
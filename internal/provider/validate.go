@@ -9,6 +9,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 // validAssumeRoleDuration validates a string can be parsed as a valid time.Duration
@@ -37,3 +38,18 @@ var validAssumeRoleSourceIdentity = validation.All(
 	validation.StringLenBetween(2, 64),
 	validation.StringMatch(regexache.MustCompile(`[\w+=,.@\-]*`), ""),
 )
+
+func validDefaultTagsResourceTypePattern(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q cannot be empty", k))
+		return
+	}
+
+	if err := tftags.ValidResourceTypePattern(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid resource type pattern: %w", k, value, err))
+	}
+
+	return
+}
@@ -73,3 +73,43 @@ func TestValidAssumeRoleDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestValidDefaultTagsResourceTypePattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		val         interface{}
+		expectedErr *regexp.Regexp
+	}{
+		{
+			val:         "",
+			expectedErr: regexache.MustCompile(`cannot be empty`),
+		},
+		{
+			val:         "aws_[instance",
+			expectedErr: regexache.MustCompile(`is not a valid resource type pattern`),
+		},
+		{
+			val: "aws_instance",
+		},
+		{
+			val: "aws_iam_*",
+		},
+	}
+
+	for i, tc := range testCases {
+		_, errs := validDefaultTagsResourceTypePattern(tc.val, "test_property")
+
+		if len(errs) == 0 && tc.expectedErr == nil {
+			continue
+		}
+
+		if len(errs) != 0 && tc.expectedErr == nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+		}
+
+		if len(errs) == 0 || !tc.expectedErr.MatchString(errs[0].Error()) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
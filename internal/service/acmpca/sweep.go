@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_acmpca_certificate_authority", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_acmpca_certificate_authority", &resource.Sweeper{
 		Name: "aws_acmpca_certificate_authority",
 		F:    sweepCertificateAuthorities,
 	})
@@ -58,7 +58,7 @@ func sweepCertificateAuthorities(region string) error {
 			d.SetId(arn)
 			d.Set("permanent_deletion_time_in_days", 7) //nolint:mnd // 7 days is the default value
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithPassthroughImportID()))
 		}
 	}
 
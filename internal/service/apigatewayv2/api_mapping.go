@@ -165,6 +165,11 @@ func resourceAPIMappingImport(ctx context.Context, d *schema.ResourceData, meta
 	return []*schema.ResourceData{d}, nil
 }
 
+// apiMappingImportID returns the ID with which the API mapping described by d is imported, 'api-mapping-id/domain-name'.
+func apiMappingImportID(d *schema.ResourceData) (string, error) {
+	return d.Id() + "/" + d.Get(names.AttrDomainName).(string), nil
+}
+
 func findAPIMappingByTwoPartKey(ctx context.Context, conn *apigatewayv2.Client, id, domainName string) (*apigatewayv2.GetApiMappingOutput, error) {
 	input := &apigatewayv2.GetApiMappingInput{
 		ApiMappingId: aws.String(id),
@@ -406,6 +406,17 @@ func resourcePolicyImport(ctx context.Context, d *schema.ResourceData, meta inte
 	return []*schema.ResourceData{d}, nil
 }
 
+// policyImportID returns the ID with which the scaling policy described by d is imported,
+// 'service-namespace/resource-id/scalable-dimension/policy-name'.
+func policyImportID(d *schema.ResourceData) (string, error) {
+	return strings.Join([]string{
+		d.Get("service_namespace").(string),
+		d.Get(names.AttrResourceID).(string),
+		d.Get("scalable_dimension").(string),
+		d.Get(names.AttrName).(string),
+	}, "/"), nil
+}
+
 func findScalingPolicyByFourPartKey(ctx context.Context, conn *applicationautoscaling.Client, name, serviceNamespace, resourceID, scalableDimension string) (*awstypes.ScalingPolicy, error) {
 	input := &applicationautoscaling.DescribeScalingPoliciesInput{
 		PolicyNames:       []string{name},
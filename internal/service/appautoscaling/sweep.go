@@ -70,7 +70,7 @@ func sweepPolicy(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepabl
 				d.Set("scalable_dimension", policies.ScalableDimension)
 				d.Set("service_namespace", policies.ServiceNamespace)
 
-				sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client, sdk.WithImportID(policyImportID)))
 			}
 		}
 	}
@@ -115,7 +115,7 @@ func sweepTarget(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepabl
 				d.Set("scalable_dimension", target.ScalableDimension)
 				d.Set("service_namespace", target.ServiceNamespace)
 
-				sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client, sdk.WithImportID(targetImportID)))
 			}
 		}
 	}
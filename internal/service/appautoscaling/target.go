@@ -262,6 +262,16 @@ func resourceTargetImport(ctx context.Context, d *schema.ResourceData, meta inte
 	return []*schema.ResourceData{d}, nil
 }
 
+// targetImportID returns the ID with which the scalable target described by d is imported,
+// 'service-namespace/resource-id/scalable-dimension'.
+func targetImportID(d *schema.ResourceData) (string, error) {
+	return strings.Join([]string{
+		d.Get("service_namespace").(string),
+		d.Id(),
+		d.Get("scalable_dimension").(string),
+	}, "/"), nil
+}
+
 func registerScalableTarget(ctx context.Context, conn *applicationautoscaling.Client, input *applicationautoscaling.RegisterScalableTargetInput) error {
 	_, err := tfresource.RetryWhen(ctx, propagationTimeout,
 		func() (interface{}, error) {
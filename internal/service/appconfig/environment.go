@@ -6,7 +6,6 @@ package appconfig
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -17,7 +16,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -32,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -40,12 +39,14 @@ import (
 // @Tags(identifierAttribute="arn")
 func newResourceEnvironment(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceEnvironment{}
+	r.SetIdentity(environmentIdentity)
 
 	return r, nil
 }
 
 type resourceEnvironment struct {
 	framework.ResourceWithConfigure
+	framework.WithImportByIdentity
 }
 
 func (r *resourceEnvironment) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -298,21 +299,12 @@ func (r *resourceEnvironment) Delete(ctx context.Context, request resource.Delet
 	}
 }
 
-func (r *resourceEnvironment) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	parts := strings.Split(request.ID, ":")
-	if len(parts) != 2 {
-		response.Diagnostics.AddError("Resource Import Invalid ID", fmt.Sprintf(`Unexpected format for import ID (%s), use: "EnvironmentID:ApplicationID"`, request.ID))
-		return
-	}
-
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("environment_id"), parts[0])...)
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrApplicationID), parts[1])...)
-}
-
 func (r *resourceEnvironment) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, request, response)
 }
 
+var environmentIdentity = identity.New(":", "environment_id", names.AttrApplicationID)
+
 type resourceEnvironmentData struct {
 	ApplicationID types.String `tfsdk:"application_id"`
 	ARN           types.String `tfsdk:"arn"`
@@ -337,10 +329,10 @@ func (d *resourceEnvironmentData) refreshFromCreateOutput(ctx context.Context, m
 	envID := aws.ToString(out.Id)
 
 	d.ApplicationID = types.StringValue(appID)
-	d.ARN = types.StringValue(environmentARN(meta, aws.ToString(out.ApplicationId), aws.ToString(out.Id)).String())
+	d.ARN = types.StringValue(environmentARN(ctx, meta, aws.ToString(out.ApplicationId), aws.ToString(out.Id)).String())
 	d.Description = flex.StringToFrameworkLegacy(ctx, out.Description)
 	d.EnvironmentID = types.StringValue(envID)
-	d.ID = types.StringValue(fmt.Sprintf("%s:%s", envID, appID))
+	d.ID = types.StringValue(environmentIdentity.ID(envID, appID))
 	d.Monitors = flattenMonitors(ctx, out.Monitors, &diags)
 	d.Name = flex.StringToFramework(ctx, out.Name)
 	d.State = flex.StringValueToFramework(ctx, out.State)
@@ -359,10 +351,10 @@ func (d *resourceEnvironmentData) refreshFromGetOutput(ctx context.Context, meta
 	envID := aws.ToString(out.Id)
 
 	d.ApplicationID = types.StringValue(appID)
-	d.ARN = types.StringValue(environmentARN(meta, aws.ToString(out.ApplicationId), aws.ToString(out.Id)).String())
+	d.ARN = types.StringValue(environmentARN(ctx, meta, aws.ToString(out.ApplicationId), aws.ToString(out.Id)).String())
 	d.Description = flex.StringToFrameworkLegacy(ctx, out.Description)
 	d.EnvironmentID = types.StringValue(envID)
-	d.ID = types.StringValue(fmt.Sprintf("%s:%s", envID, appID))
+	d.ID = types.StringValue(environmentIdentity.ID(envID, appID))
 	d.Monitors = flattenMonitors(ctx, out.Monitors, &diags)
 	d.Name = flex.StringToFramework(ctx, out.Name)
 	d.State = flex.StringValueToFramework(ctx, out.State)
@@ -381,10 +373,10 @@ func (d *resourceEnvironmentData) refreshFromUpdateOutput(ctx context.Context, m
 	envID := aws.ToString(out.Id)
 
 	d.ApplicationID = types.StringValue(appID)
-	d.ARN = types.StringValue(environmentARN(meta, aws.ToString(out.ApplicationId), aws.ToString(out.Id)).String())
+	d.ARN = types.StringValue(environmentARN(ctx, meta, aws.ToString(out.ApplicationId), aws.ToString(out.Id)).String())
 	d.Description = flex.StringToFrameworkLegacy(ctx, out.Description)
 	d.EnvironmentID = types.StringValue(envID)
-	d.ID = types.StringValue(fmt.Sprintf("%s:%s", envID, appID))
+	d.ID = types.StringValue(environmentIdentity.ID(envID, appID))
 	d.Monitors = flattenMonitors(ctx, out.Monitors, &diags)
 	d.Name = flex.StringToFramework(ctx, out.Name)
 	d.State = flex.StringValueToFramework(ctx, out.State)
@@ -413,11 +405,11 @@ func (d *resourceEnvironmentData) deleteEnvironmentInput() *appconfig.DeleteEnvi
 	}
 }
 
-func environmentARN(meta *conns.AWSClient, appID, envID string) arn.ARN {
+func environmentARN(ctx context.Context, meta *conns.AWSClient, appID, envID string) arn.ARN {
 	return arn.ARN{
 		AccountID: meta.AccountID,
 		Partition: meta.Partition,
-		Region:    meta.Region,
+		Region:    meta.RegionForContext(ctx),
 		Resource:  fmt.Sprintf("application/%s/environment/%s", appID, envID),
 		Service:   "appconfig",
 	}
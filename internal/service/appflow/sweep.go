@@ -44,7 +44,7 @@ func sweepInstanceProfile(ctx context.Context, client *conns.AWSClient) ([]sweep
 			d.SetId(aws.ToString(flow.FlowArn))
 			d.Set(names.AttrName, flow.FlowName)
 
-			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client, sdk.WithPassthroughImportID()))
 		}
 	}
 
@@ -17,12 +17,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_appmesh_gateway_route", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_gateway_route", &resource.Sweeper{
 		Name: "aws_appmesh_gateway_route",
 		F:    sweepGatewayRoutes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_mesh", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_mesh", &resource.Sweeper{
 		Name: "aws_appmesh_mesh",
 		F:    sweepMeshes,
 		Dependencies: []string{
@@ -33,12 +33,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_route", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_route", &resource.Sweeper{
 		Name: "aws_appmesh_route",
 		F:    sweepRoutes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_gateway", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_gateway",
 		F:    sweepVirtualGateways,
 		Dependencies: []string{
@@ -46,12 +46,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_node", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_node", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_node",
 		F:    sweepVirtualNodes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_router",
 		F:    sweepVirtualRouters,
 		Dependencies: []string{
@@ -59,7 +59,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_service", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_service",
 		F:    sweepVirtualServices,
 	})
@@ -144,7 +144,7 @@ func sweepVirtualGateways(region string) error {
 					d.Set("mesh_name", meshName)
 					d.Set(names.AttrName, virtualGatewayName)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithPassthroughImportID()))
 				}
 
 				return !lastPage
@@ -215,7 +215,7 @@ func sweepVirtualNodes(region string) error {
 					d.Set("mesh_name", meshName)
 					d.Set(names.AttrName, virtualNodeName)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithPassthroughImportID()))
 				}
 
 				return !lastPage
@@ -286,7 +286,7 @@ func sweepVirtualRouters(region string) error {
 					d.Set("mesh_name", meshName)
 					d.Set(names.AttrName, virtualRouterName)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithPassthroughImportID()))
 				}
 
 				return !lastPage
@@ -357,7 +357,7 @@ func sweepVirtualServices(region string) error {
 					d.Set("mesh_name", meshName)
 					d.Set(names.AttrName, virtualServiceName)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithPassthroughImportID()))
 				}
 
 				return !lastPage
@@ -441,7 +441,7 @@ func sweepGatewayRoutes(region string) error {
 							d.Set(names.AttrName, gatewayRouteName)
 							d.Set("virtual_gateway_name", virtualGatewayName)
 
-							sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+							sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithPassthroughImportID()))
 						}
 
 						return !lastPage
@@ -537,7 +537,7 @@ func sweepRoutes(region string) error {
 							d.Set(names.AttrName, routeName)
 							d.Set("virtual_router_name", virtualRouterName)
 
-							sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+							sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithPassthroughImportID()))
 						}
 
 						return !lastPage
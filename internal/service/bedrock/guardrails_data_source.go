@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Guardrails")
+func newGuardrailsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &guardrailsDataSource{}, nil
+}
+
+type guardrailsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *guardrailsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_bedrock_guardrails"
+}
+
+func (d *guardrailsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"guardrail_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"guardrails": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[guardrailSummaryModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[guardrailSummaryModel](ctx),
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (d *guardrailsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data guardrailsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().BedrockClient(ctx)
+
+	// When a guardrail identifier is specified, all versions of that guardrail are listed.
+	input := &bedrock.ListGuardrailsInput{
+		GuardrailIdentifier: fwflex.StringFromFramework(ctx, data.GuardrailID),
+	}
+
+	output, err := findGuardrails(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("listing Bedrock Guardrails", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, &bedrock.ListGuardrailsOutput{Guardrails: output}, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().RegionForContext(ctx))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findGuardrails(ctx context.Context, conn *bedrock.Client, input *bedrock.ListGuardrailsInput) ([]awstypes.GuardrailSummary, error) {
+	var output []awstypes.GuardrailSummary
+
+	pages := bedrock.NewListGuardrailsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Guardrails...)
+	}
+
+	return output, nil
+}
+
+type guardrailsDataSourceModel struct {
+	GuardrailID types.String                                           `tfsdk:"guardrail_id"`
+	Guardrails  fwtypes.ListNestedObjectValueOf[guardrailSummaryModel] `tfsdk:"guardrails"`
+	ID          types.String                                           `tfsdk:"id"`
+}
+
+type guardrailSummaryModel struct {
+	ARN         types.String                                 `tfsdk:"arn"`
+	CreatedAt   timetypes.RFC3339                            `tfsdk:"created_at"`
+	Description types.String                                 `tfsdk:"description"`
+	ID          types.String                                 `tfsdk:"id"`
+	Name        types.String                                 `tfsdk:"name"`
+	Status      fwtypes.StringEnum[awstypes.GuardrailStatus] `tfsdk:"status"`
+	UpdatedAt   timetypes.RFC3339                            `tfsdk:"updated_at"`
+	Version     types.String                                 `tfsdk:"version"`
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockGuardrailsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_bedrock_guardrails.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardrailsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrID),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "guardrails.#", 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBedrockGuardrailsDataSource_versions(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_bedrock_guardrails.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardrailsDataSourceConfig_versions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "guardrails.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "guardrails.*", map[string]string{
+						names.AttrName:    rName,
+						names.AttrVersion: "DRAFT",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "guardrails.*", map[string]string{
+						names.AttrName:    rName,
+						names.AttrVersion: acctest.Ct1,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccGuardrailsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGuardrailConfig_basic(rName), `
+data "aws_bedrock_guardrails" "test" {
+  depends_on = [aws_bedrock_guardrail.test]
+}
+`)
+}
+
+func testAccGuardrailsDataSourceConfig_versions(rName string) string {
+	return acctest.ConfigCompose(testAccGuardrailVersionConfig_basic(rName), `
+data "aws_bedrock_guardrails" "test" {
+  guardrail_id = aws_bedrock_guardrail.test.guardrail_id
+
+  depends_on = [aws_bedrock_guardrail_version.test]
+}
+`)
+}
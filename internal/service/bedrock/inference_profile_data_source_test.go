@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockInferenceProfileDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_bedrock_inference_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInferenceProfileDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, dataSourceName, "inference_profile_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "inference_profile_arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "inference_profile_name"),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "models.#", 0),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrType, "SYSTEM_DEFINED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInferenceProfileDataSourceConfig_basic() string {
+	return `
+data "aws_bedrock_inference_profiles" "test" {
+  type = "SYSTEM_DEFINED"
+}
+
+data "aws_bedrock_inference_profile" "test" {
+  inference_profile_id = data.aws_bedrock_inference_profiles.test.inference_profile_summaries[0].inference_profile_id
+}
+`
+}
@@ -32,6 +32,22 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newFoundationModelsDataSource,
 			Name:    "Foundation Models",
 		},
+		{
+			Factory: newGuardrailDataSource,
+			Name:    "Guardrail",
+		},
+		{
+			Factory: newGuardrailsDataSource,
+			Name:    "Guardrails",
+		},
+		{
+			Factory: newInferenceProfileDataSource,
+			Name:    "Inference Profile",
+		},
+		{
+			Factory: newInferenceProfilesDataSource,
+			Name:    "Inference Profiles",
+		},
 	}
 }
 
@@ -44,6 +60,17 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: "job_arn",
 			},
 		},
+		{
+			Factory: newGuardrailResource,
+			Name:    "Guardrail",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "guardrail_arn",
+			},
+		},
+		{
+			Factory: newGuardrailVersionResource,
+			Name:    "Guardrail Version",
+		},
 		{
 			Factory: newModelInvocationLoggingConfigurationResource,
 			Name:    "Model Invocation Logging Configuration",
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_cloudfront_distributions", name="Distributions")
+func newDistributionsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &distributionsDataSource{}, nil
+}
+
+type distributionsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*distributionsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_cloudfront_distributions"
+}
+
+func (d *distributionsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARNs: schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"domain_names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrIDs: schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrTags: tftags.TagsAttribute(),
+		},
+	}
+}
+
+func (d *distributionsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data distributionsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().CloudFrontClient(ctx)
+
+	var arns, domainNames, ids []string
+	tagsToMatch := tftags.New(ctx, data.Tags)
+
+	pages := cloudfront.NewListDistributionsPaginator(conn, &cloudfront.ListDistributionsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			response.Diagnostics.AddError("listing CloudFront Distributions", err.Error())
+
+			return
+		}
+
+		if page.DistributionList == nil {
+			continue
+		}
+
+		for _, v := range page.DistributionList.Items {
+			distributionARN := aws.ToString(v.ARN)
+
+			if len(tagsToMatch) > 0 {
+				tags, err := listTags(ctx, conn, distributionARN)
+
+				if err != nil {
+					response.Diagnostics.AddError(fmt.Sprintf("listing tags for CloudFront Distribution (%s)", distributionARN), err.Error())
+
+					return
+				}
+
+				if !tags.ContainsAll(tagsToMatch) {
+					continue
+				}
+			}
+
+			arns = append(arns, distributionARN)
+			domainNames = append(domainNames, aws.ToString(v.DomainName))
+			ids = append(ids, aws.ToString(v.Id))
+		}
+	}
+
+	data.ARNs = flex.FlattenFrameworkStringValueListLegacy(ctx, arns)
+	data.DomainNames = flex.FlattenFrameworkStringValueListLegacy(ctx, domainNames)
+	data.ID = types.StringValue(d.Meta().AccountID)
+	data.IDs = flex.FlattenFrameworkStringValueListLegacy(ctx, ids)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type distributionsDataSourceModel struct {
+	ARNs        types.List   `tfsdk:"arns"`
+	DomainNames types.List   `tfsdk:"domain_names"`
+	ID          types.String `tfsdk:"id"`
+	IDs         types.List   `tfsdk:"ids"`
+	Tags        types.Map    `tfsdk:"tags"`
+}
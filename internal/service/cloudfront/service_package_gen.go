@@ -20,6 +20,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newDataSourceOriginAccessControl,
 			Name:    "Origin Access Control",
 		},
+		{
+			Factory: newDistributionsDataSource,
+			Name:    "Distributions",
+		},
 	}
 }
 
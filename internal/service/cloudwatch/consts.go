@@ -30,3 +30,101 @@ func missingData_Values() []string {
 		missingDataNotBreaching,
 	}
 }
+
+const (
+	dashboardAlarmWidgetSortByDefault               = "default"
+	dashboardAlarmWidgetSortByStateUpdatedTimestamp = "stateUpdatedTimestamp"
+	dashboardAlarmWidgetSortByTimestamp             = "timestamp"
+)
+
+func dashboardAlarmWidgetSortBy_Values() []string {
+	return []string{
+		dashboardAlarmWidgetSortByDefault,
+		dashboardAlarmWidgetSortByStateUpdatedTimestamp,
+		dashboardAlarmWidgetSortByTimestamp,
+	}
+}
+
+const (
+	dashboardAlarmWidgetStateAlarm            = "ALARM"
+	dashboardAlarmWidgetStateInsufficientData = "INSUFFICIENT_DATA"
+	dashboardAlarmWidgetStateOK               = "OK"
+)
+
+func dashboardAlarmWidgetState_Values() []string {
+	return []string{
+		dashboardAlarmWidgetStateAlarm,
+		dashboardAlarmWidgetStateInsufficientData,
+		dashboardAlarmWidgetStateOK,
+	}
+}
+
+const (
+	dashboardLogWidgetViewBar        = "bar"
+	dashboardLogWidgetViewPie        = "pie"
+	dashboardLogWidgetViewTable      = "table"
+	dashboardLogWidgetViewTimeSeries = "timeSeries"
+)
+
+func dashboardLogWidgetView_Values() []string {
+	return []string{
+		dashboardLogWidgetViewBar,
+		dashboardLogWidgetViewPie,
+		dashboardLogWidgetViewTable,
+		dashboardLogWidgetViewTimeSeries,
+	}
+}
+
+const (
+	dashboardMetricWidgetViewBar         = "bar"
+	dashboardMetricWidgetViewGauge       = "gauge"
+	dashboardMetricWidgetViewPie         = "pie"
+	dashboardMetricWidgetViewSingleValue = "singleValue"
+	dashboardMetricWidgetViewTimeSeries  = "timeSeries"
+)
+
+func dashboardMetricWidgetView_Values() []string {
+	return []string{
+		dashboardMetricWidgetViewBar,
+		dashboardMetricWidgetViewGauge,
+		dashboardMetricWidgetViewPie,
+		dashboardMetricWidgetViewSingleValue,
+		dashboardMetricWidgetViewTimeSeries,
+	}
+}
+
+const (
+	dashboardMetricYAxisLeft  = "left"
+	dashboardMetricYAxisRight = "right"
+)
+
+func dashboardMetricYAxis_Values() []string {
+	return []string{
+		dashboardMetricYAxisLeft,
+		dashboardMetricYAxisRight,
+	}
+}
+
+const (
+	dashboardPeriodOverrideAuto    = "auto"
+	dashboardPeriodOverrideInherit = "inherit"
+)
+
+func dashboardPeriodOverride_Values() []string {
+	return []string{
+		dashboardPeriodOverrideAuto,
+		dashboardPeriodOverrideInherit,
+	}
+}
+
+const (
+	dashboardTextWidgetBackgroundSolid       = "solid"
+	dashboardTextWidgetBackgroundTransparent = "transparent"
+)
+
+func dashboardTextWidgetBackground_Values() []string {
+	return []string{
+		dashboardTextWidgetBackgroundSolid,
+		dashboardTextWidgetBackgroundTransparent,
+	}
+}
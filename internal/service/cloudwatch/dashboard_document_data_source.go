@@ -0,0 +1,583 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudwatch_dashboard_document", name="Dashboard Document")
+func dataSourceDashboardDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDashboardDocumentRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			// Alarm, log and metric widgets share the region and title properties.
+			widgetSchema := func(m map[string]*schema.Schema) map[string]*schema.Schema {
+				m[names.AttrRegion] = &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+				}
+				m["title"] = &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+				}
+
+				return m
+			}
+
+			return map[string]*schema.Schema{
+				"end": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					RequiredWith: []string{"start"},
+				},
+				names.AttrJSON: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"minified_json": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"period_override": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(dashboardPeriodOverride_Values(), false),
+				},
+				"start": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"widget": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 500,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"alarm": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: widgetSchema(map[string]*schema.Schema{
+										"alarms": {
+											Type:     schema.TypeList,
+											Required: true,
+											MinItems: 1,
+											MaxItems: 100,
+											Elem: &schema.Schema{
+												Type:         schema.TypeString,
+												ValidateFunc: verify.ValidARN,
+											},
+										},
+										"sort_by": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice(dashboardAlarmWidgetSortBy_Values(), false),
+										},
+										"states": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem: &schema.Schema{
+												Type:         schema.TypeString,
+												ValidateFunc: validation.StringInSlice(dashboardAlarmWidgetState_Values(), false),
+											},
+										},
+									}),
+								},
+							},
+							"height": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      6,
+								ValidateFunc: validation.IntBetween(1, 1000),
+							},
+							"log": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: widgetSchema(map[string]*schema.Schema{
+										"log_group_names": {
+											Type:     schema.TypeList,
+											Required: true,
+											MinItems: 1,
+											MaxItems: 50,
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+										},
+										"query": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"stacked": {
+											Type:     schema.TypeBool,
+											Optional: true,
+										},
+										"view": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice(dashboardLogWidgetView_Values(), false),
+										},
+									}),
+								},
+							},
+							"metric": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: widgetSchema(map[string]*schema.Schema{
+										"metric_query": {
+											Type:     schema.TypeList,
+											Required: true,
+											MinItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													names.AttrAccountID: {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													"color": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													"dimensions": {
+														Type:     schema.TypeMap,
+														Optional: true,
+														Elem:     &schema.Schema{Type: schema.TypeString},
+													},
+													names.AttrExpression: {
+														Type:         schema.TypeString,
+														Optional:     true,
+														ValidateFunc: validation.StringLenBetween(1, 1024),
+													},
+													names.AttrID: {
+														Type:         schema.TypeString,
+														Optional:     true,
+														ValidateFunc: validation.StringLenBetween(1, 255),
+													},
+													"label": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													names.AttrMetricName: {
+														Type:         schema.TypeString,
+														Optional:     true,
+														ValidateFunc: validation.StringLenBetween(1, 255),
+													},
+													names.AttrNamespace: {
+														Type:         schema.TypeString,
+														Optional:     true,
+														ValidateFunc: validation.StringLenBetween(1, 255),
+													},
+													"period": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														ValidateFunc: validation.Any(
+															validation.IntInSlice([]int{1, 5, 10, 30}),
+															validation.IntDivisibleBy(60),
+														),
+													},
+													"stat": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													"visible": {
+														Type:     schema.TypeBool,
+														Optional: true,
+														Default:  true,
+													},
+													"y_axis": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														ValidateFunc: validation.StringInSlice(dashboardMetricYAxis_Values(), false),
+													},
+												},
+											},
+										},
+										"period": {
+											Type:     schema.TypeInt,
+											Optional: true,
+											ValidateFunc: validation.Any(
+												validation.IntInSlice([]int{1, 5, 10, 30}),
+												validation.IntDivisibleBy(60),
+											),
+										},
+										"stacked": {
+											Type:     schema.TypeBool,
+											Optional: true,
+										},
+										"stat": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"view": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice(dashboardMetricWidgetView_Values(), false),
+										},
+									}),
+								},
+							},
+							"text": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"background": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice(dashboardTextWidgetBackground_Values(), false),
+										},
+										"markdown": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+							"width": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      6,
+								ValidateFunc: validation.IntBetween(1, 24),
+							},
+							"x": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntBetween(0, 23),
+							},
+							"y": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+							},
+						},
+					},
+				},
+			}
+		},
+	}
+}
+
+func dataSourceDashboardDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	doc := &dashboardDocument{
+		End:            d.Get("end").(string),
+		PeriodOverride: d.Get("period_override").(string),
+		Start:          d.Get("start").(string),
+		Widgets:        []*dashboardWidget{},
+	}
+
+	for i, v := range d.Get("widget").([]interface{}) {
+		if v == nil {
+			return sdkdiag.AppendErrorf(diags, "writing CloudWatch Dashboard Document: widget %d: exactly one of `alarm`, `log`, `metric` or `text` must be specified", i)
+		}
+
+		widget, err := expandDashboardWidget(v.(map[string]interface{}), meta.(*conns.AWSClient).RegionForContext(ctx))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing CloudWatch Dashboard Document: widget %d: %s", i, err)
+		}
+
+		doc.Widgets = append(doc.Widgets, widget)
+	}
+
+	jsonDoc, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		// should never happen if the above code is correct
+		return sdkdiag.AppendErrorf(diags, "writing CloudWatch Dashboard Document: formatting JSON: %s", err)
+	}
+	jsonString := string(jsonDoc)
+
+	d.Set(names.AttrJSON, jsonString)
+
+	jsonMinDoc, err := json.Marshal(doc)
+	if err != nil {
+		// should never happen if the above code is correct
+		return sdkdiag.AppendErrorf(diags, "writing CloudWatch Dashboard Document: formatting JSON: %s", err)
+	}
+	jsonMinString := string(jsonMinDoc)
+
+	d.Set("minified_json", jsonMinString)
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return diags
+}
+
+// Dashboard body structure.
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html.
+// Fields are declared in alphabetical order so that the emitted JSON is canonical.
+
+type dashboardDocument struct {
+	End            string             `json:"end,omitempty"`
+	PeriodOverride string             `json:"periodOverride,omitempty"`
+	Start          string             `json:"start,omitempty"`
+	Widgets        []*dashboardWidget `json:"widgets"`
+}
+
+type dashboardWidget struct {
+	Height     int         `json:"height"`
+	Properties interface{} `json:"properties"`
+	Type       string      `json:"type"`
+	Width      int         `json:"width"`
+	X          *int        `json:"x,omitempty"`
+	Y          *int        `json:"y,omitempty"`
+}
+
+type dashboardAlarmWidgetProperties struct {
+	Alarms []string `json:"alarms"`
+	SortBy string   `json:"sortBy,omitempty"`
+	States []string `json:"states,omitempty"`
+	Title  string   `json:"title,omitempty"`
+}
+
+type dashboardLogWidgetProperties struct {
+	Query   string `json:"query"`
+	Region  string `json:"region"`
+	Stacked bool   `json:"stacked,omitempty"`
+	Title   string `json:"title,omitempty"`
+	View    string `json:"view,omitempty"`
+}
+
+type dashboardMetricWidgetProperties struct {
+	Metrics [][]interface{} `json:"metrics"`
+	Period  int             `json:"period,omitempty"`
+	Region  string          `json:"region"`
+	Stacked bool            `json:"stacked,omitempty"`
+	Stat    string          `json:"stat,omitempty"`
+	Title   string          `json:"title,omitempty"`
+	View    string          `json:"view,omitempty"`
+}
+
+type dashboardTextWidgetProperties struct {
+	Background string `json:"background,omitempty"`
+	Markdown   string `json:"markdown"`
+}
+
+func expandDashboardWidget(tfMap map[string]interface{}, defaultRegion string) (*dashboardWidget, error) {
+	widget := &dashboardWidget{
+		Height: tfMap["height"].(int),
+		Width:  tfMap["width"].(int),
+	}
+
+	// Omitting both coordinates lets CloudWatch lay the widget out automatically.
+	if x, y := tfMap["x"].(int), tfMap["y"].(int); x != 0 || y != 0 {
+		widget.X, widget.Y = &x, &y
+	}
+
+	var n int
+
+	if v, ok := tfMap["alarm"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		widget.Type = "alarm"
+		widget.Properties = expandDashboardAlarmWidgetProperties(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["log"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		widget.Type = "log"
+		widget.Properties = expandDashboardLogWidgetProperties(v[0].(map[string]interface{}), defaultRegion)
+	}
+
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		properties, err := expandDashboardMetricWidgetProperties(v[0].(map[string]interface{}), defaultRegion)
+
+		if err != nil {
+			return nil, err
+		}
+
+		widget.Type = "metric"
+		widget.Properties = properties
+	}
+
+	if v, ok := tfMap["text"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		widget.Type = "text"
+		widget.Properties = expandDashboardTextWidgetProperties(v[0].(map[string]interface{}))
+	}
+
+	if n != 1 {
+		return nil, fmt.Errorf("exactly one of `alarm`, `log`, `metric` or `text` must be specified")
+	}
+
+	return widget, nil
+}
+
+func expandDashboardAlarmWidgetProperties(tfMap map[string]interface{}) *dashboardAlarmWidgetProperties {
+	return &dashboardAlarmWidgetProperties{
+		Alarms: flex.ExpandStringValueList(tfMap["alarms"].([]interface{})),
+		SortBy: tfMap["sort_by"].(string),
+		States: flex.ExpandStringValueList(tfMap["states"].([]interface{})),
+		Title:  tfMap["title"].(string),
+	}
+}
+
+func expandDashboardLogWidgetProperties(tfMap map[string]interface{}, defaultRegion string) *dashboardLogWidgetProperties {
+	var sources []string
+	for _, v := range flex.ExpandStringValueList(tfMap["log_group_names"].([]interface{})) {
+		sources = append(sources, fmt.Sprintf("SOURCE '%s'", v))
+	}
+
+	return &dashboardLogWidgetProperties{
+		Query:   strings.Join(append(sources, tfMap["query"].(string)), " | "),
+		Region:  dashboardWidgetRegion(tfMap, defaultRegion),
+		Stacked: tfMap["stacked"].(bool),
+		Title:   tfMap["title"].(string),
+		View:    tfMap["view"].(string),
+	}
+}
+
+func expandDashboardMetricWidgetProperties(tfMap map[string]interface{}, defaultRegion string) (*dashboardMetricWidgetProperties, error) {
+	properties := &dashboardMetricWidgetProperties{
+		Metrics: [][]interface{}{},
+		Period:  tfMap["period"].(int),
+		Region:  dashboardWidgetRegion(tfMap, defaultRegion),
+		Stacked: tfMap["stacked"].(bool),
+		Stat:    tfMap["stat"].(string),
+		Title:   tfMap["title"].(string),
+		View:    tfMap["view"].(string),
+	}
+
+	var ids, expressions []string
+	allIDs := true
+
+	for i, v := range tfMap["metric_query"].([]interface{}) {
+		if v == nil {
+			return nil, fmt.Errorf("metric_query %d: one of `expression` or `metric_name` must be specified", i)
+		}
+
+		tfMap := v.(map[string]interface{})
+		expression, metricName := tfMap[names.AttrExpression].(string), tfMap[names.AttrMetricName].(string)
+
+		if (expression == "") == (metricName == "") {
+			return nil, fmt.Errorf("metric_query %d: exactly one of `expression` or `metric_name` must be specified", i)
+		}
+
+		if id := tfMap[names.AttrID].(string); id != "" {
+			if slices.Contains(ids, id) {
+				return nil, fmt.Errorf("metric_query %d: duplicate ID (%s)", i, id)
+			}
+			ids = append(ids, id)
+		} else {
+			allIDs = false
+		}
+
+		if expression != "" {
+			expressions = append(expressions, expression)
+		}
+
+		properties.Metrics = append(properties.Metrics, expandDashboardMetric(tfMap))
+	}
+
+	// CloudWatch assigns IDs to metrics that don't specify one, so references can only be checked when every ID is explicit.
+	if allIDs {
+		for _, expression := range expressions {
+			if err := validMetricMathExpressionReferences(expression, ids); err != nil {
+				return nil, fmt.Errorf("metric_query: %w", err)
+			}
+		}
+	}
+
+	return properties, nil
+}
+
+// expandDashboardMetric returns a metric array in the dashboard body format:
+// [Namespace, MetricName, DimensionName, DimensionValue, ..., {rendering properties}] or [{expression properties}].
+func expandDashboardMetric(tfMap map[string]interface{}) []interface{} {
+	var apiObject []interface{}
+	options := make(map[string]interface{})
+
+	if v, ok := tfMap[names.AttrExpression].(string); ok && v != "" {
+		options[names.AttrExpression] = v
+	} else {
+		apiObject = append(apiObject, tfMap[names.AttrNamespace].(string), tfMap[names.AttrMetricName].(string))
+
+		dimensions := tfMap["dimensions"].(map[string]interface{})
+		keys := make([]string, 0, len(dimensions))
+		for k := range dimensions {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+
+		for _, k := range keys {
+			apiObject = append(apiObject, k, dimensions[k].(string))
+		}
+	}
+
+	if v, ok := tfMap[names.AttrAccountID].(string); ok && v != "" {
+		options["accountId"] = v
+	}
+
+	if v, ok := tfMap["color"].(string); ok && v != "" {
+		options["color"] = v
+	}
+
+	if v, ok := tfMap[names.AttrID].(string); ok && v != "" {
+		options[names.AttrID] = v
+	}
+
+	if v, ok := tfMap["label"].(string); ok && v != "" {
+		options["label"] = v
+	}
+
+	if v, ok := tfMap["period"].(int); ok && v != 0 {
+		options["period"] = v
+	}
+
+	if v, ok := tfMap["stat"].(string); ok && v != "" {
+		options["stat"] = v
+	}
+
+	if v, ok := tfMap["visible"].(bool); ok && !v {
+		options["visible"] = v
+	}
+
+	if v, ok := tfMap["y_axis"].(string); ok && v != "" {
+		options["yAxis"] = v
+	}
+
+	if len(options) > 0 {
+		apiObject = append(apiObject, options)
+	}
+
+	return apiObject
+}
+
+func expandDashboardTextWidgetProperties(tfMap map[string]interface{}) *dashboardTextWidgetProperties {
+	return &dashboardTextWidgetProperties{
+		Background: tfMap["background"].(string),
+		Markdown:   tfMap["markdown"].(string),
+	}
+}
+
+func dashboardWidgetRegion(tfMap map[string]interface{}, defaultRegion string) string {
+	if v, ok := tfMap[names.AttrRegion].(string); ok && v != "" {
+		return v
+	}
+
+	return defaultRegion
+}
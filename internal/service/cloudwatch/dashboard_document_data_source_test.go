@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchDashboardDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccDashboardDocumentExpectedJSON()),
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", testAccDashboardDocumentExpectedJSON()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_undefinedMetricReference(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_undefinedMetricReference,
+				ExpectError: regexache.MustCompile(`references undefined ID\(s\): m2`),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_alarm(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricAlarmDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_alarm(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "minified_json", regexache.MustCompile(`"properties":\{"alarms":\["arn:[^"]+:alarm:`+rName+`"\],"sortBy":"stateUpdatedTimestamp","states":\["ALARM","INSUFFICIENT_DATA"\],"title":"Alarms"\},"type":"alarm"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_dashboard(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_dashboard.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_dashboard(rName),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "dashboard_body", testAccDashboardDocumentExpectedJSON()),
+				),
+			},
+		},
+	})
+}
+
+func testAccDashboardDocumentExpectedJSON() string {
+	return fmt.Sprintf(`{"periodOverride":"inherit","start":"-PT6H","widgets":[{"height":2,"properties":{"background":"transparent","markdown":"# Service health"},"type":"text","width":24},{"height":6,"properties":{"metrics":[["AWS/ApplicationELB","HTTPCode_Target_5XX_Count","LoadBalancer","app/test/1234567890abcdef",{"id":"errors","visible":false}],["AWS/ApplicationELB","RequestCount","LoadBalancer","app/test/1234567890abcdef",{"id":"requests","visible":false}],[{"expression":"100 * errors / requests","id":"rate","label":"Error rate","yAxis":"right"}]],"period":300,"region":%[1]q,"stat":"Sum","title":"Errors","view":"timeSeries"},"type":"metric","width":12,"x":0,"y":2},{"height":6,"properties":{"query":"SOURCE '/aws/lambda/test' | fields @timestamp, @message | sort @timestamp desc | limit 20","region":%[1]q,"title":"Recent log events","view":"table"},"type":"log","width":12,"x":12,"y":2}]}`, acctest.Region())
+}
+
+const testAccDashboardDocumentDataSourceConfig_basic = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  period_override = "inherit"
+  start           = "-PT6H"
+
+  widget {
+    height = 2
+    width  = 24
+
+    text {
+      markdown   = "# Service health"
+      background = "transparent"
+    }
+  }
+
+  widget {
+    x     = 0
+    y     = 2
+    width = 12
+
+    metric {
+      title  = "Errors"
+      view   = "timeSeries"
+      stat   = "Sum"
+      period = 300
+
+      metric_query {
+        id          = "errors"
+        namespace   = "AWS/ApplicationELB"
+        metric_name = "HTTPCode_Target_5XX_Count"
+        visible     = false
+
+        dimensions = {
+          LoadBalancer = "app/test/1234567890abcdef"
+        }
+      }
+
+      metric_query {
+        id          = "requests"
+        namespace   = "AWS/ApplicationELB"
+        metric_name = "RequestCount"
+        visible     = false
+
+        dimensions = {
+          LoadBalancer = "app/test/1234567890abcdef"
+        }
+      }
+
+      metric_query {
+        id         = "rate"
+        expression = "100 * errors / requests"
+        label      = "Error rate"
+        y_axis     = "right"
+      }
+    }
+  }
+
+  widget {
+    x     = 12
+    y     = 2
+    width = 12
+
+    log {
+      title           = "Recent log events"
+      view            = "table"
+      log_group_names = ["/aws/lambda/test"]
+      query           = "fields @timestamp, @message | sort @timestamp desc | limit 20"
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceConfig_undefinedMetricReference = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    metric {
+      metric_query {
+        id          = "m1"
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+      }
+
+      metric_query {
+        id         = "e1"
+        expression = "m1 + m2"
+      }
+    }
+  }
+}
+`
+
+func testAccDashboardDocumentDataSourceConfig_alarm(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 2
+  metric_name         = "CPUUtilization"
+  namespace           = "AWS/EC2"
+  period              = 120
+  statistic           = "Average"
+  threshold           = 80
+}
+
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    height = 3
+    width  = 24
+
+    alarm {
+      title   = "Alarms"
+      alarms  = [aws_cloudwatch_metric_alarm.test.arn]
+      sort_by = "stateUpdatedTimestamp"
+      states  = ["ALARM", "INSUFFICIENT_DATA"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccDashboardDocumentDataSourceConfig_dashboard(rName string) string {
+	return acctest.ConfigCompose(testAccDashboardDocumentDataSourceConfig_basic, fmt.Sprintf(`
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+  dashboard_body = data.aws_cloudwatch_dashboard_document.test.json
+}
+`, rName))
+}
@@ -6,7 +6,9 @@ package cloudwatch
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
+	"slices"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -301,6 +303,7 @@ func resourceMetricAlarm() *schema.Resource {
 				}
 
 				if v := diff.Get("metric_query"); v != nil {
+					var ids, expressions []string
 					for _, v := range v.(*schema.Set).List() {
 						tfMap := v.(map[string]interface{})
 						if v, ok := tfMap[names.AttrExpression]; ok && v.(string) != "" {
@@ -309,6 +312,17 @@ func resourceMetricAlarm() *schema.Resource {
 									return errors.New("No metric_query may have both `expression` and a `metric` specified")
 								}
 							}
+							expressions = append(expressions, v.(string))
+						}
+						ids = append(ids, tfMap[names.AttrID].(string))
+					}
+
+					// Unknown IDs are empty strings at plan time; defer validation until they are known.
+					if !slices.Contains(ids, "") {
+						for _, expression := range expressions {
+							if err := validMetricMathExpressionReferences(expression, ids); err != nil {
+								return fmt.Errorf("metric_query: %w", err)
+							}
 						}
 					}
 				}
@@ -431,6 +431,10 @@ func TestAccCloudWatchMetricAlarm_metricQuery(t *testing.T) {
 				Config:      testAccMetricAlarmConfig_badMetricQuery(rName),
 				ExpectError: regexache.MustCompile("No metric_query may have both `expression` and a `metric` specified"),
 			},
+			{
+				Config:      testAccMetricAlarmConfig_metricQueryUndefinedReference(rName),
+				ExpectError: regexache.MustCompile(`references undefined ID\(s\): m2`),
+			},
 			{
 				Config: testAccMetricAlarmConfig_metricQueryExpressionQuery(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -1044,6 +1048,42 @@ resource "aws_cloudwatch_metric_alarm" "test" {
 `, rName)
 }
 
+func testAccMetricAlarmConfig_metricQueryUndefinedReference(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name                = %[1]q
+  comparison_operator       = "GreaterThanOrEqualToThreshold"
+  evaluation_periods        = 2
+  threshold                 = 80
+  alarm_description         = "This metric monitors ec2 cpu utilization"
+  insufficient_data_actions = []
+
+  metric_query {
+    id          = "e1"
+    expression  = "m1 + m2"
+    label       = "cat"
+    return_data = "true"
+  }
+
+  metric_query {
+    id = "m1"
+
+    metric {
+      metric_name = "CPUUtilization"
+      namespace   = "AWS/EC2"
+      period      = 120
+      stat        = "Average"
+      unit        = "Count"
+
+      dimensions = {
+        InstanceId = "i-abcd1234"
+      }
+    }
+  }
+}
+`, rName)
+}
+
 // EC2 Automate requires a valid EC2 instance
 // ValidationError: Invalid use of EC2 'Recover' action. i-abcd1234 is not a valid EC2 instance.
 func testAccMetricAlarmConfig_actionsEC2Automate(rName, action string) string {
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfiltersv2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_cloudwatch_metric_alarms", name="Metric Alarms")
+func newMetricAlarmsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &metricAlarmsDataSource{}, nil
+}
+
+type metricAlarmsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*metricAlarmsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_cloudwatch_metric_alarms"
+}
+
+func (d *metricAlarmsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARNs: schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"name_regex": schema.StringAttribute{
+				CustomType: fwtypes.RegexpType,
+				Optional:   true,
+			},
+			names.AttrNames: schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrTags: tftags.TagsAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrFilter: namevaluesfiltersv2.Block(ctx, "action-prefix", "alarm-name-prefix", "state-value"),
+		},
+	}
+}
+
+func (d *metricAlarmsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data metricAlarmsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().CloudWatchClient(ctx)
+
+	filters, diags := data.Filters.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var arns, alarmNames []string
+	tagsToMatch := tftags.New(ctx, data.Tags)
+
+	for _, filter := range namevaluesfiltersv2.New(filters).Combinations() {
+		input := &cloudwatch.DescribeAlarmsInput{
+			AlarmTypes: []awstypes.AlarmType{awstypes.AlarmTypeMetricAlarm},
+			StateValue: awstypes.StateValue(filter["state-value"]),
+		}
+		if v, ok := filter["action-prefix"]; ok {
+			input.ActionPrefix = aws.String(v)
+		}
+		if v, ok := filter["alarm-name-prefix"]; ok {
+			input.AlarmNamePrefix = aws.String(v)
+		}
+
+		pages := cloudwatch.NewDescribeAlarmsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				response.Diagnostics.AddError("reading CloudWatch Metric Alarms", err.Error())
+
+				return
+			}
+
+			for _, v := range page.MetricAlarms {
+				alarmARN, alarmName := aws.ToString(v.AlarmArn), aws.ToString(v.AlarmName)
+				if !data.NameRegex.IsNull() && !data.NameRegex.ValueRegexp().MatchString(alarmName) {
+					continue
+				}
+
+				if slices.Contains(arns, alarmARN) {
+					continue
+				}
+
+				if len(tagsToMatch) > 0 {
+					tags, err := listTags(ctx, conn, alarmARN)
+
+					if err != nil {
+						response.Diagnostics.AddError(fmt.Sprintf("listing tags for CloudWatch Metric Alarm (%s)", alarmName), err.Error())
+
+						return
+					}
+
+					if !tags.ContainsAll(tagsToMatch) {
+						continue
+					}
+				}
+
+				arns = append(arns, alarmARN)
+				alarmNames = append(alarmNames, alarmName)
+			}
+		}
+	}
+
+	data.ARNs = fwflex.FlattenFrameworkStringValueListLegacy(ctx, arns)
+	data.ID = types.StringValue(d.Meta().RegionForContext(ctx))
+	data.Names = fwflex.FlattenFrameworkStringValueListLegacy(ctx, alarmNames)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type metricAlarmsDataSourceModel struct {
+	ARNs      types.List                                                      `tfsdk:"arns"`
+	Filters   fwtypes.SetNestedObjectValueOf[namevaluesfiltersv2.FilterModel] `tfsdk:"filter"`
+	ID        types.String                                                    `tfsdk:"id"`
+	NameRegex fwtypes.Regexp                                                  `tfsdk:"name_regex"`
+	Names     types.List                                                      `tfsdk:"names"`
+	Tags      types.Map                                                       `tfsdk:"tags"`
+}
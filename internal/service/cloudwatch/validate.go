@@ -5,6 +5,8 @@ package cloudwatch
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 )
@@ -40,3 +42,70 @@ func validEC2AutomateARN(v interface{}, k string) (ws []string, errors []error)
 
 	return
 }
+
+// metricMathExpressionReferences returns the metric IDs referenced by a metric math expression.
+// Metric IDs must start with a lowercase letter whereas metric math functions and keywords are
+// upper case, so any lowercase identifier outside of a string literal is treated as a reference.
+// Metrics Insights queries (SELECT ...) reference metric names rather than IDs and are ignored.
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/using-metric-math.html.
+func metricMathExpressionReferences(expression string) []string {
+	if fields := strings.Fields(expression); len(fields) > 0 && strings.EqualFold(fields[0], "SELECT") {
+		return nil
+	}
+
+	var ids []string
+	runes := []rune(expression)
+
+	for i := 0; i < len(runes); {
+		switch r := runes[i]; {
+		case r == '\'' || r == '"':
+			// Skip string literals, e.g. SEARCH('{AWS/EC2,InstanceId} MetricName="CPUUtilization"', 'Average').
+			for i++; i < len(runes) && runes[i] != r; i++ {
+				if runes[i] == '\\' {
+					i++
+				}
+			}
+			i++
+		case r >= '0' && r <= '9':
+			// Skip numeric literals, including exponents.
+			i++
+			for i < len(runes) && (isMetricMathIdentifierRune(runes[i]) || runes[i] == '.') {
+				i++
+			}
+		case r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z'):
+			start := i
+			i++
+			for i < len(runes) && isMetricMathIdentifierRune(runes[i]) {
+				i++
+			}
+			if id := string(runes[start:i]); r >= 'a' && r <= 'z' && !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		default:
+			i++
+		}
+	}
+
+	return ids
+}
+
+func isMetricMathIdentifierRune(r rune) bool {
+	return r == '_' || (r >= '0' && r <= '9') || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+// validMetricMathExpressionReferences checks that every metric ID referenced by a metric math expression is defined.
+func validMetricMathExpressionReferences(expression string, ids []string) error {
+	var undefined []string
+
+	for _, id := range metricMathExpressionReferences(expression) {
+		if !slices.Contains(ids, id) {
+			undefined = append(undefined, id)
+		}
+	}
+
+	if len(undefined) > 0 {
+		return fmt.Errorf("metric math expression (%s) references undefined ID(s): %s", expression, strings.Join(undefined, ", "))
+	}
+
+	return nil
+}
@@ -74,3 +74,63 @@ func TestValidEC2AutomateARN(t *testing.T) {
 		}
 	}
 }
+
+func TestValidMetricMathExpressionReferences(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		expression string
+		ids        []string
+		valid      bool
+	}{
+		{
+			expression: "m1 + m2",
+			ids:        []string{"m1", "m2"},
+			valid:      true,
+		},
+		{
+			expression: "SUM(METRICS())",
+			valid:      true,
+		},
+		{
+			expression: "ANOMALY_DETECTION_BAND(m1, 2)",
+			ids:        []string{"m1"},
+			valid:      true,
+		},
+		{
+			expression: "IF(errors > 0, 100 * errors / requests, 0)",
+			ids:        []string{"errors", "requests"},
+			valid:      true,
+		},
+		{
+			expression: `SEARCH('{AWS/EC2,InstanceId} MetricName="CPUUtilization"', 'Average', 300)`,
+			valid:      true,
+		},
+		{
+			expression: "m1 * 1e3 / 3.5",
+			ids:        []string{"m1"},
+			valid:      true,
+		},
+		{
+			expression: `SELECT AVG(cpu) FROM SCHEMA("AWS/EC2", InstanceId)`,
+			valid:      true,
+		},
+		{
+			expression: "m1 + m3",
+			ids:        []string{"m1", "m2"},
+			valid:      false,
+		},
+		{
+			expression: "FILL(m1, REPEAT)",
+			valid:      false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := validMetricMathExpressionReferences(testCase.expression, testCase.ids)
+
+		if got, want := err == nil, testCase.valid; got != want {
+			t.Errorf("validMetricMathExpressionReferences(%q, %q) valid = %t, want %t (%v)", testCase.expression, testCase.ids, got, want, err)
+		}
+	}
+}
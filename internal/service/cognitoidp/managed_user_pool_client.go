@@ -42,11 +42,15 @@ import (
 
 // @FrameworkResource(name="Managed User Pool Client")
 func newManagedUserPoolClientResource(context.Context) (resource.ResourceWithConfigure, error) {
-	return &managedUserPoolClientResource{}, nil
+	r := &managedUserPoolClientResource{}
+	r.SetIdentity(userPoolClientIdentity)
+
+	return r, nil
 }
 
 type managedUserPoolClientResource struct {
 	framework.ResourceWithConfigure
+	framework.WithImportByIdentity
 	framework.WithNoOpDelete
 }
 
@@ -676,18 +680,6 @@ func (r *managedUserPoolClientResource) Update(ctx context.Context, request reso
 	response.Diagnostics.Append(response.State.Set(ctx, &config)...)
 }
 
-func (r *managedUserPoolClientResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	parts := strings.Split(request.ID, "/")
-	if len(parts) != 2 {
-		response.Diagnostics.AddError("Resource Import Invalid ID", fmt.Sprintf("wrong format of import ID (%s), use: 'user-pool-id/client-id'", request.ID))
-		return
-	}
-	userPoolId := parts[0]
-	clientId := parts[1]
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), clientId)...)
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrUserPoolID), userPoolId)...)
-}
-
 func (r *managedUserPoolClientResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
 	return []resource.ConfigValidator{
 		resourceManagedUserPoolClientAccessTokenValidityValidator{
@@ -156,7 +156,7 @@ func resourceAppRead(ctx context.Context, d *schema.ResourceData, meta interface
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "codedeploy",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).RegionForContext(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("application:%s", appName),
 	}.String()
@@ -225,6 +225,11 @@ func appParseResourceID(id string) string {
 	return parts[1]
 }
 
+// appImportID returns the ID with which the application described by d is imported, its name.
+func appImportID(d *schema.ResourceData) (string, error) {
+	return d.Get(names.AttrName).(string), nil
+}
+
 func findApplicationByName(ctx context.Context, conn *codedeploy.Client, name string) (*types.ApplicationInfo, error) {
 	input := &codedeploy.GetApplicationInput{
 		ApplicationName: aws.String(name),
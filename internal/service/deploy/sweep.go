@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_codedeploy_app", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codedeploy_app", &resource.Sweeper{
 		Name: "aws_codedeploy_app",
 		F:    sweepApps,
 	})
@@ -50,7 +50,7 @@ func sweepApps(region string) error {
 			d.SetId(fmt.Sprintf("%s:%s", "xxxx", v))
 			d.Set(names.AttrName, v)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithImportID(appImportID)))
 		}
 	}
 
@@ -86,7 +86,7 @@ func (r *resourceEventSourcesConfig) Create(ctx context.Context, req resource.Cr
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	plan.ID = types.StringValue(r.Meta().Region)
+	plan.ID = types.StringValue(r.Meta().RegionForContext(ctx))
 
 	in := &devopsguru.UpdateEventSourcesConfigInput{}
 	resp.Diagnostics.Append(flex.Expand(ctx, &plan, in)...)
@@ -33,6 +33,7 @@ import (
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -376,9 +377,9 @@ func (r *trustResource) Delete(ctx context.Context, request resource.DeleteReque
 }
 
 func (r *trustResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	parts := strings.Split(request.ID, "/")
-	if len(parts) != 2 {
-		response.Diagnostics.AddError("Resource Import Invalid ID", fmt.Sprintf("Wrong format for import ID (%s), use: 'directory-id/remote-directory-domain'", request.ID))
+	parts, err := trustIdentity.Split(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("Resource Import Invalid ID", err.Error())
 		return
 	}
 	directoryID := parts[0]
@@ -390,12 +391,15 @@ func (r *trustResource) ImportState(ctx context.Context, request resource.Import
 			"Importing Resource",
 			err.Error(),
 		)
+		return
 	}
 
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), aws.ToString(trust.TrustId))...)
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("directory_id"), directoryID)...)
 }
 
+var trustIdentity = identity.New("/", "directory_id", "remote_domain_name")
+
 type trustResourceModel struct {
 	ConditionalForwarderIPAddrs          types.Set                                   `tfsdk:"conditional_forwarder_ip_addrs"`
 	CreatedDateTime                      timetypes.RFC3339                           `tfsdk:"created_date_time"`
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_dynamodb_tables", name="Tables")
+func newTablesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &tablesDataSource{}, nil
+}
+
+type tablesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*tablesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_dynamodb_tables"
+}
+
+func (d *tablesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARNs: schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"name_regex": schema.StringAttribute{
+				CustomType: fwtypes.RegexpType,
+				Optional:   true,
+			},
+			names.AttrNames: schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrTags: tftags.TagsAttribute(),
+		},
+	}
+}
+
+func (d *tablesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data tablesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().DynamoDBClient(ctx)
+
+	var arns, tableNames []string
+	tagsToMatch := tftags.New(ctx, data.Tags)
+
+	pages := dynamodb.NewListTablesPaginator(conn, &dynamodb.ListTablesInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			response.Diagnostics.AddError("listing DynamoDB Tables", err.Error())
+
+			return
+		}
+
+		for _, tableName := range page.TableNames {
+			if !data.NameRegex.IsNull() && !data.NameRegex.ValueRegexp().MatchString(tableName) {
+				continue
+			}
+
+			tableARN := arn.ARN{
+				Partition: d.Meta().Partition,
+				Service:   "dynamodb",
+				Region:    d.Meta().RegionForContext(ctx),
+				AccountID: d.Meta().AccountID,
+				Resource:  "table/" + tableName,
+			}.String()
+
+			if len(tagsToMatch) > 0 {
+				tags, err := listTags(ctx, conn, tableARN)
+
+				if err != nil {
+					response.Diagnostics.AddError(fmt.Sprintf("listing tags for DynamoDB Table (%s)", tableName), err.Error())
+
+					return
+				}
+
+				if !tags.ContainsAll(tagsToMatch) {
+					continue
+				}
+			}
+
+			arns = append(arns, tableARN)
+			tableNames = append(tableNames, tableName)
+		}
+	}
+
+	data.ARNs = flex.FlattenFrameworkStringValueListLegacy(ctx, arns)
+	data.ID = types.StringValue(d.Meta().RegionForContext(ctx))
+	data.Names = flex.FlattenFrameworkStringValueListLegacy(ctx, tableNames)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type tablesDataSourceModel struct {
+	ARNs      types.List     `tfsdk:"arns"`
+	ID        types.String   `tfsdk:"id"`
+	NameRegex fwtypes.Regexp `tfsdk:"name_regex"`
+	Names     types.List     `tfsdk:"names"`
+	Tags      types.Map      `tfsdk:"tags"`
+}
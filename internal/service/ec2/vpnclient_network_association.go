@@ -151,3 +151,9 @@ func resourceClientVPNNetworkAssociationImport(ctx context.Context, d *schema.Re
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// clientVPNNetworkAssociationImportID returns the ID with which the network association described by d is imported,
+// 'endpoint-id,association-id'.
+func clientVPNNetworkAssociationImportID(d *schema.ResourceData) (string, error) {
+	return d.Get("client_vpn_endpoint_id").(string) + "," + d.Id(), nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -257,7 +258,7 @@ func resourceCapacityProviderImport(ctx context.Context, d *schema.ResourceData,
 	d.Set(names.AttrName, d.Id())
 	d.SetId(arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).RegionForContext(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Service:   "ecs",
 		Resource:  "capacity-provider/" + d.Id(),
@@ -266,6 +267,16 @@ func resourceCapacityProviderImport(ctx context.Context, d *schema.ResourceData,
 	return []*schema.ResourceData{d}, nil
 }
 
+// capacityProviderImportID returns the ID with which the capacity provider described by d is imported, its name.
+func capacityProviderImportID(d *schema.ResourceData) (string, error) {
+	v, err := arn.Parse(d.Id())
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimPrefix(v.Resource, "capacity-provider/"), nil
+}
+
 func partitionFromConn(conn *ecs.Client) string {
 	return names.PartitionForRegion(conn.Options().Region)
 }
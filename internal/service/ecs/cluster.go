@@ -6,6 +6,7 @@ package ecs
 import (
 	"context"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -333,7 +334,7 @@ func resourceClusterImport(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set(names.AttrName, d.Id())
 	d.SetId(arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).RegionForContext(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Service:   "ecs",
 		Resource:  "cluster/" + d.Id(),
@@ -342,6 +343,16 @@ func resourceClusterImport(ctx context.Context, d *schema.ResourceData, meta int
 	return []*schema.ResourceData{d}, nil
 }
 
+// clusterImportID returns the ID with which the cluster described by d is imported, its name.
+func clusterImportID(d *schema.ResourceData) (string, error) {
+	v, err := arn.Parse(d.Id())
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimPrefix(v.Resource, "cluster/"), nil
+}
+
 func retryClusterCreate(ctx context.Context, conn *ecs.Client, input *ecs.CreateClusterInput) (*ecs.CreateClusterOutput, error) {
 	outputRaw, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.InvalidParameterException](ctx, propagationTimeout, func() (interface{}, error) {
 		return conn.CreateCluster(ctx, input)
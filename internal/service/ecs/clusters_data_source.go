@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ecs_clusters", name="Clusters")
+func newClustersDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &clustersDataSource{}, nil
+}
+
+type clustersDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*clustersDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_ecs_clusters"
+}
+
+func (d *clustersDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARNs: schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"name_regex": schema.StringAttribute{
+				CustomType: fwtypes.RegexpType,
+				Optional:   true,
+			},
+			names.AttrNames: schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrTags: tftags.TagsAttribute(),
+		},
+	}
+}
+
+func (d *clustersDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data clustersDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ECSClient(ctx)
+
+	var arns, clusterNames []string
+	tagsToMatch := tftags.New(ctx, data.Tags)
+
+	pages := ecs.NewListClustersPaginator(conn, &ecs.ListClustersInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			response.Diagnostics.AddError("listing ECS Clusters", err.Error())
+
+			return
+		}
+
+		for _, clusterARN := range page.ClusterArns {
+			clusterName := clusterARN[strings.LastIndex(clusterARN, "/")+1:]
+			if !data.NameRegex.IsNull() && !data.NameRegex.ValueRegexp().MatchString(clusterName) {
+				continue
+			}
+
+			if len(tagsToMatch) > 0 {
+				tags, err := listTags(ctx, conn, clusterARN)
+
+				if err != nil {
+					response.Diagnostics.AddError(fmt.Sprintf("listing tags for ECS Cluster (%s)", clusterName), err.Error())
+
+					return
+				}
+
+				if !tags.ContainsAll(tagsToMatch) {
+					continue
+				}
+			}
+
+			arns = append(arns, clusterARN)
+			clusterNames = append(clusterNames, clusterName)
+		}
+	}
+
+	data.ARNs = flex.FlattenFrameworkStringValueListLegacy(ctx, arns)
+	data.ID = types.StringValue(d.Meta().RegionForContext(ctx))
+	data.Names = flex.FlattenFrameworkStringValueListLegacy(ctx, clusterNames)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type clustersDataSourceModel struct {
+	ARNs      types.List     `tfsdk:"arns"`
+	ID        types.String   `tfsdk:"id"`
+	NameRegex fwtypes.Regexp `tfsdk:"name_regex"`
+	Names     types.List     `tfsdk:"names"`
+	Tags      types.Map      `tfsdk:"tags"`
+}
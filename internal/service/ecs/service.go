@@ -1601,7 +1601,7 @@ func resourceServiceImport(ctx context.Context, d *schema.ResourceData, meta int
 	d.SetId(name)
 	clusterArn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).RegionForContext(ctx),
 		Service:   "ecs",
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("cluster/%s", cluster),
@@ -1610,6 +1610,26 @@ func resourceServiceImport(ctx context.Context, d *schema.ResourceData, meta int
 	return []*schema.ResourceData{d}, nil
 }
 
+// serviceImportID returns the ID with which the ECS Service described by d is imported, 'cluster-name/service-name'.
+func serviceImportID(d *schema.ResourceData) (string, error) {
+	cluster := d.Get("cluster").(string)
+	if arn.IsARN(cluster) {
+		v, err := arn.Parse(cluster)
+		if err != nil {
+			return "", err
+		}
+		cluster = strings.TrimPrefix(v.Resource, "cluster/")
+	}
+
+	if cluster == "" {
+		return "", fmt.Errorf("ECS Service (%s) has no cluster", d.Id())
+	}
+
+	name := d.Id()[strings.LastIndex(d.Id(), "/")+1:]
+
+	return cluster + "/" + name, nil
+}
+
 func retryServiceCreate(ctx context.Context, conn *ecs.Client, input *ecs.CreateServiceInput) (*ecs.CreateServiceOutput, error) {
 	const (
 		serviceCreateTimeout = 2 * time.Minute
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfiltersv2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ecs_services", name="Services")
+func newServicesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &servicesDataSource{}, nil
+}
+
+type servicesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*servicesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_ecs_services"
+}
+
+func (d *servicesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARNs: schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"cluster": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"name_regex": schema.StringAttribute{
+				CustomType: fwtypes.RegexpType,
+				Optional:   true,
+			},
+			names.AttrNames: schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrTags: tftags.TagsAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrFilter: namevaluesfiltersv2.Block(ctx, "launch-type", "scheduling-strategy"),
+		},
+	}
+}
+
+func (d *servicesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data servicesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ECSClient(ctx)
+
+	filters, diags := data.Filters.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var arns, serviceNames []string
+	tagsToMatch := tftags.New(ctx, data.Tags)
+
+	for _, filter := range namevaluesfiltersv2.New(filters).Combinations() {
+		input := &ecs.ListServicesInput{
+			Cluster:            fwflex.StringFromFramework(ctx, data.Cluster),
+			LaunchType:         awstypes.LaunchType(filter["launch-type"]),
+			SchedulingStrategy: awstypes.SchedulingStrategy(filter["scheduling-strategy"]),
+		}
+
+		pages := ecs.NewListServicesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("listing ECS Services (%s)", data.Cluster.ValueString()), err.Error())
+
+				return
+			}
+
+			for _, serviceARN := range page.ServiceArns {
+				serviceName := serviceARN[strings.LastIndex(serviceARN, "/")+1:]
+				if !data.NameRegex.IsNull() && !data.NameRegex.ValueRegexp().MatchString(serviceName) {
+					continue
+				}
+
+				if slices.Contains(arns, serviceARN) {
+					continue
+				}
+
+				if len(tagsToMatch) > 0 {
+					tags, err := listTags(ctx, conn, serviceARN)
+
+					if err != nil {
+						response.Diagnostics.AddError(fmt.Sprintf("listing tags for ECS Service (%s)", serviceName), err.Error())
+
+						return
+					}
+
+					if !tags.ContainsAll(tagsToMatch) {
+						continue
+					}
+				}
+
+				arns = append(arns, serviceARN)
+				serviceNames = append(serviceNames, serviceName)
+			}
+		}
+	}
+
+	data.ARNs = fwflex.FlattenFrameworkStringValueListLegacy(ctx, arns)
+	data.ID = types.StringValue(d.Meta().RegionForContext(ctx))
+	data.Names = fwflex.FlattenFrameworkStringValueListLegacy(ctx, serviceNames)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type servicesDataSourceModel struct {
+	ARNs      types.List                                                      `tfsdk:"arns"`
+	Cluster   types.String                                                    `tfsdk:"cluster"`
+	Filters   fwtypes.SetNestedObjectValueOf[namevaluesfiltersv2.FilterModel] `tfsdk:"filter"`
+	ID        types.String                                                    `tfsdk:"id"`
+	NameRegex fwtypes.Regexp                                                  `tfsdk:"name_regex"`
+	Names     types.List                                                      `tfsdk:"names"`
+	Tags      types.Map                                                       `tfsdk:"tags"`
+}
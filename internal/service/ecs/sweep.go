@@ -10,13 +10,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ecs_capacity_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_capacity_provider", &resource.Sweeper{
 		Name: "aws_ecs_capacity_provider",
 		F:    sweepCapacityProviders,
 		Dependencies: []string{
@@ -25,7 +26,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ecs_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_cluster", &resource.Sweeper{
 		Name: "aws_ecs_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -33,12 +34,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ecs_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_service", &resource.Sweeper{
 		Name: "aws_ecs_service",
 		F:    sweepServices,
 	})
 
-	resource.AddTestSweepers("aws_ecs_task_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_task_definition", &resource.Sweeper{
 		Name: "aws_ecs_task_definition",
 		F:    sweepTaskDefinitions,
 		Dependencies: []string{
@@ -74,7 +75,7 @@ func sweepCapacityProviders(region string) error {
 			d := r.Data(nil)
 			d.SetId(arn)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithImportID(capacityProviderImportID)))
 		}
 
 		return !lastPage
@@ -126,7 +127,7 @@ func sweepClusters(region string) error {
 			d := r.Data(nil)
 			d.SetId(v)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithImportID(clusterImportID)))
 		}
 	}
 
@@ -176,12 +177,7 @@ func sweepServices(region string) error {
 				}
 
 				for _, v := range page.ServiceArns {
-					r := resourceService()
-					d := r.Data(nil)
-					d.SetId(v)
-					d.Set("cluster", clusterARN)
-
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, newServiceSweepResource(client, clusterARN, v))
 				}
 			}
 		}
@@ -196,6 +192,15 @@ func sweepServices(region string) error {
 	return nil
 }
 
+func newServiceSweepResource(client *conns.AWSClient, clusterARN, serviceARN string) sweep.Sweepable {
+	r := resourceService()
+	d := r.Data(nil)
+	d.SetId(serviceARN)
+	d.Set("cluster", clusterARN)
+
+	return sweep.NewSweepResource(r, d, client, sweep.WithImportID(serviceImportID))
+}
+
 func sweepTaskDefinitions(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -225,7 +230,7 @@ func sweepTaskDefinitions(region string) error {
 			d.SetId(v)
 			d.Set(names.AttrARN, v)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithPassthroughImportID()))
 		}
 	}
 
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func TestServiceSweepResourceImportBlocks(t *testing.T) { //nolint:paralleltest // Registers sweepers and sets the package-level sweep recorder.
+	ctx := context.Background()
+
+	const (
+		clusterARN = "arn:aws:ecs:us-west-2:123456789012:cluster/cluster-1"           //lintignore:AWSAT003,AWSAT005
+		serviceARN = "arn:aws:ecs:us-west-2:123456789012:service/cluster-1/service-1" //lintignore:AWSAT003,AWSAT005
+	)
+	client := &conns.AWSClient{
+		AccountID: "123456789012",
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	// Sweepers registered without a listing function have the resources that they pass to SweepOrchestrator
+	// recorded, so no AWS API calls are made.
+	sweep.AddTestSweepers("aws_ecs_service", &resource.Sweeper{
+		Name: "aws_ecs_service",
+		F: func(string) error {
+			return sweep.SweepOrchestrator(ctx, []sweep.Sweepable{newServiceSweepResource(client, clusterARN, serviceARN)})
+		},
+	})
+
+	var out bytes.Buffer
+	err := sweep.ImportBlocks(ctx, sweep.ImportBlocksOptions{
+		Output:        &out,
+		Regions:       []string{"us-west-2"}, //lintignore:AWSAT003
+		ResourceTypes: []string{"aws_ecs_service"},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	m := regexp.MustCompile(`(?m)^  id = "([^"]*)"$`).FindStringSubmatch(out.String())
+	if m == nil {
+		t.Fatalf("no import block written: %s", out.String())
+	}
+
+	if got, expected := m[1], "cluster-1/service-1"; got != expected {
+		t.Errorf("incorrect import ID. Expected: %q, got: %q", expected, got)
+	}
+
+	// The import ID must be accepted by the resource's own importer.
+	d := resourceService().Data(nil)
+	d.SetId(m[1])
+
+	if _, err := resourceServiceImport(ctx, d, client); err != nil {
+		t.Fatalf("importing %q: %s", m[1], err)
+	}
+
+	if got, expected := d.Id(), "service-1"; got != expected {
+		t.Errorf("incorrect ID. Expected: %q, got: %q", expected, got)
+	}
+
+	if got, expected := d.Get("cluster").(string), clusterARN; got != expected {
+		t.Errorf("incorrect cluster. Expected: %q, got: %q", expected, got)
+	}
+}
+
+func TestServiceSweepResourceImportID_unknownFormat(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Without an import ID function the service ARN would be written, which the importer rejects.
+	r := resourceService()
+	d := r.Data(nil)
+	d.SetId("arn:aws:ecs:us-west-2:123456789012:service/cluster-1/service-1") //lintignore:AWSAT003,AWSAT005
+
+	id, ok, err := sweep.NewSweepResource(r, d, nil).ImportID(ctx)
+
+	if !ok {
+		t.Fatal("expected resource to support import")
+	}
+
+	if err == nil {
+		t.Fatalf("expected error, got import ID %q", id)
+	}
+
+	if _, err := resourceServiceImport(ctx, d, &conns.AWSClient{}); err == nil {
+		t.Error("expected importer to reject the service ARN")
+	}
+}
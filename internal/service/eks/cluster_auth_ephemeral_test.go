@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSClusterAuthEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.EKSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6EchoProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterAuthEphemeralConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(echoResourceName, "data.name", "foobar"),
+					resource.TestCheckResourceAttrSet(echoResourceName, "data.token"),
+					testAccCheckClusterAuthEphemeralToken(echoResourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckClusterAuthEphemeralToken(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		name := rs.Primary.Attributes["data.name"]
+		tok := rs.Primary.Attributes["data.token"]
+		verifier := tfeks.NewVerifier(name)
+		identity, err := verifier.Verify(tok)
+		if err != nil {
+			return fmt.Errorf("Error verifying token for cluster %q: %v", name, err)
+		}
+		if identity.ARN == "" {
+			return fmt.Errorf("Unexpected blank ARN for token identity")
+		}
+
+		return nil
+	}
+}
+
+const testAccClusterAuthEphemeralConfig_basic = `
+ephemeral "aws_eks_cluster_auth" "test" {
+  name = "foobar"
+}
+
+provider "echo" {
+  data = ephemeral.aws_eks_cluster_auth.test
+}
+
+resource "echo" "test" {}
+`
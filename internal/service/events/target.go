@@ -781,6 +781,17 @@ func targetParseImportID(id string) (string, string, string, error) {
 	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected EVENTBUSNAME%[2]sRULENAME%[2]sTARGETID or RULENAME%[2]sTARGETID", id, targetImportIDSeparator)
 }
 
+// targetImportID returns the ID with which the target described by d is imported.
+func targetImportID(d *schema.ResourceData) (string, error) {
+	parts := []string{d.Get(names.AttrRule).(string), d.Get("target_id").(string)}
+
+	if v := d.Get("event_bus_name").(string); v != "" && v != DefaultEventBusName {
+		parts = append([]string{v}, parts...)
+	}
+
+	return strings.Join(parts, targetImportIDSeparator), nil
+}
+
 func putTargetError(apiObject types.PutTargetsResultEntry) error {
 	return errs.APIError(aws.ToString(apiObject.ErrorCode), aws.ToString(apiObject.ErrorMessage))
 }
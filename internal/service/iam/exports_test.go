@@ -40,14 +40,20 @@ var (
 	FindAttachedUserPolicies            = findAttachedUserPolicies
 	FindAttachedUserPolicyByTwoPartKey  = findAttachedUserPolicyByTwoPartKey
 	FindEntitiesForPolicyByARN          = findEntitiesForPolicyByARN
+	FindGroupAttachedPolicies           = findGroupAttachedPolicies
 	FindGroupByName                     = findGroupByName
+	FindGroupPolicyNames                = findGroupPolicyNames
 	FindInstanceProfileByName           = findInstanceProfileByName
 	FindOpenIDConnectProviderByARN      = findOpenIDConnectProviderByARN
 	FindPolicyByARN                     = findPolicyByARN
+	FindRoleAttachedPolicies            = findRoleAttachedPolicies
+	FindRolePolicyNames                 = findRolePolicyNames
 	FindSAMLProviderByARN               = findSAMLProviderByARN
 	FindServerCertificateByName         = findServerCertificateByName
 	FindSSHPublicKeyByThreePartKey      = findSSHPublicKeyByThreePartKey
+	FindUserAttachedPolicies            = findUserAttachedPolicies
 	FindUserByName                      = findUserByName
+	FindUserPolicyNames                 = findUserPolicyNames
 	FindVirtualMFADeviceBySerialNumber  = findVirtualMFADeviceBySerialNumber
 	SESSMTPPasswordFromSecretKeySigV4   = sesSMTPPasswordFromSecretKeySigV4
 )
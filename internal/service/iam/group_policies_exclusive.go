@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_iam_group_policies_exclusive", name="Group Policies Exclusive")
+func newGroupPoliciesExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &groupPoliciesExclusiveResource{}, nil
+}
+
+type groupPoliciesExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (*groupPoliciesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_group_policies_exclusive"
+}
+
+func (r *groupPoliciesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrGroupName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_names": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *groupPoliciesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data groupPoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	groupName := data.GroupName.ValueString()
+	if err := syncGroupPolicies(ctx, conn, groupName, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyNames)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IAM Group Policies Exclusive (%s)", groupName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *groupPoliciesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data groupPoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	groupName := data.GroupName.ValueString()
+	output, err := findGroupPolicyNames(ctx, conn, groupName)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IAM Group Policies Exclusive (%s)", groupName), err.Error())
+
+		return
+	}
+
+	data.PolicyNames = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *groupPoliciesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new groupPoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	if !new.PolicyNames.Equal(old.PolicyNames) {
+		groupName := new.GroupName.ValueString()
+		if err := syncGroupPolicies(ctx, conn, groupName, fwflex.ExpandFrameworkStringValueSet(ctx, new.PolicyNames)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IAM Group Policies Exclusive (%s)", groupName), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *groupPoliciesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrGroupName), request, response)
+}
+
+// syncGroupPolicies deletes any inline policies embedded in the IAM group that are not in want.
+// Inline policies in want are managed using the aws_iam_group_policy resource and are not created.
+func syncGroupPolicies(ctx context.Context, conn *iam.Client, groupName string, want []string) error {
+	have, err := findGroupPolicyNames(ctx, conn, groupName)
+
+	if err != nil {
+		return fmt.Errorf("reading IAM Group (%s) inline policies: %w", groupName, err)
+	}
+
+	_, remove, _ := flex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+
+	for _, policyName := range remove {
+		input := &iam.DeleteGroupPolicyInput{
+			PolicyName: aws.String(policyName),
+			GroupName:  aws.String(groupName),
+		}
+
+		_, err := conn.DeleteGroupPolicy(ctx, input)
+
+		if errs.IsA[*awstypes.NoSuchEntityException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting IAM Group (%s) inline policy (%s): %w", groupName, policyName, err)
+		}
+	}
+
+	return nil
+}
+
+type groupPoliciesExclusiveResourceModel struct {
+	GroupName   types.String `tfsdk:"group_name"`
+	PolicyNames types.Set    `tfsdk:"policy_names"`
+}
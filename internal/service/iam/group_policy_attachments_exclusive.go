@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_iam_group_policy_attachments_exclusive", name="Group Policy Attachments Exclusive")
+func newGroupPolicyAttachmentsExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &groupPolicyAttachmentsExclusiveResource{}, nil
+}
+
+type groupPolicyAttachmentsExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (*groupPolicyAttachmentsExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_group_policy_attachments_exclusive"
+}
+
+func (r *groupPolicyAttachmentsExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrGroupName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_arns": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *groupPolicyAttachmentsExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data groupPolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	groupName := data.GroupName.ValueString()
+	if err := syncGroupPolicyAttachments(ctx, conn, groupName, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IAM Group Policy Attachments Exclusive (%s)", groupName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *groupPolicyAttachmentsExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data groupPolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	groupName := data.GroupName.ValueString()
+	output, err := findGroupAttachedPolicies(ctx, conn, groupName)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IAM Group Policy Attachments Exclusive (%s)", groupName), err.Error())
+
+		return
+	}
+
+	data.PolicyARNs = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *groupPolicyAttachmentsExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new groupPolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	if !new.PolicyARNs.Equal(old.PolicyARNs) {
+		groupName := new.GroupName.ValueString()
+		if err := syncGroupPolicyAttachments(ctx, conn, groupName, fwflex.ExpandFrameworkStringValueSet(ctx, new.PolicyARNs)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IAM Group Policy Attachments Exclusive (%s)", groupName), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *groupPolicyAttachmentsExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrGroupName), request, response)
+}
+
+// syncGroupPolicyAttachments attaches the managed policies in want to the IAM group and detaches any others.
+func syncGroupPolicyAttachments(ctx context.Context, conn *iam.Client, groupName string, want []string) error {
+	have, err := findGroupAttachedPolicies(ctx, conn, groupName)
+
+	if err != nil {
+		return fmt.Errorf("reading IAM Group (%s) attached policies: %w", groupName, err)
+	}
+
+	attach, detach, _ := flex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+
+	for _, policyARN := range attach {
+		if err := attachPolicyToGroup(ctx, conn, groupName, policyARN); err != nil {
+			return err
+		}
+	}
+
+	for _, policyARN := range detach {
+		if err := detachPolicyFromGroup(ctx, conn, groupName, policyARN); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type groupPolicyAttachmentsExclusiveResourceModel struct {
+	GroupName  types.String `tfsdk:"group_name"`
+	PolicyARNs types.Set    `tfsdk:"policy_arns"`
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_iam_policies", name="Policies")
+func newPoliciesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &policiesDataSource{}, nil
+}
+
+type policiesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*policiesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_iam_policies"
+}
+
+func (d *policiesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARNs: schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"name_regex": schema.StringAttribute{
+				CustomType: fwtypes.RegexpType,
+				Optional:   true,
+			},
+			names.AttrNames: schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"only_attached": schema.BoolAttribute{
+				Optional: true,
+			},
+			"path_prefix": schema.StringAttribute{
+				Optional: true,
+			},
+			"policy_usage_filter": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PolicyUsageType](),
+				Optional:   true,
+			},
+			names.AttrScope: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PolicyScopeType](),
+				Optional:   true,
+			},
+			names.AttrTags: tftags.TagsAttribute(),
+		},
+	}
+}
+
+func (d *policiesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data policiesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().IAMClient(ctx)
+
+	input := &iam.ListPoliciesInput{
+		OnlyAttached:      data.OnlyAttached.ValueBool(),
+		PathPrefix:        fwflex.StringFromFramework(ctx, data.PathPrefix),
+		PolicyUsageFilter: data.PolicyUsageFilter.ValueEnum(),
+		Scope:             data.Scope.ValueEnum(),
+	}
+
+	var arns, policyNames []string
+	tagsToMatch := tftags.New(ctx, data.Tags)
+
+	pages := iam.NewListPoliciesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			response.Diagnostics.AddError("listing IAM Policies", err.Error())
+
+			return
+		}
+
+		for _, v := range page.Policies {
+			policyARN, policyName := aws.ToString(v.Arn), aws.ToString(v.PolicyName)
+			if !data.NameRegex.IsNull() && !data.NameRegex.ValueRegexp().MatchString(policyName) {
+				continue
+			}
+
+			if len(tagsToMatch) > 0 {
+				tags, err := policyTags(ctx, conn, policyARN)
+
+				if err != nil {
+					response.Diagnostics.AddError(fmt.Sprintf("listing tags for IAM Policy (%s)", policyARN), err.Error())
+
+					return
+				}
+
+				if !KeyValueTags(ctx, tags).ContainsAll(tagsToMatch) {
+					continue
+				}
+			}
+
+			arns = append(arns, policyARN)
+			policyNames = append(policyNames, policyName)
+		}
+	}
+
+	data.ARNs = fwflex.FlattenFrameworkStringValueListLegacy(ctx, arns)
+	data.ID = types.StringValue(d.Meta().AccountID)
+	data.Names = fwflex.FlattenFrameworkStringValueListLegacy(ctx, policyNames)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type policiesDataSourceModel struct {
+	ARNs              types.List                                   `tfsdk:"arns"`
+	ID                types.String                                 `tfsdk:"id"`
+	NameRegex         fwtypes.Regexp                               `tfsdk:"name_regex"`
+	Names             types.List                                   `tfsdk:"names"`
+	OnlyAttached      types.Bool                                   `tfsdk:"only_attached"`
+	PathPrefix        types.String                                 `tfsdk:"path_prefix"`
+	PolicyUsageFilter fwtypes.StringEnum[awstypes.PolicyUsageType] `tfsdk:"policy_usage_filter"`
+	Scope             fwtypes.StringEnum[awstypes.PolicyScopeType] `tfsdk:"scope"`
+	Tags              types.Map                                    `tfsdk:"tags"`
+}
@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Policy types understood by LintPolicy. The type determines the maximum document size.
+const (
+	PolicyLintTypeGroupInline    = "group_inline"
+	PolicyLintTypeManaged        = "managed"
+	PolicyLintTypeResource       = "resource"
+	PolicyLintTypeRoleInline     = "role_inline"
+	PolicyLintTypeRoleTrust      = "role_trust"
+	PolicyLintTypeServiceControl = "service_control"
+	PolicyLintTypeUserInline     = "user_inline"
+)
+
+func PolicyLintType_Values() []string {
+	return []string{
+		PolicyLintTypeGroupInline,
+		PolicyLintTypeManaged,
+		PolicyLintTypeResource,
+		PolicyLintTypeRoleInline,
+		PolicyLintTypeRoleTrust,
+		PolicyLintTypeServiceControl,
+		PolicyLintTypeUserInline,
+	}
+}
+
+// Finding types, matching those returned by IAM Access Analyzer policy validation.
+const (
+	policyLintFindingTypeError           = "ERROR"
+	policyLintFindingTypeSecurityWarning = "SECURITY_WARNING"
+	policyLintFindingTypeWarning         = "WARNING"
+)
+
+// Finding codes.
+const (
+	policyLintCodeDuplicateSid              = "DUPLICATE_SID"
+	policyLintCodeInvalidConditionOperator  = "INVALID_CONDITION_OPERATOR"
+	policyLintCodeNotActionWithAllow        = "NOT_ACTION_WITH_ALLOW"
+	policyLintCodePolicySizeExceeded        = "POLICY_SIZE_EXCEEDED"
+	policyLintCodeUnknownGlobalConditionKey = "UNKNOWN_GLOBAL_CONDITION_KEY"
+	policyLintCodeWildcardActionAndResource = "WILDCARD_ACTION_AND_RESOURCE"
+)
+
+type policyLintSizeLimit struct {
+	maxLength         int
+	excludeWhitespace bool
+}
+
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html#reference_iam-quotas-entity-length
+// and https://docs.aws.amazon.com/organizations/latest/userguide/orgs_reference_limits.html.
+var policyLintSizeLimits = map[string]policyLintSizeLimit{
+	PolicyLintTypeGroupInline:    {maxLength: 5120, excludeWhitespace: true},
+	PolicyLintTypeManaged:        {maxLength: 6144, excludeWhitespace: true},
+	PolicyLintTypeResource:       {maxLength: 20480},
+	PolicyLintTypeRoleInline:     {maxLength: 10240, excludeWhitespace: true},
+	PolicyLintTypeRoleTrust:      {maxLength: 2048, excludeWhitespace: true},
+	PolicyLintTypeServiceControl: {maxLength: 5120},
+	PolicyLintTypeUserInline:     {maxLength: 2048, excludeWhitespace: true},
+}
+
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html.
+var policyLintConditionOperators = []string{
+	"arnequals",
+	"arnlike",
+	"arnnotequals",
+	"arnnotlike",
+	"binaryequals",
+	"bool",
+	"dateequals",
+	"dategreaterthan",
+	"dategreaterthanequals",
+	"datelessthan",
+	"datelessthanequals",
+	"datenotequals",
+	"ipaddress",
+	"notipaddress",
+	"null",
+	"numericequals",
+	"numericgreaterthan",
+	"numericgreaterthanequals",
+	"numericlessthan",
+	"numericlessthanequals",
+	"numericnotequals",
+	"stringequals",
+	"stringequalsignorecase",
+	"stringlike",
+	"stringnotequals",
+	"stringnotequalsignorecase",
+	"stringnotlike",
+}
+
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_condition-keys.html.
+var (
+	policyLintGlobalConditionKeys = []string{
+		"aws:assumedroot",
+		"aws:calledvia",
+		"aws:calledviafirst",
+		"aws:calledvialast",
+		"aws:chatbotsourcearn",
+		"aws:currenttime",
+		"aws:ec2instancesourceprivateipv4",
+		"aws:ec2instancesourcevpc",
+		"aws:epochtime",
+		"aws:federatedprovider",
+		"aws:multifactorauthage",
+		"aws:multifactorauthpresent",
+		"aws:principalaccount",
+		"aws:principalarn",
+		"aws:principalisawsservice",
+		"aws:principalorgid",
+		"aws:principalorgpaths",
+		"aws:principalservicename",
+		"aws:principalservicenameslist",
+		"aws:principaltype",
+		"aws:referer",
+		"aws:requestedregion",
+		"aws:resourceaccount",
+		"aws:resourceorgid",
+		"aws:resourceorgpaths",
+		"aws:securetransport",
+		"aws:sourceaccount",
+		"aws:sourcearn",
+		"aws:sourceidentity",
+		"aws:sourceip",
+		"aws:sourceorgid",
+		"aws:sourceorgpaths",
+		"aws:sourceowner",
+		"aws:sourcevpc",
+		"aws:sourcevpcarn",
+		"aws:sourcevpce",
+		"aws:tagkeys",
+		"aws:tokenissuetime",
+		"aws:useragent",
+		"aws:userid",
+		"aws:username",
+		"aws:viaawsservice",
+		"aws:vpceaccount",
+		"aws:vpceorgid",
+		"aws:vpceorgpaths",
+		"aws:vpcsourceip",
+	}
+	policyLintGlobalConditionKeyPrefixes = []string{
+		"aws:principaltag/",
+		"aws:requesttag/",
+		"aws:resourcetag/",
+	}
+)
+
+// PolicyLintFinding is a single issue found by LintPolicy.
+type PolicyLintFinding struct {
+	Code        string
+	FindingType string
+	Message     string
+	Sid         string
+	// StatementIndex is the zero-based index of the statement the finding relates to, or -1 for document-level findings.
+	StatementIndex int64
+}
+
+func (f PolicyLintFinding) String() string {
+	if f.StatementIndex < 0 {
+		return fmt.Sprintf("%s: %s", f.Code, f.Message)
+	}
+
+	if f.Sid != "" {
+		return fmt.Sprintf("%s: Statement[%d] (%s): %s", f.Code, f.StatementIndex, f.Sid, f.Message)
+	}
+
+	return fmt.Sprintf("%s: Statement[%d]: %s", f.Code, f.StatementIndex, f.Message)
+}
+
+// LintPolicy statically analyzes an IAM policy document without calling any AWS APIs.
+// policyType is one of the PolicyLintType values and determines the maximum document size.
+func LintPolicy(document, policyType string) ([]PolicyLintFinding, error) {
+	doc, err := policyLintUnmarshal(document)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []PolicyLintFinding
+
+	if v, ok := policyLintSizeLimits[policyType]; ok {
+		length := len(document)
+		if v.excludeWhitespace {
+			length = len(strings.Join(strings.FieldsFunc(document, unicode.IsSpace), ""))
+		}
+
+		if length > v.maxLength {
+			findings = append(findings, PolicyLintFinding{
+				Code:           policyLintCodePolicySizeExceeded,
+				FindingType:    policyLintFindingTypeError,
+				Message:        fmt.Sprintf("policy document is %d characters, exceeding the %d character limit for %s policies", length, v.maxLength, policyType),
+				StatementIndex: -1,
+			})
+		}
+	}
+
+	sids := make(map[string]int)
+
+	for i, statement := range doc.Statements {
+		if statement == nil {
+			continue
+		}
+
+		newFinding := func(code, findingType, format string, a ...any) PolicyLintFinding {
+			return PolicyLintFinding{
+				Code:           code,
+				FindingType:    findingType,
+				Message:        fmt.Sprintf(format, a...),
+				Sid:            statement.Sid,
+				StatementIndex: int64(i),
+			}
+		}
+
+		if sid := statement.Sid; sid != "" {
+			if j, ok := sids[sid]; ok {
+				findings = append(findings, newFinding(policyLintCodeDuplicateSid, policyLintFindingTypeError, "Sid %q is also used by Statement[%d]", sid, j))
+			} else {
+				sids[sid] = i
+			}
+		}
+
+		isAllow := strings.EqualFold(statement.Effect, "Allow")
+
+		if isAllow && statement.NotActions != nil {
+			findings = append(findings, newFinding(policyLintCodeNotActionWithAllow, policyLintFindingTypeSecurityWarning, "NotAction with Effect Allow grants every action not listed, including actions added to AWS in the future"))
+		}
+
+		if isAllow && slices.Contains(policyLintStrings(statement.Resources), "*") {
+			for _, action := range policyLintStrings(statement.Actions) {
+				if action == "*" || action == "*:*" {
+					findings = append(findings, newFinding(policyLintCodeWildcardActionAndResource, policyLintFindingTypeSecurityWarning, "Action %q with Resource \"*\" grants full access to all resources", action))
+				} else if regexache.MustCompile(`^[0-9a-z-]+:\*$`).MatchString(action) {
+					findings = append(findings, newFinding(policyLintCodeWildcardActionAndResource, policyLintFindingTypeSecurityWarning, "Action %q with Resource \"*\" grants all %s actions on all resources", action, strings.TrimSuffix(action, ":*")))
+				}
+			}
+		}
+
+		for _, condition := range statement.Conditions {
+			if !policyLintValidConditionOperator(condition.Test) {
+				findings = append(findings, newFinding(policyLintCodeInvalidConditionOperator, policyLintFindingTypeError, "condition operator %q is not valid", condition.Test))
+			}
+
+			if !policyLintKnownGlobalConditionKey(condition.Variable) {
+				findings = append(findings, newFinding(policyLintCodeUnknownGlobalConditionKey, policyLintFindingTypeWarning, "%q is not a known AWS global condition key", condition.Variable))
+			}
+		}
+	}
+
+	slices.SortStableFunc(findings, func(a, b PolicyLintFinding) int {
+		if a.StatementIndex != b.StatementIndex {
+			return int(a.StatementIndex - b.StatementIndex)
+		}
+		if a.Code != b.Code {
+			return strings.Compare(a.Code, b.Code)
+		}
+		return strings.Compare(a.Message, b.Message)
+	})
+
+	return findings, nil
+}
+
+// PolicyLintTypeForAttribute returns the policy type used to lint the specified resource attribute.
+func PolicyLintTypeForAttribute(typeName, attrName string) string {
+	switch {
+	case typeName == "aws_iam_group_policy" && attrName == names.AttrPolicy:
+		return PolicyLintTypeGroupInline
+	case typeName == "aws_iam_policy" && attrName == names.AttrPolicy:
+		return PolicyLintTypeManaged
+	case typeName == "aws_iam_role" && attrName == "assume_role_policy":
+		return PolicyLintTypeRoleTrust
+	case typeName == "aws_iam_role" && attrName == names.AttrPolicy: // inline_policy.policy
+		return PolicyLintTypeRoleInline
+	case typeName == "aws_iam_role_policy" && attrName == names.AttrPolicy:
+		return PolicyLintTypeRoleInline
+	case typeName == "aws_iam_user_policy" && attrName == names.AttrPolicy:
+		return PolicyLintTypeUserInline
+	case typeName == "aws_organizations_policy":
+		return PolicyLintTypeServiceControl
+	case strings.HasPrefix(typeName, "aws_iam_"):
+		return PolicyLintTypeManaged
+	default:
+		return PolicyLintTypeResource
+	}
+}
+
+func policyLintUnmarshal(document string) (*IAMPolicyDoc, error) {
+	var doc IAMPolicyDoc
+
+	if err := json.Unmarshal([]byte(document), &doc); err == nil {
+		return &doc, nil
+	}
+
+	// Statement may be a single object rather than an array.
+	var v struct {
+		Version   string
+		Id        string
+		Statement *IAMPolicyStatement
+	}
+
+	if err := json.Unmarshal([]byte(document), &v); err != nil {
+		return nil, fmt.Errorf("parsing policy document: %w", err)
+	}
+
+	doc = IAMPolicyDoc{
+		Version: v.Version,
+		Id:      v.Id,
+	}
+	if v.Statement != nil {
+		doc.Statements = []*IAMPolicyStatement{v.Statement}
+	}
+
+	return &doc, nil
+}
+
+func policyLintStrings(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var s []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+		return s
+	default:
+		return nil
+	}
+}
+
+func policyLintValidConditionOperator(operator string) bool {
+	operator = strings.ToLower(operator)
+
+	for _, prefix := range []string{"forallvalues:", "foranyvalue:"} {
+		if v, ok := strings.CutPrefix(operator, prefix); ok {
+			operator = v
+			break
+		}
+	}
+
+	if v, ok := strings.CutSuffix(operator, "ifexists"); ok && v != "null" {
+		operator = v
+	}
+
+	return slices.Contains(policyLintConditionOperators, operator)
+}
+
+func policyLintKnownGlobalConditionKey(key string) bool {
+	key = strings.ToLower(key)
+
+	if !strings.HasPrefix(key, "aws:") {
+		// Service-specific condition key.
+		return true
+	}
+
+	if slices.Contains(policyLintGlobalConditionKeys, key) {
+		return true
+	}
+
+	return slices.ContainsFunc(policyLintGlobalConditionKeyPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(key, prefix)
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_iam_policy_lint", name="Policy Lint")
+func newPolicyLintDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &policyLintDataSource{}, nil
+}
+
+type policyLintDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*policyLintDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_iam_policy_lint"
+}
+
+func (d *policyLintDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Required:   true,
+			},
+			"policy_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(PolicyLintType_Values()...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"findings": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[policyLintFindingModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							Computed: true,
+						},
+						"finding_type": schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrMessage: schema.StringAttribute{
+							Computed: true,
+						},
+						"sid": schema.StringAttribute{
+							Computed: true,
+						},
+						"statement_index": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *policyLintDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data policyLintDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.PolicyType.IsNull() {
+		data.PolicyType = types.StringValue(PolicyLintTypeManaged)
+	}
+
+	document := data.Policy.ValueString()
+	findings, err := LintPolicy(document, data.PolicyType.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("linting IAM policy", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, findings, &data.Findings)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(strconv.Itoa(create.StringHashcode(document)))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type policyLintDataSourceModel struct {
+	Findings   fwtypes.ListNestedObjectValueOf[policyLintFindingModel] `tfsdk:"findings"`
+	ID         types.String                                            `tfsdk:"id"`
+	Policy     fwtypes.IAMPolicy                                       `tfsdk:"policy"`
+	PolicyType types.String                                            `tfsdk:"policy_type"`
+}
+
+type policyLintFindingModel struct {
+	Code           types.String `tfsdk:"code"`
+	FindingType    types.String `tfsdk:"finding_type"`
+	Message        types.String `tfsdk:"message"`
+	Sid            types.String `tfsdk:"sid"`
+	StatementIndex types.Int64  `tfsdk:"statement_index"`
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIAMPolicyLintDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_lint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyLintDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.code", "WILDCARD_ACTION_AND_RESOURCE"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.finding_type", "SECURITY_WARNING"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.sid", "All"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.statement_index", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.1.code", "DUPLICATE_SID"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.1.statement_index", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "policy_type", "managed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyLintDataSource_clean(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_lint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyLintDataSourceConfig_clean,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "policy_type", "role_trust"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyLintDataSourceConfig_basic = `
+data "aws_iam_policy_lint" "test" {
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid      = "All"
+      Effect   = "Allow"
+      Action   = "*"
+      Resource = "*"
+      }, {
+      Sid      = "All"
+      Effect   = "Deny"
+      Action   = "iam:*"
+      Resource = "*"
+    }]
+  })
+}
+`
+
+const testAccPolicyLintDataSourceConfig_clean = `
+data "aws_iam_policy_lint" "test" {
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = "ec2.amazonaws.com"
+      }
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = "123456789012"
+        }
+      }
+    }]
+  })
+  policy_type = "role_trust"
+}
+`
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestLintPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		document      string
+		policyType    string
+		expectedCodes []string
+		expectedErr   bool
+	}{
+		"invalid JSON": {
+			document:    `{`,
+			policyType:  tfiam.PolicyLintTypeManaged,
+			expectedErr: true,
+		},
+		"clean": {
+			document: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "Read",
+    "Effect": "Allow",
+    "Action": ["s3:GetObject", "s3:ListBucket"],
+    "Resource": ["arn:aws:s3:::example", "arn:aws:s3:::example/*"],
+    "Condition": {
+      "ForAnyValue:StringLikeIfExists": {"aws:PrincipalTag/team": "storage"},
+      "Bool": {"aws:SecureTransport": "true"},
+      "NumericLessThanEquals": {"s3:max-keys": [10]}
+    }
+  }]
+}`,
+			policyType: tfiam.PolicyLintTypeManaged,
+		},
+		"single statement": {
+			document:      `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": "*", "Resource": "*"}}`,
+			policyType:    tfiam.PolicyLintTypeManaged,
+			expectedCodes: []string{"WILDCARD_ACTION_AND_RESOURCE"},
+		},
+		"service wildcard": {
+			document:      `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": ["s3:*", "ec2:Describe*"], "Resource": "*"}]}`,
+			policyType:    tfiam.PolicyLintTypeManaged,
+			expectedCodes: []string{"WILDCARD_ACTION_AND_RESOURCE"},
+		},
+		"deny wildcard": {
+			document:   `{"Version": "2012-10-17", "Statement": [{"Effect": "Deny", "Action": "*", "Resource": "*"}]}`,
+			policyType: tfiam.PolicyLintTypeServiceControl,
+		},
+		"not action": {
+			document:      `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "NotAction": "iam:*", "Resource": "arn:aws:s3:::example"}]}`,
+			policyType:    tfiam.PolicyLintTypeManaged,
+			expectedCodes: []string{"NOT_ACTION_WITH_ALLOW"},
+		},
+		"conditions": {
+			document: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Deny",
+    "Action": "s3:*",
+    "Resource": "*",
+    "Condition": {
+      "StringEqualz": {"aws:SourceVpce": "vpce-12345678"},
+      "NullIfExists": {"aws:SourceIp": "true"},
+      "StringEquals": {"aws:SourceVPCE": "vpce-12345678", "aws:RequestedRegions": "us-west-2"}
+    }
+  }]
+}`,
+			policyType:    tfiam.PolicyLintTypeResource,
+			expectedCodes: []string{"INVALID_CONDITION_OPERATOR", "INVALID_CONDITION_OPERATOR", "UNKNOWN_GLOBAL_CONDITION_KEY"},
+		},
+		"duplicate Sid": {
+			document:      `{"Version": "2012-10-17", "Statement": [{"Sid": "A", "Effect": "Deny", "Action": "s3:GetObject", "Resource": "*"}, {"Sid": "A", "Effect": "Deny", "Action": "s3:PutObject", "Resource": "*"}]}`,
+			policyType:    tfiam.PolicyLintTypeManaged,
+			expectedCodes: []string{"DUPLICATE_SID"},
+		},
+		"user inline size": {
+			document:      `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::` + strings.Repeat("a", 2048) + `"}]}`,
+			policyType:    tfiam.PolicyLintTypeUserInline,
+			expectedCodes: []string{"POLICY_SIZE_EXCEEDED"},
+		},
+		"role inline size": {
+			document:   `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::` + strings.Repeat("a", 2048) + `"}]}`,
+			policyType: tfiam.PolicyLintTypeRoleInline,
+		},
+		"whitespace excluded": {
+			document:   `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::example"}]}` + strings.Repeat(" ", 2048),
+			policyType: tfiam.PolicyLintTypeUserInline,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, err := tfiam.LintPolicy(testCase.document, testCase.policyType)
+
+			if got, want := err != nil, testCase.expectedErr; got != want {
+				t.Fatalf("LintPolicy() err %t, want %t: %v", got, want, err)
+			}
+
+			var codes []string
+			for _, v := range findings {
+				codes = append(codes, v.Code)
+			}
+
+			if diff := cmp.Diff(codes, testCase.expectedCodes); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s\n%v", diff, findings)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkResource("aws_iam_role_policy_attachments_exclusive", name="Role Policy Attachments Exclusive")
+func newRolePolicyAttachmentsExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &rolePolicyAttachmentsExclusiveResource{}, nil
+}
+
+type rolePolicyAttachmentsExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (*rolePolicyAttachmentsExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_role_policy_attachments_exclusive"
+}
+
+func (r *rolePolicyAttachmentsExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"policy_arns": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"role_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *rolePolicyAttachmentsExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data rolePolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	roleName := data.RoleName.ValueString()
+	if err := syncRolePolicyAttachments(ctx, conn, roleName, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IAM Role Policy Attachments Exclusive (%s)", roleName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *rolePolicyAttachmentsExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data rolePolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	roleName := data.RoleName.ValueString()
+	output, err := findRoleAttachedPolicies(ctx, conn, roleName)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IAM Role Policy Attachments Exclusive (%s)", roleName), err.Error())
+
+		return
+	}
+
+	data.PolicyARNs = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *rolePolicyAttachmentsExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new rolePolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	if !new.PolicyARNs.Equal(old.PolicyARNs) {
+		roleName := new.RoleName.ValueString()
+		if err := syncRolePolicyAttachments(ctx, conn, roleName, fwflex.ExpandFrameworkStringValueSet(ctx, new.PolicyARNs)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IAM Role Policy Attachments Exclusive (%s)", roleName), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *rolePolicyAttachmentsExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("role_name"), request, response)
+}
+
+// syncRolePolicyAttachments attaches the managed policies in want to the IAM role and detaches any others.
+func syncRolePolicyAttachments(ctx context.Context, conn *iam.Client, roleName string, want []string) error {
+	have, err := findRoleAttachedPolicies(ctx, conn, roleName)
+
+	if err != nil {
+		return fmt.Errorf("reading IAM Role (%s) attached policies: %w", roleName, err)
+	}
+
+	attach, detach, _ := flex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+
+	for _, policyARN := range attach {
+		if err := attachPolicyToRole(ctx, conn, roleName, policyARN); err != nil {
+			return err
+		}
+	}
+
+	for _, policyARN := range detach {
+		if err := detachPolicyFromRole(ctx, conn, roleName, policyARN); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type rolePolicyAttachmentsExclusiveResourceModel struct {
+	PolicyARNs types.Set    `tfsdk:"policy_arns"`
+	RoleName   types.String `tfsdk:"role_name"`
+}
@@ -15,11 +15,45 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newPoliciesDataSource,
+			Name:    "Policies",
+		},
+		{
+			Factory: newPolicyLintDataSource,
+			Name:    "Policy Lint",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newGroupPoliciesExclusiveResource,
+			Name:    "Group Policies Exclusive",
+		},
+		{
+			Factory: newGroupPolicyAttachmentsExclusiveResource,
+			Name:    "Group Policy Attachments Exclusive",
+		},
+		{
+			Factory: newRolePoliciesExclusiveResource,
+			Name:    "Role Policies Exclusive",
+		},
+		{
+			Factory: newRolePolicyAttachmentsExclusiveResource,
+			Name:    "Role Policy Attachments Exclusive",
+		},
+		{
+			Factory: newUserPoliciesExclusiveResource,
+			Name:    "User Policies Exclusive",
+		},
+		{
+			Factory: newUserPolicyAttachmentsExclusiveResource,
+			Name:    "User Policy Attachments Exclusive",
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
@@ -505,6 +505,11 @@ func resourceStreamImport(ctx context.Context, d *schema.ResourceData, meta inte
 	return []*schema.ResourceData{d}, nil
 }
 
+// streamImportID returns the ID with which the stream described by d is imported, its name.
+func streamImportID(d *schema.ResourceData) (string, error) {
+	return d.Get(names.AttrName).(string), nil
+}
+
 func findStreamByName(ctx context.Context, conn *kinesis.Client, name string) (*types.StreamDescriptionSummary, error) {
 	input := &kinesis.DescribeStreamSummaryInput{
 		StreamName: aws.String(name),
@@ -17,7 +17,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_awstypes.application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_awstypes.application", &resource.Sweeper{
 		Name: "aws_awstypes.application",
 		F:    sweepApplication,
 	})
@@ -62,7 +62,7 @@ func sweepApplication(region string) error {
 			d.Set("create_timestamp", aws.ToTime(application.CreateTimestamp).Format(time.RFC3339))
 			d.Set(names.AttrName, name)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithPassthroughImportID()))
 		}
 	}
 
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_kms_keys", name="Keys")
+func newKeysDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &keysDataSource{}, nil
+}
+
+type keysDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*keysDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_kms_keys"
+}
+
+func (d *keysDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"alias_name_regex": schema.StringAttribute{
+				CustomType: fwtypes.RegexpType,
+				Optional:   true,
+			},
+			names.AttrARNs: schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrIDs: schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrTags: tftags.TagsAttribute(),
+		},
+	}
+}
+
+func (d *keysDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data keysDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().KMSClient(ctx)
+
+	// Key ID to alias names.
+	var aliasNames map[string][]string
+	if !data.AliasNameRegex.IsNull() {
+		aliasNames = make(map[string][]string)
+
+		pages := kms.NewListAliasesPaginator(conn, &kms.ListAliasesInput{})
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				response.Diagnostics.AddError("listing KMS Aliases", err.Error())
+
+				return
+			}
+
+			for _, v := range page.Aliases {
+				if keyID := aws.ToString(v.TargetKeyId); keyID != "" {
+					aliasNames[keyID] = append(aliasNames[keyID], aws.ToString(v.AliasName))
+				}
+			}
+		}
+	}
+
+	var arns, keyIDs []string
+	tagsToMatch := tftags.New(ctx, data.Tags)
+
+	pages := kms.NewListKeysPaginator(conn, &kms.ListKeysInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			response.Diagnostics.AddError("listing KMS Keys", err.Error())
+
+			return
+		}
+
+		for _, v := range page.Keys {
+			keyARN, keyID := aws.ToString(v.KeyArn), aws.ToString(v.KeyId)
+			if aliasNames != nil && !slices.ContainsFunc(aliasNames[keyID], data.AliasNameRegex.ValueRegexp().MatchString) {
+				continue
+			}
+
+			if len(tagsToMatch) > 0 {
+				tags, err := listTags(ctx, conn, keyID)
+
+				if err != nil {
+					response.Diagnostics.AddError(fmt.Sprintf("listing tags for KMS Key (%s)", keyID), err.Error())
+
+					return
+				}
+
+				if !tags.ContainsAll(tagsToMatch) {
+					continue
+				}
+			}
+
+			arns = append(arns, keyARN)
+			keyIDs = append(keyIDs, keyID)
+		}
+	}
+
+	data.ARNs = flex.FlattenFrameworkStringValueListLegacy(ctx, arns)
+	data.ID = types.StringValue(d.Meta().RegionForContext(ctx))
+	data.IDs = flex.FlattenFrameworkStringValueListLegacy(ctx, keyIDs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type keysDataSourceModel struct {
+	AliasNameRegex fwtypes.Regexp `tfsdk:"alias_name_regex"`
+	ARNs           types.List     `tfsdk:"arns"`
+	ID             types.String   `tfsdk:"id"`
+	IDs            types.List     `tfsdk:"ids"`
+	Tags           types.Map      `tfsdk:"tags"`
+}
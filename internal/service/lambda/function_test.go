@@ -39,6 +39,40 @@ func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
 	)
 }
 
+func TestInvokeARN_regionOverride(t *testing.T) {
+	t.Parallel()
+
+	client := &conns.AWSClient{
+		Partition: names.StandardPartitionID,
+		Region:    names.USWest2RegionID,
+	}
+	functionARN := "arn:aws:lambda:eu-west-1:123456789012:function:test" // lintignore:AWSAT003,AWSAT005 // unit test
+
+	testCases := map[string]struct {
+		ctx      context.Context
+		expected string
+	}{
+		"no override": {
+			ctx:      context.Background(),
+			expected: "arn:aws:apigateway:us-west-2:lambda:path/2015-03-31/functions/" + functionARN + "/invocations", // lintignore:AWSAT003,AWSAT005 // unit test
+		},
+		"override": {
+			ctx:      conns.NewRegionContext(context.Background(), names.EUWest1RegionID),
+			expected: "arn:aws:apigateway:eu-west-1:lambda:path/2015-03-31/functions/" + functionARN + "/invocations", // lintignore:AWSAT003,AWSAT005 // unit test
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tflambda.InvokeARN(testCase.ctx, client, functionARN); got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
 func TestAccLambdaFunction_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf lambda.GetFunctionOutput
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(name="Invocation")
+func newInvocationEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &invocationEphemeralResource{}, nil
+}
+
+type invocationEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *invocationEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_lambda_invocation"
+}
+
+func (e *invocationEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"client_context": schema.StringAttribute{
+				Optional: true,
+			},
+			"executed_version": schema.StringAttribute{
+				Computed: true,
+			},
+			"function_name": schema.StringAttribute{
+				Required: true,
+			},
+			"log_result": schema.StringAttribute{
+				Computed: true,
+			},
+			"log_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LogType](),
+				Optional:   true,
+			},
+			"payload": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					fwvalidators.JSON(),
+				},
+			},
+			"qualifier": schema.StringAttribute{
+				Optional: true,
+			},
+			"result": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrStatusCode: schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *invocationEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data invocationEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().LambdaClient(ctx)
+
+	functionName := data.FunctionName.ValueString()
+	qualifier := FunctionVersionLatest
+	if !data.Qualifier.IsNull() && !data.Qualifier.IsUnknown() {
+		qualifier = data.Qualifier.ValueString()
+	}
+	input := &lambda.InvokeInput{
+		ClientContext:  fwflex.StringFromFramework(ctx, data.ClientContext),
+		FunctionName:   aws.String(functionName),
+		InvocationType: awstypes.InvocationTypeRequestResponse,
+		LogType:        data.LogType.ValueEnum(),
+		Payload:        []byte(data.Payload.ValueString()),
+		Qualifier:      aws.String(qualifier),
+	}
+
+	output, err := conn.Invoke(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("invoking Lambda Function (%s)", functionName), err.Error())
+
+		return
+	}
+
+	if output.FunctionError != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("invoking Lambda Function (%s)", functionName), string(output.Payload))
+
+		return
+	}
+
+	data.ExecutedVersion = fwflex.StringToFramework(ctx, output.ExecutedVersion)
+	data.LogResult = fwflex.StringToFramework(ctx, output.LogResult)
+	data.Result = fwflex.StringValueToFramework(ctx, string(output.Payload))
+	data.StatusCode = types.Int64Value(int64(output.StatusCode))
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type invocationEphemeralResourceModel struct {
+	ClientContext   types.String                         `tfsdk:"client_context"`
+	ExecutedVersion types.String                         `tfsdk:"executed_version"`
+	FunctionName    types.String                         `tfsdk:"function_name"`
+	LogResult       types.String                         `tfsdk:"log_result"`
+	LogType         fwtypes.StringEnum[awstypes.LogType] `tfsdk:"log_type"`
+	Payload         types.String                         `tfsdk:"payload"`
+	Qualifier       types.String                         `tfsdk:"qualifier"`
+	Result          types.String                         `tfsdk:"result"`
+	StatusCode      types.Int64                          `tfsdk:"status_code"`
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaInvocationEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	testData := "value3"
+	echoResourceName := "echo.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.LambdaServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6EchoProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvocationEphemeralConfig_basic(rName, testData),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(echoResourceName, "data.executed_version", "$LATEST"),
+					resource.TestCheckResourceAttr(echoResourceName, "data.result", `{"key1":"value1","key2":"value2","key3":"`+testData+`"}`),
+					resource.TestCheckResourceAttr(echoResourceName, "data.status_code", "200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInvocationEphemeralConfig_basic(rName, testData string) string {
+	return acctest.ConfigCompose(testAccInvocationDataSource_base_config(rName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  depends_on = [aws_iam_role_policy_attachment.lambda_role_policy]
+
+  filename      = "test-fixtures/lambda_invocation.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.lambda_role.arn
+  handler       = "lambda_invocation.handler"
+  runtime       = "nodejs18.x"
+
+  environment {
+    variables = {
+      TEST_DATA = %[2]q
+    }
+  }
+}
+
+ephemeral "aws_lambda_invocation" "test" {
+  function_name = aws_lambda_function.test.function_name
+
+  payload = jsonencode({
+    key1 = "value1"
+    key2 = "value2"
+  })
+}
+
+provider "echo" {
+  data = ephemeral.aws_lambda_invocation.test
+}
+
+resource "echo" "test" {}
+`, rName, testData))
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_lambda_layer_versions", name="Layer Versions")
+func newLayerVersionsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &layerVersionsDataSource{}, nil
+}
+
+type layerVersionsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*layerVersionsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_lambda_layer_versions"
+}
+
+func (d *layerVersionsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"compatible_architecture": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Architecture](),
+				Optional:   true,
+			},
+			"compatible_runtime": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Runtime](),
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"layer_name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"layer_versions": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[layerVersionModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"compatible_architectures": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"compatible_runtimes": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						names.AttrCreatedDate: schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Computed: true,
+						},
+						"layer_version_arn": schema.StringAttribute{
+							Computed: true,
+						},
+						"license_info": schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrVersion: schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *layerVersionsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data layerVersionsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().LambdaClient(ctx)
+
+	layerName := data.LayerName.ValueString()
+	input := &lambda.ListLayerVersionsInput{
+		CompatibleArchitecture: data.CompatibleArchitecture.ValueEnum(),
+		CompatibleRuntime:      data.CompatibleRuntime.ValueEnum(),
+		LayerName:              fwflex.StringFromFramework(ctx, data.LayerName),
+	}
+
+	var layerVersions []awstypes.LayerVersionsListItem
+
+	pages := lambda.NewListLayerVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("listing Lambda Layer (%s) Versions", layerName), err.Error())
+
+			return
+		}
+
+		layerVersions = append(layerVersions, page.LayerVersions...)
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, layerVersions, &data.LayerVersions)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(layerName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type layerVersionsDataSourceModel struct {
+	CompatibleArchitecture fwtypes.StringEnum[awstypes.Architecture]          `tfsdk:"compatible_architecture"`
+	CompatibleRuntime      fwtypes.StringEnum[awstypes.Runtime]               `tfsdk:"compatible_runtime"`
+	ID                     types.String                                       `tfsdk:"id"`
+	LayerName              types.String                                       `tfsdk:"layer_name"`
+	LayerVersions          fwtypes.ListNestedObjectValueOf[layerVersionModel] `tfsdk:"layer_versions"`
+}
+
+type layerVersionModel struct {
+	CompatibleArchitectures fwtypes.ListValueOf[types.String] `tfsdk:"compatible_architectures"`
+	CompatibleRuntimes      fwtypes.ListValueOf[types.String] `tfsdk:"compatible_runtimes"`
+	CreatedDate             types.String                      `tfsdk:"created_date"`
+	Description             types.String                      `tfsdk:"description"`
+	LayerVersionARN         types.String                      `tfsdk:"layer_version_arn"`
+	LicenseInfo             types.String                      `tfsdk:"license_info"`
+	Version                 types.Int64                       `tfsdk:"version"`
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaLayerVersionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_layer_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.layer_version_arn", "aws_lambda_layer_version.python", names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.0.compatible_runtimes.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.0.compatible_runtimes.0", "python3.12"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLayerVersionsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "nodejs" {
+  filename            = "test-fixtures/lambdatest.zip"
+  layer_name          = %[1]q
+  compatible_runtimes = ["nodejs20.x"]
+}
+
+resource "aws_lambda_layer_version" "python" {
+  filename            = "test-fixtures/lambdatest.zip"
+  layer_name          = %[1]q
+  compatible_runtimes = ["python3.12"]
+
+  depends_on = [aws_lambda_layer_version.nodejs]
+}
+
+data "aws_lambda_layer_versions" "test" {
+  layer_name         = aws_lambda_layer_version.python.layer_name
+  compatible_runtime = "python3.12"
+}
+`, rName)
+}
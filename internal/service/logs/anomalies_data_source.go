@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Anomalies")
+func newAnomaliesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &anomaliesDataSource{}
+
+	return d, nil
+}
+
+type anomaliesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*anomaliesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_cloudwatch_log_anomalies"
+}
+
+func (d *anomaliesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"anomalies": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[anomalyModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[anomalyModel](ctx),
+			},
+			"anomaly_detector_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"suppression_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SuppressionState](),
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *anomaliesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data anomaliesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().LogsClient(ctx)
+
+	input := &cloudwatchlogs.ListAnomaliesInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findAnomalies(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading CloudWatch Logs Anomalies", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, &cloudwatchlogs.ListAnomaliesOutput{Anomalies: output}, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().RegionForContext(ctx))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findAnomalies(ctx context.Context, conn *cloudwatchlogs.Client, input *cloudwatchlogs.ListAnomaliesInput) ([]awstypes.Anomaly, error) {
+	var output []awstypes.Anomaly
+
+	err := listAnomaliesPages(ctx, conn, input, func(page *cloudwatchlogs.ListAnomaliesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.Anomalies...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+type anomaliesDataSourceModel struct {
+	Anomalies          fwtypes.ListNestedObjectValueOf[anomalyModel] `tfsdk:"anomalies"`
+	AnomalyDetectorARN fwtypes.ARN                                   `tfsdk:"anomaly_detector_arn"`
+	ID                 types.String                                  `tfsdk:"id"`
+	SuppressionState   fwtypes.StringEnum[awstypes.SuppressionState] `tfsdk:"suppression_state"`
+}
+
+type anomalyModel struct {
+	Active             types.Bool                         `tfsdk:"active"`
+	AnomalyDetectorARN types.String                       `tfsdk:"anomaly_detector_arn"`
+	AnomalyID          types.String                       `tfsdk:"anomaly_id"`
+	Description        types.String                       `tfsdk:"description"`
+	FirstSeen          types.Int64                        `tfsdk:"first_seen"`
+	LastSeen           types.Int64                        `tfsdk:"last_seen"`
+	LogGroupARNList    fwtypes.ListValueOf[types.String]  `tfsdk:"log_group_arn_list"`
+	PatternID          types.String                       `tfsdk:"pattern_id"`
+	PatternString      types.String                       `tfsdk:"pattern_string"`
+	Priority           types.String                       `tfsdk:"priority"`
+	State              fwtypes.StringEnum[awstypes.State] `tfsdk:"state"`
+	Suppressed         types.Bool                         `tfsdk:"suppressed"`
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLogsAnomaliesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_log_anomalies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomaliesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// A new anomaly detector is still training and has not reported any anomalies.
+					resource.TestCheckResourceAttr(dataSourceName, "anomalies.#", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(dataSourceName, "anomaly_detector_arn", "aws_cloudwatch_log_anomaly_detector.test", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccAnomaliesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAnomalyDetectorConfig_basic(rName), `
+data "aws_cloudwatch_log_anomalies" "test" {
+  anomaly_detector_arn = aws_cloudwatch_log_anomaly_detector.test.arn
+}
+`)
+}
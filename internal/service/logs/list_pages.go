@@ -12,6 +12,22 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 )
 
+func describeIndexPoliciesPages(ctx context.Context, conn *cloudwatchlogs.Client, input *cloudwatchlogs.DescribeIndexPoliciesInput, fn func(*cloudwatchlogs.DescribeIndexPoliciesOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeIndexPolicies(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
 func describeQueryDefinitionsPages(ctx context.Context, conn *cloudwatchlogs.Client, input *cloudwatchlogs.DescribeQueryDefinitionsInput, fn func(*cloudwatchlogs.DescribeQueryDefinitionsOutput, bool) bool) error {
 	for {
 		output, err := conn.DescribeQueryDefinitions(ctx, input)
@@ -44,3 +60,35 @@ func describeResourcePoliciesPages(ctx context.Context, conn *cloudwatchlogs.Cli
 	}
 	return nil
 }
+func listAnomaliesPages(ctx context.Context, conn *cloudwatchlogs.Client, input *cloudwatchlogs.ListAnomaliesInput, fn func(*cloudwatchlogs.ListAnomaliesOutput, bool) bool) error {
+	for {
+		output, err := conn.ListAnomalies(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+func listLogAnomalyDetectorsPages(ctx context.Context, conn *cloudwatchlogs.Client, input *cloudwatchlogs.ListLogAnomalyDetectorsInput, fn func(*cloudwatchlogs.ListLogAnomalyDetectorsOutput, bool) bool) error {
+	for {
+		output, err := conn.ListLogAnomalyDetectors(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
@@ -165,6 +165,19 @@ func resourceQueryDefinitionImport(ctx context.Context, d *schema.ResourceData,
 	return []*schema.ResourceData{d}, nil
 }
 
+// queryDefinitionImportID returns a function that returns the ID with which the query definition described by d is imported, its ARN.
+func queryDefinitionImportID(ctx context.Context, c *conns.AWSClient) func(*schema.ResourceData) (string, error) {
+	return func(d *schema.ResourceData) (string, error) {
+		return arn.ARN{
+			Partition: c.Partition,
+			Region:    c.RegionForContext(ctx),
+			AccountID: c.AccountID,
+			Service:   "logs",
+			Resource:  "query-definition:" + d.Id(),
+		}.String(), nil
+	}
+}
+
 func findQueryDefinitionByTwoPartKey(ctx context.Context, conn *cloudwatchlogs.Client, name, queryDefinitionID string) (*types.QueryDefinition, error) {
 	input := &cloudwatchlogs.DescribeQueryDefinitionsInput{}
 	if name != "" {
@@ -9,19 +9,50 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_log_anomaly_detector", &resource.Sweeper{
+		Name: "aws_cloudwatch_log_anomaly_detector",
+		F:    sweepAnomalyDetectors,
+	})
+
+	sweep.AddTestSweepers("aws_cloudwatch_log_delivery", &resource.Sweeper{
+		Name: "aws_cloudwatch_log_delivery",
+		F:    sweepDeliveries,
+	})
+
+	sweep.AddTestSweepers("aws_cloudwatch_log_delivery_destination", &resource.Sweeper{
+		Name: "aws_cloudwatch_log_delivery_destination",
+		F:    sweepDeliveryDestinations,
+		Dependencies: []string{
+			"aws_cloudwatch_log_delivery",
+		},
+	})
+
+	sweep.AddTestSweepers("aws_cloudwatch_log_delivery_source", &resource.Sweeper{
+		Name: "aws_cloudwatch_log_delivery_source",
+		F:    sweepDeliverySources,
+		Dependencies: []string{
+			"aws_cloudwatch_log_delivery",
+		},
+	})
+
+	sweep.AddTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
 		Name: "aws_cloudwatch_log_group",
 		F:    sweepGroups,
 		Dependencies: []string{
 			"aws_api_gateway_rest_api",
 			"aws_cloudhsm_v2_cluster",
 			"aws_cloudtrail",
+			"aws_cloudwatch_log_index_policy",
 			"aws_datasync_task",
 			"aws_db_instance",
 			"aws_directory_service_directory",
@@ -42,17 +73,181 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_query_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_log_index_policy", &resource.Sweeper{
+		Name: "aws_cloudwatch_log_index_policy",
+		F:    sweepIndexPolicies,
+	})
+
+	sweep.AddTestSweepers("aws_cloudwatch_query_definition", &resource.Sweeper{
 		Name: "aws_cloudwatch_query_definition",
 		F:    sweeplogQueryDefinitions,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_log_resource_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_log_resource_policy", &resource.Sweeper{
 		Name: "aws_cloudwatch_log_resource_policy",
 		F:    sweepResourcePolicies,
 	})
 }
 
+func sweepAnomalyDetectors(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	input := &cloudwatchlogs.ListLogAnomalyDetectorsInput{}
+	conn := client.LogsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = listLogAnomalyDetectorsPages(ctx, conn, input, func(page *cloudwatchlogs.ListLogAnomalyDetectorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AnomalyDetectors {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newAnomalyDetectorResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.AnomalyDetectorArn)),
+			))
+		}
+
+		return !lastPage
+	})
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping CloudWatch Logs Anomaly Detector sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing CloudWatch Logs Anomaly Detectors (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Logs Anomaly Detectors (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDeliveries(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	input := &cloudwatchlogs.DescribeDeliveriesInput{}
+	conn := client.LogsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := cloudwatchlogs.NewDescribeDeliveriesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Logs Delivery sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Logs Deliveries (%s): %w", region, err)
+		}
+
+		for _, v := range page.Deliveries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newDeliveryResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Id)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Logs Deliveries (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDeliveryDestinations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	input := &cloudwatchlogs.DescribeDeliveryDestinationsInput{}
+	conn := client.LogsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := cloudwatchlogs.NewDescribeDeliveryDestinationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Logs Delivery Destination sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Logs Delivery Destinations (%s): %w", region, err)
+		}
+
+		for _, v := range page.DeliveryDestinations {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newDeliveryDestinationResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Name)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Logs Delivery Destinations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDeliverySources(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	input := &cloudwatchlogs.DescribeDeliverySourcesInput{}
+	conn := client.LogsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := cloudwatchlogs.NewDescribeDeliverySourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Logs Delivery Source sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Logs Delivery Sources (%s): %w", region, err)
+		}
+
+		for _, v := range page.DeliverySources {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newDeliverySourceResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Name)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Logs Delivery Sources (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepGroups(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -94,6 +289,64 @@ func sweepGroups(region string) error {
 	return nil
 }
 
+func sweepIndexPolicies(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	input := &cloudwatchlogs.DescribeLogGroupsInput{}
+	conn := client.LogsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := cloudwatchlogs.NewDescribeLogGroupsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Logs Index Policy sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Logs Log Groups (%s): %w", region, err)
+		}
+
+		for _, v := range page.LogGroups {
+			name := aws.ToString(v.LogGroupName)
+			input := &cloudwatchlogs.DescribeIndexPoliciesInput{
+				LogGroupIdentifiers: []string{name},
+			}
+
+			policies, err := findIndexPolicies(ctx, conn, input, func(v *awstypes.IndexPolicy) bool {
+				return v.Source == awstypes.IndexSourceLogGroup
+			})
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error listing CloudWatch Logs Index Policies (%s): %w", region, err)
+			}
+
+			if len(policies) > 0 {
+				sweepResources = append(sweepResources, framework.NewSweepResource(newIndexPolicyResource, client,
+					framework.NewAttribute(names.AttrID, name),
+				))
+			}
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Logs Index Policies (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweeplogQueryDefinitions(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -114,7 +367,7 @@ func sweeplogQueryDefinitions(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.QueryDefinitionId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithImportID(queryDefinitionImportID(ctx, client))))
 		}
 
 		return !lastPage
@@ -158,7 +411,7 @@ func sweepResourcePolicies(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.PolicyName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithPassthroughImportID()))
 		}
 
 		return !lastPage
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func TestGroupSweeper_fakeAWS(t *testing.T) { //nolint:paralleltest // Registers sweepers and sets environment variables.
+	ctx := acctest.Context(t)
+	server := fakeaws.New()
+	client := acctest.FakeAWSProvider(ctx, t, server, tflogs.ServicePackage(ctx)).Meta().(*conns.AWSClient)
+	conn := client.LogsClient(ctx)
+	groupNames := []string{"/tf-fake-test/one", "/tf-fake-test/two"}
+
+	for _, name := range groupNames {
+		if _, err := conn.CreateLogGroup(ctx, &cloudwatchlogs.CreateLogGroupInput{
+			LogGroupName: aws.String(name),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Cleanup(sweep.UseSharedRegionalSweepClient(server.Region(), client))
+	tflogs.RegisterSweepers()
+
+	var out bytes.Buffer
+	if err := sweep.ImportBlocks(ctx, sweep.ImportBlocksOptions{
+		Output:        &out,
+		Regions:       []string{server.Region()},
+		ResourceTypes: []string{"aws_cloudwatch_log_group"},
+	}); err != nil {
+		t.Fatalf("generating import blocks: %s", err)
+	}
+
+	var ids []string
+	for _, m := range regexp.MustCompile(`(?m)^  id = "([^"]*)"$`).FindAllStringSubmatch(out.String(), -1) {
+		ids = append(ids, m[1])
+	}
+
+	if got, expected := len(ids), len(groupNames); got != expected {
+		t.Fatalf("incorrect number of import blocks. Expected: %d, got: %d\n%s", expected, got, out.String())
+	}
+	for i, name := range groupNames {
+		if got, expected := ids[i], name; got != expected {
+			t.Errorf("incorrect import ID. Expected: %q, got: %q", expected, got)
+		}
+	}
+
+	if err := sweep.Run(ctx, sweep.Options{
+		Regions: []string{server.Region()},
+		Run:     "aws_cloudwatch_log_group",
+	}); err != nil {
+		t.Fatalf("sweeping: %s", err)
+	}
+
+	output, err := conn.DescribeLogGroups(ctx, &cloudwatchlogs.DescribeLogGroupsInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := len(output.LogGroups); got != 0 {
+		t.Errorf("expected all log groups to be swept, %d remain", got)
+	}
+}
@@ -42,6 +42,10 @@ func (d *dataSourceDefaultTags) Schema(ctx context.Context, req datasource.Schem
 				Optional: true,
 				Computed: true,
 			},
+			names.AttrResourceType: schema.StringAttribute{
+				Optional:    true,
+				Description: "Resource type, e.g. `aws_instance`, for which to return default tags after applying any matching `default_tags` rules.",
+			},
 			names.AttrTags: tftags.TagsAttributeComputedOnly(),
 		},
 	}
@@ -59,6 +63,9 @@ func (d *dataSourceDefaultTags) Read(ctx context.Context, request datasource.Rea
 	}
 
 	defaultTagsConfig := d.Meta().DefaultTagsConfig
+	if v := data.ResourceType.ValueString(); v != "" {
+		defaultTagsConfig = defaultTagsConfig.ForResourceType(ctx, v)
+	}
 	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
 	tags := defaultTagsConfig.GetTags()
 
@@ -69,6 +76,7 @@ func (d *dataSourceDefaultTags) Read(ctx context.Context, request datasource.Rea
 }
 
 type dataSourceDefaultTagsData struct {
-	ID   types.String `tfsdk:"id"`
-	Tags types.Map    `tfsdk:"tags"`
+	ID           types.String `tfsdk:"id"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	Tags         types.Map    `tfsdk:"tags"`
 }
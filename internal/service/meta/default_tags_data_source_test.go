@@ -4,6 +4,7 @@
 package meta_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -114,6 +115,101 @@ func TestAccMetaDefaultTagsDataSource_ignore(t *testing.T) {
 	})
 }
 
+func TestAccMetaDefaultTagsDataSource_rules(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_default_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					testAccDefaultTagsDataSourceConfig_providerRules(),
+					testAccDefaultTagsDataSourceConfig_resourceType("aws_vpc"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.CostCenter", "1234"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Environment", "production"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					testAccDefaultTagsDataSourceConfig_providerRules(),
+					testAccDefaultTagsDataSourceConfig_resourceType("aws_instance"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, acctest.CtTagsPercent, acctest.Ct3),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Backup", "daily"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.CostCenter", "1234"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Environment", "production"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					testAccDefaultTagsDataSourceConfig_providerRules(),
+					testAccDefaultTagsDataSourceConfig_resourceType("aws_iam_role"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Environment", "production"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					testAccDefaultTagsDataSourceConfig_providerRules(),
+					testAccDefaultTagsDataSourceConfig_basic(),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, acctest.CtTagsPercent, acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
 func testAccDefaultTagsDataSourceConfig_basic() string {
 	return `data "aws_default_tags" "test" {}`
 }
+
+func testAccDefaultTagsDataSourceConfig_resourceType(resourceType string) string {
+	return fmt.Sprintf(`
+data "aws_default_tags" "test" {
+  resource_type = %[1]q
+}
+`, resourceType)
+}
+
+func testAccDefaultTagsDataSourceConfig_providerRules() string {
+	//lintignore:AT004
+	return `
+provider "aws" {
+  default_tags {
+    tags = {
+      CostCenter  = "1234"
+      Environment = "production"
+    }
+
+    rule {
+      resource_types = ["aws_instance"]
+
+      tags = {
+        Backup = "daily"
+      }
+    }
+
+    rule {
+      resource_types = ["aws_iam_*"]
+      exclude_keys   = ["CostCenter"]
+    }
+  }
+
+  skip_credentials_validation = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+`
+}
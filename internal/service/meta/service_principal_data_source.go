@@ -76,7 +76,7 @@ func (d *servicePrincipalDataSource) Read(ctx context.Context, request datasourc
 
 	// Default to provider current region if no other filters matched
 	if region == nil {
-		matchingRegion, err := FindRegionByName(d.Meta().Region)
+		matchingRegion, err := FindRegionByName(d.Meta().RegionForContext(ctx))
 
 		if err != nil {
 			response.Diagnostics.AddError("finding Region using the provider", err.Error())
@@ -7,7 +7,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
@@ -31,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -259,9 +259,8 @@ func (r *resourceSecurityConfig) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *resourceSecurityConfig) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, idSeparator)
-	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
-		err := fmt.Errorf("unexpected format for ID (%[1]s), expected saml/account-id/name", req.ID)
+	parts, err := securityConfigIdentity.Split(req.ID)
+	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("importing Security Policy (%s)", req.ID), err.Error())
 		return
 	}
@@ -270,6 +269,9 @@ func (r *resourceSecurityConfig) ImportState(ctx context.Context, req resource.I
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrName), parts[2])...)
 }
 
+// securityConfigIdentity describes the security configuration's ID, e.g. `saml/123456789012/example`.
+var securityConfigIdentity = identity.New(idSeparator, names.AttrType, "account_id", names.AttrName)
+
 type resourceSecurityConfigData struct {
 	ID            types.String `tfsdk:"id"`
 	ConfigVersion types.String `tfsdk:"config_version"`
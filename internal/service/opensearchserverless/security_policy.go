@@ -7,7 +7,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
@@ -26,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -35,6 +35,8 @@ func newResourceSecurityPolicy(_ context.Context) (resource.ResourceWithConfigur
 	return &resourceSecurityPolicy{}, nil
 }
 
+var securityPolicyIdentity = identity.New(idSeparator, names.AttrName, names.AttrType)
+
 type resourceSecurityPolicyData struct {
 	Description   types.String `tfsdk:"description"`
 	ID            types.String `tfsdk:"id"`
@@ -227,9 +229,8 @@ func (r *resourceSecurityPolicy) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *resourceSecurityPolicy) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, idSeparator)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		err := fmt.Errorf("unexpected format for ID (%[1]s), expected security-policy-name%[2]ssecurity-policy-type", req.ID, idSeparator)
+	parts, err := securityPolicyIdentity.Split(req.ID)
+	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("importing Security Policy (%s)", req.ID), err.Error())
 		return
 	}
@@ -6,14 +6,11 @@ package quicksight
 import (
 	"context"
 	"errors"
-	"fmt"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -24,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -31,7 +29,10 @@ import (
 // Function annotations are used for resource registration to the Provider. DO NOT EDIT.
 // @FrameworkResource(name="Folder Membership")
 func newResourceFolderMembership(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &resourceFolderMembership{}, nil
+	r := &resourceFolderMembership{}
+	r.SetIdentity(folderMembershipIdentity)
+
+	return r, nil
 }
 
 const (
@@ -40,6 +41,7 @@ const (
 
 type resourceFolderMembership struct {
 	framework.ResourceWithConfigure
+	framework.WithImportByIdentity
 }
 
 func (r *resourceFolderMembership) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -200,10 +202,6 @@ func (r *resourceFolderMembership) Delete(ctx context.Context, req resource.Dele
 	}
 }
 
-func (r *resourceFolderMembership) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
-}
-
 func FindFolderMembershipByID(ctx context.Context, conn *quicksight.QuickSight, id string) (*quicksight.MemberIdArnPair, error) {
 	awsAccountID, folderID, _, memberID, err := ParseFolderMembershipID(id)
 	if err != nil {
@@ -245,16 +243,19 @@ func FindFolderMembershipByID(ctx context.Context, conn *quicksight.QuickSight,
 	}
 }
 
+var folderMembershipIdentity = identity.New(",", names.AttrAWSAccountID, "folder_id", "member_type", "member_id").WithSeparatorInFinalValue()
+
 func ParseFolderMembershipID(id string) (string, string, string, string, error) {
-	parts := strings.SplitN(id, ",", 4)
-	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
-		return "", "", "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID,FOLDER_ID,MEMBER_TYPE,MEMBER_ID", id)
+	parts, err := folderMembershipIdentity.Split(id)
+	if err != nil {
+		return "", "", "", "", err
 	}
+
 	return parts[0], parts[1], parts[2], parts[3], nil
 }
 
 func createFolderMembershipID(awsAccountID, folderID, memberType, memberID string) string {
-	return strings.Join([]string{awsAccountID, folderID, memberType, memberID}, ",")
+	return folderMembershipIdentity.ID(awsAccountID, folderID, memberType, memberID)
 }
 
 type resourceFolderMembershipData struct {
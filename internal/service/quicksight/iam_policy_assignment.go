@@ -6,8 +6,6 @@ package quicksight
 import (
 	"context"
 	"errors"
-	"fmt"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
@@ -16,7 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -28,13 +25,17 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @FrameworkResource(name="IAM Policy Assignment")
 func newResourceIAMPolicyAssignment(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &resourceIAMPolicyAssignment{}, nil
+	r := &resourceIAMPolicyAssignment{}
+	r.SetIdentity(iamPolicyAssignmentIdentity)
+
+	return r, nil
 }
 
 const (
@@ -47,6 +48,7 @@ const (
 
 type resourceIAMPolicyAssignment struct {
 	framework.ResourceWithConfigure
+	framework.WithImportByIdentity
 }
 
 func (r *resourceIAMPolicyAssignment) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -315,10 +317,6 @@ func (r *resourceIAMPolicyAssignment) Delete(ctx context.Context, req resource.D
 	}
 }
 
-func (r *resourceIAMPolicyAssignment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
-}
-
 func FindIAMPolicyAssignmentByID(ctx context.Context, conn *quicksight.QuickSight, id string) (*quicksight.IAMPolicyAssignment, error) {
 	awsAccountID, namespace, assignmentName, err := ParseIAMPolicyAssignmentID(id)
 	if err != nil {
@@ -350,16 +348,19 @@ func FindIAMPolicyAssignmentByID(ctx context.Context, conn *quicksight.QuickSigh
 	return out.IAMPolicyAssignment, nil
 }
 
+var iamPolicyAssignmentIdentity = identity.New(",", names.AttrAWSAccountID, names.AttrNamespace, "assignment_name").WithSeparatorInFinalValue()
+
 func ParseIAMPolicyAssignmentID(id string) (string, string, string, error) {
-	parts := strings.SplitN(id, ",", 3)
-	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
-		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID,NAMESPACE,ASSIGNMENT_NAME", id)
+	parts, err := iamPolicyAssignmentIdentity.Split(id)
+	if err != nil {
+		return "", "", "", err
 	}
+
 	return parts[0], parts[1], parts[2], nil
 }
 
 func createIAMPolicyAssignmentID(awsAccountID, namespace, assignmentName string) string {
-	return fmt.Sprintf("%s,%s,%s", awsAccountID, namespace, assignmentName)
+	return iamPolicyAssignmentIdentity.ID(awsAccountID, namespace, assignmentName)
 }
 
 var (
@@ -6,14 +6,11 @@ package quicksight
 import (
 	"context"
 	"errors"
-	"fmt"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -24,13 +21,17 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @FrameworkResource(name="Ingestion")
 func newResourceIngestion(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &resourceIngestion{}, nil
+	r := &resourceIngestion{}
+	r.SetIdentity(ingestionIdentity)
+
+	return r, nil
 }
 
 const (
@@ -39,6 +40,7 @@ const (
 
 type resourceIngestion struct {
 	framework.ResourceWithConfigure
+	framework.WithImportByIdentity
 }
 
 func (r *resourceIngestion) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -201,10 +203,6 @@ func (r *resourceIngestion) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
-func (r *resourceIngestion) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
-}
-
 func FindIngestionByID(ctx context.Context, conn *quicksight.QuickSight, id string) (*quicksight.Ingestion, error) {
 	awsAccountID, dataSetID, ingestionID, err := ParseIngestionID(id)
 	if err != nil {
@@ -236,16 +234,19 @@ func FindIngestionByID(ctx context.Context, conn *quicksight.QuickSight, id stri
 	return out.Ingestion, nil
 }
 
+var ingestionIdentity = identity.New(",", names.AttrAWSAccountID, "data_set_id", "ingestion_id").WithSeparatorInFinalValue()
+
 func ParseIngestionID(id string) (string, string, string, error) {
-	parts := strings.SplitN(id, ",", 3)
-	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
-		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID,DATA_SET_ID,INGESTION_ID", id)
+	parts, err := ingestionIdentity.Split(id)
+	if err != nil {
+		return "", "", "", err
 	}
+
 	return parts[0], parts[1], parts[2], nil
 }
 
 func createIngestionID(awsAccountID, dataSetID, ingestionID string) string {
-	return fmt.Sprintf("%s,%s,%s", awsAccountID, dataSetID, ingestionID)
+	return ingestionIdentity.ID(awsAccountID, dataSetID, ingestionID)
 }
 
 type resourceIngestionData struct {
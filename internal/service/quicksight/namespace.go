@@ -6,15 +6,12 @@ package quicksight
 import (
 	"context"
 	"errors"
-	"fmt"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -25,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -34,6 +32,7 @@ import (
 // @Tags(identifierAttribute="arn")
 func newResourceNamespace(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceNamespace{}
+	r.SetIdentity(namespaceIdentity)
 	r.SetDefaultCreateTimeout(2 * time.Minute)
 	r.SetDefaultDeleteTimeout(2 * time.Minute)
 
@@ -46,6 +45,7 @@ const (
 
 type resourceNamespace struct {
 	framework.ResourceWithConfigure
+	framework.WithImportByIdentity
 	framework.WithTimeouts
 }
 
@@ -248,10 +248,6 @@ func (r *resourceNamespace) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
-func (r *resourceNamespace) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
-}
-
 func (r *resourceNamespace) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, req, resp)
 }
@@ -286,16 +282,19 @@ func FindNamespaceByID(ctx context.Context, conn *quicksight.QuickSight, id stri
 	return out.Namespace, nil
 }
 
+var namespaceIdentity = identity.New(",", names.AttrAWSAccountID, names.AttrNamespace)
+
 func ParseNamespaceID(id string) (string, string, error) {
-	parts := strings.SplitN(id, ",", 3)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID,NAMESPACE", id)
+	parts, err := namespaceIdentity.Split(id)
+	if err != nil {
+		return "", "", err
 	}
+
 	return parts[0], parts[1], nil
 }
 
 func createNamespaceID(awsAccountID, namespace string) string {
-	return fmt.Sprintf("%s,%s", awsAccountID, namespace)
+	return namespaceIdentity.ID(awsAccountID, namespace)
 }
 
 type resourceNamespaceData struct {
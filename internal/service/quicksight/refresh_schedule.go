@@ -7,7 +7,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -30,13 +29,17 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @FrameworkResource(name="Refresh Schedule")
 func newResourceRefreshSchedule(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &resourceRefreshSchedule{}, nil
+	r := &resourceRefreshSchedule{}
+	r.SetIdentity(refreshScheduleIdentity)
+
+	return r, nil
 }
 
 const (
@@ -50,6 +53,7 @@ const (
 
 type resourceRefreshSchedule struct {
 	framework.ResourceWithConfigure
+	framework.WithImportByIdentity
 }
 
 func (r *resourceRefreshSchedule) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -405,10 +409,6 @@ func (r *resourceRefreshSchedule) Delete(ctx context.Context, req resource.Delet
 	}
 }
 
-func (r *resourceRefreshSchedule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
-}
-
 func (r *resourceRefreshSchedule) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var state resourceRefreshScheduleData
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
@@ -653,15 +653,18 @@ func flattenRefreshOnDay(ctx context.Context, apiObject *quicksight.ScheduleRefr
 	return listVal, diags
 }
 
+var refreshScheduleIdentity = identity.New(",", names.AttrAWSAccountID, "data_set_id", "schedule_id").WithSeparatorInFinalValue()
+
 func createRefreshScheduleID(awsAccountID, dataSetId, scheduleID string) string {
-	return fmt.Sprintf("%s,%s,%s", awsAccountID, dataSetId, scheduleID)
+	return refreshScheduleIdentity.ID(awsAccountID, dataSetId, scheduleID)
 }
 
 func ParseRefreshScheduleID(id string) (string, string, string, error) {
-	parts := strings.SplitN(id, ",", 3)
-	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
-		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID,DATA_SET_ID,SCHEDULE_ID", id)
+	parts, err := refreshScheduleIdentity.Split(id)
+	if err != nil {
+		return "", "", "", err
 	}
+
 	return parts[0], parts[1], parts[2], nil
 }
 
@@ -6,13 +6,10 @@ package quicksight
 import (
 	"context"
 	"errors"
-	"fmt"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -22,13 +19,17 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @FrameworkResource(name="Template Alias")
 func newResourceTemplateAlias(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &resourceTemplateAlias{}, nil
+	r := &resourceTemplateAlias{}
+	r.SetIdentity(templateAliasIdentity)
+
+	return r, nil
 }
 
 const (
@@ -37,6 +38,7 @@ const (
 
 type resourceTemplateAlias struct {
 	framework.ResourceWithConfigure
+	framework.WithImportByIdentity
 }
 
 func (r *resourceTemplateAlias) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -229,10 +231,6 @@ func (r *resourceTemplateAlias) Delete(ctx context.Context, req resource.DeleteR
 	}
 }
 
-func (r *resourceTemplateAlias) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
-}
-
 func FindTemplateAliasByID(ctx context.Context, conn *quicksight.QuickSight, id string) (*quicksight.TemplateAlias, error) {
 	awsAccountID, templateID, aliasName, err := ParseTemplateAliasID(id)
 	if err != nil {
@@ -264,16 +262,19 @@ func FindTemplateAliasByID(ctx context.Context, conn *quicksight.QuickSight, id
 	return out.TemplateAlias, nil
 }
 
+var templateAliasIdentity = identity.New(",", names.AttrAWSAccountID, "template_id", "alias_name").WithSeparatorInFinalValue()
+
 func ParseTemplateAliasID(id string) (string, string, string, error) {
-	parts := strings.SplitN(id, ",", 3)
-	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
-		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID,TEMPLATE_ID,ALIAS_NAME", id)
+	parts, err := templateAliasIdentity.Split(id)
+	if err != nil {
+		return "", "", "", err
 	}
+
 	return parts[0], parts[1], parts[2], nil
 }
 
 func createTemplateAliasID(awsAccountID, templateID, aliasName string) string {
-	return strings.Join([]string{awsAccountID, templateID, aliasName}, ",")
+	return templateAliasIdentity.ID(awsAccountID, templateID, aliasName)
 }
 
 type resourceTemplateAliasData struct {
@@ -6,8 +6,6 @@ package quicksight
 import (
 	"context"
 	"errors"
-	"fmt"
-	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -17,7 +15,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -28,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -37,6 +35,7 @@ import (
 // @Tags(identifierAttribute="arn")
 func newResourceVPCConnection(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceVPCConnection{}
+	r.SetIdentity(vpcConnectionIdentity)
 	r.SetDefaultCreateTimeout(5 * time.Minute)
 	r.SetDefaultUpdateTimeout(5 * time.Minute)
 	r.SetDefaultDeleteTimeout(5 * time.Minute)
@@ -46,6 +45,7 @@ func newResourceVPCConnection(_ context.Context) (resource.ResourceWithConfigure
 
 type resourceVPCConnection struct {
 	framework.ResourceWithConfigure
+	framework.WithImportByIdentity
 	framework.WithTimeouts
 }
 
@@ -372,10 +372,6 @@ func (r *resourceVPCConnection) Delete(ctx context.Context, req resource.DeleteR
 	}
 }
 
-func (r *resourceVPCConnection) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
-}
-
 func (r *resourceVPCConnection) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, req, resp)
 }
@@ -507,16 +503,19 @@ func statusVPCConnection(ctx context.Context, conn *quicksight.QuickSight, id st
 	}
 }
 
+var vpcConnectionIdentity = identity.New(",", names.AttrAWSAccountID, "vpc_connection_id").WithSeparatorInFinalValue()
+
 func ParseVPCConnectionID(id string) (string, string, error) {
-	parts := strings.SplitN(id, ",", 2)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID,VPC_CONNECTION_ID", id)
+	parts, err := vpcConnectionIdentity.Split(id)
+	if err != nil {
+		return "", "", err
 	}
+
 	return parts[0], parts[1], nil
 }
 
 func createVPCConnectionID(awsAccountID, vpcConnectionID string) string {
-	return strings.Join([]string{awsAccountID, vpcConnectionID}, ",")
+	return vpcConnectionIdentity.ID(awsAccountID, vpcConnectionID)
 }
 
 type resourceVPCConnectionData struct {
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// resourceARN is a resource ARN split into its constituent parts.
+type resourceARN struct {
+	AccountID    string
+	Name         string
+	Partition    string
+	Region       string
+	Resource     string
+	ResourceType string
+	Service      string
+}
+
+// parseResourceARN parses an ARN in the same way as the `arn_parse` provider function and
+// additionally splits the ARN's resource part into a resource type and name.
+// The resource type is of the form `service[:type]`, matching the Resource Groups Tagging API's resource type filters.
+func parseResourceARN(s string) (resourceARN, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return resourceARN{}, err
+	}
+
+	resourceType, name := splitARNResource(v.Resource)
+	if resourceType == "" {
+		resourceType = v.Service
+	} else {
+		resourceType = v.Service + ":" + resourceType
+	}
+
+	return resourceARN{
+		AccountID:    v.AccountID,
+		Name:         name,
+		Partition:    v.Partition,
+		Region:       v.Region,
+		Resource:     v.Resource,
+		ResourceType: resourceType,
+		Service:      v.Service,
+	}, nil
+}
+
+// splitARNResource splits the resource part of an ARN into a resource type and name.
+// The resource part is of the form `name`, `type:name`, `type/name` or `type/parent/.../name`.
+// For slash-separated resources the name is the final path element.
+func splitARNResource(s string) (string, string) {
+	i := strings.IndexAny(s, ":/")
+
+	if i < 0 {
+		return "", s
+	}
+
+	resourceType, name := s[:i], s[i+1:]
+
+	if s[i] == '/' {
+		name = name[strings.LastIndex(name, "/")+1:]
+	}
+
+	return resourceType, name
+}
+
+// resourceTypeMatches returns whether the specified resource type (`service[:type]`) matches a resource type filter.
+// A filter of the form `service` matches all of that service's resource types.
+func resourceTypeMatches(resourceType, filter string) bool {
+	if resourceType == filter {
+		return true
+	}
+
+	return !strings.Contains(filter, ":") && strings.HasPrefix(resourceType, filter+":")
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseResourceARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       string
+		expected    resourceARN
+		expectError bool
+	}{
+		"invalid": {
+			input:       "not-an-arn",
+			expectError: true,
+		},
+		"no resource type": {
+			input: "arn:aws:s3:::my-bucket", //lintignore:AWSAT005
+			expected: resourceARN{
+				Name:         "my-bucket",
+				Partition:    "aws",
+				Resource:     "my-bucket",
+				ResourceType: "s3",
+				Service:      "s3",
+			},
+		},
+		"slash": {
+			input: "arn:aws:ec2:us-west-2:123456789012:instance/i-1234567890abcdef0", //lintignore:AWSAT003,AWSAT005
+			expected: resourceARN{
+				AccountID:    "123456789012",
+				Name:         "i-1234567890abcdef0",
+				Partition:    "aws",
+				Region:       "us-west-2", //lintignore:AWSAT003
+				Resource:     "instance/i-1234567890abcdef0",
+				ResourceType: "ec2:instance",
+				Service:      "ec2",
+			},
+		},
+		"nested slash": {
+			input: "arn:aws:ecs:us-west-2:123456789012:service/my-cluster/my-service", //lintignore:AWSAT003,AWSAT005
+			expected: resourceARN{
+				AccountID:    "123456789012",
+				Name:         "my-service",
+				Partition:    "aws",
+				Region:       "us-west-2", //lintignore:AWSAT003
+				Resource:     "service/my-cluster/my-service",
+				ResourceType: "ecs:service",
+				Service:      "ecs",
+			},
+		},
+		"colon": {
+			input: "arn:aws:lambda:us-west-2:123456789012:function:my-function", //lintignore:AWSAT003,AWSAT005
+			expected: resourceARN{
+				AccountID:    "123456789012",
+				Name:         "my-function",
+				Partition:    "aws",
+				Region:       "us-west-2", //lintignore:AWSAT003
+				Resource:     "function:my-function",
+				ResourceType: "lambda:function",
+				Service:      "lambda",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseResourceARN(testCase.input)
+
+			if err == nil && testCase.expectError {
+				t.Fatal("expected error, got none")
+			}
+			if err != nil && !testCase.expectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceTypeMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		resourceType string
+		filter       string
+		expected     bool
+	}{
+		{"ec2:instance", "ec2:instance", true},
+		{"ec2:instance", "ec2", true},
+		{"ec2:instance", "ec2:volume", false},
+		{"ec2:instance", "ec", false},
+		{"s3", "s3", true},
+		{"s3", "s3:bucket", false},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := resourceTypeMatches(testCase.resourceType, testCase.filter), testCase.expected; got != want {
+			t.Errorf("resourceTypeMatches(%q, %q) = %t, want %t", testCase.resourceType, testCase.filter, got, want)
+		}
+	}
+}
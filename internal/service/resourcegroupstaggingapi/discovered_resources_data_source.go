@@ -0,0 +1,364 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
+	resourceexplorer2types "github.com/aws/aws-sdk-go-v2/service/resourceexplorer2/types"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_resourcegroupstaggingapi_discovered_resources", name="Discovered Resources")
+func newDiscoveredResourcesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &discoveredResourcesDataSource{}, nil
+}
+
+type discoveredResourcesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*discoveredResourcesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_resourcegroupstaggingapi_discovered_resources"
+}
+
+func (d *discoveredResourcesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	tagFilterObject := schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			names.AttrKey: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrValues: schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(20),
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"regions": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"resource_explorer_query": schema.StringAttribute{
+				Optional: true,
+			},
+			"resource_explorer_view_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"resource_type_filters": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(100),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"exclude_tag": schema.ListNestedBlock{
+				CustomType:   fwtypes.NewListNestedObjectTypeOf[tagFilterModel](ctx),
+				NestedObject: tagFilterObject,
+			},
+			"include_tag": schema.ListNestedBlock{
+				CustomType:   fwtypes.NewListNestedObjectTypeOf[tagFilterModel](ctx),
+				NestedObject: tagFilterObject,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(50),
+				},
+			},
+			names.AttrResources: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[discoveredResourceModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrAccountID: schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrARN: schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Computed: true,
+						},
+						"partition": schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrRegion: schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrResourceType: schema.StringAttribute{
+							Computed: true,
+						},
+						"service": schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrTags: tftags.TagsAttributeComputedOnly(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *discoveredResourcesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data discoveredResourcesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	includeTags, diags := data.IncludeTags.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	excludeTags, diags := data.ExcludeTags.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	regions := fwflex.ExpandFrameworkStringValueSet(ctx, data.Regions)
+	if len(regions) == 0 {
+		var err error
+		regions, err = findEnabledRegions(ctx, d.Meta().EC2Client(ctx))
+
+		if err != nil {
+			response.Diagnostics.AddError("reading enabled Regions", err.Error())
+
+			return
+		}
+	}
+
+	resourceTypeFilters := fwflex.ExpandFrameworkStringValueSet(ctx, data.ResourceTypeFilters)
+	// Resource ARN to tags.
+	discovered := make(map[string]tftags.KeyValueTags)
+
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: resourceTypeFilters,
+		TagFilters: tfslices.ApplyToAll(includeTags, func(v *tagFilterModel) awstypes.TagFilter {
+			return awstypes.TagFilter{
+				Key:    v.Key.ValueStringPointer(),
+				Values: fwflex.ExpandFrameworkStringValueSet(ctx, v.Values),
+			}
+		}),
+	}
+
+	for _, region := range regions {
+		conn := d.Meta().ResourceGroupsTaggingAPIClient(conns.NewRegionContext(ctx, region))
+
+		pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("reading Resource Groups Tagging API Resources (%s)", region), err.Error())
+
+				return
+			}
+
+			for _, v := range page.ResourceTagMappingList {
+				discovered[aws.ToString(v.ResourceARN)] = KeyValueTags(ctx, v.Tags)
+			}
+		}
+	}
+
+	if !data.ResourceExplorerQuery.IsNull() {
+		conn := d.Meta().ResourceExplorer2Client(ctx)
+
+		input := &resourceexplorer2.SearchInput{
+			QueryString: fwflex.StringFromFramework(ctx, data.ResourceExplorerQuery),
+			ViewArn:     fwflex.StringFromFramework(ctx, data.ResourceExplorerViewARN),
+		}
+
+		pages := resourceexplorer2.NewSearchPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				response.Diagnostics.AddError("searching Resource Explorer", err.Error())
+
+				return
+			}
+
+			for _, v := range page.Resources {
+				// Regional results are limited to the requested Regions. Global resources are always included.
+				if region := aws.ToString(v.Region); region != "" && region != "global" && !slices.Contains(regions, region) {
+					continue
+				}
+
+				arn := aws.ToString(v.Arn)
+				if _, ok := discovered[arn]; ok {
+					continue
+				}
+
+				tags, err := resourceExplorerTags(v.Properties)
+
+				if err != nil {
+					response.Diagnostics.AddError(fmt.Sprintf("reading Resource Explorer resource (%s) tags", arn), err.Error())
+
+					return
+				}
+
+				discovered[arn] = tftags.New(ctx, tags)
+			}
+		}
+	}
+
+	var resources []discoveredResourceModel
+
+	for arn, tags := range discovered {
+		if !tagFiltersMatch(ctx, tags, includeTags, true) || tagFiltersMatch(ctx, tags, excludeTags, false) {
+			continue
+		}
+
+		parsedARN, err := parseResourceARN(arn)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("parsing ARN (%s)", arn), err.Error())
+
+			return
+		}
+
+		if len(resourceTypeFilters) > 0 && !slices.ContainsFunc(resourceTypeFilters, func(filter string) bool {
+			return resourceTypeMatches(parsedARN.ResourceType, filter)
+		}) {
+			continue
+		}
+
+		resources = append(resources, discoveredResourceModel{
+			AccountID:    types.StringValue(parsedARN.AccountID),
+			ARN:          types.StringValue(arn),
+			Name:         types.StringValue(parsedARN.Name),
+			Partition:    types.StringValue(parsedARN.Partition),
+			Region:       types.StringValue(parsedARN.Region),
+			ResourceType: types.StringValue(parsedARN.ResourceType),
+			Service:      types.StringValue(parsedARN.Service),
+			Tags:         fwflex.FlattenFrameworkStringValueMapLegacy(ctx, tags.IgnoreAWS().Map()),
+		})
+	}
+
+	slices.SortFunc(resources, func(a, b discoveredResourceModel) int {
+		return strings.Compare(a.ARN.ValueString(), b.ARN.ValueString())
+	})
+
+	data.ID = types.StringValue(d.Meta().Partition)
+	data.Resources = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, resources)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// findEnabledRegions returns the names of the Regions that are enabled for the account.
+func findEnabledRegions(ctx context.Context, conn *ec2.Client) ([]string, error) {
+	output, err := conn.DescribeRegions(ctx, &ec2.DescribeRegionsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output.Regions, func(v ec2types.Region) string {
+		return aws.ToString(v.RegionName)
+	}), nil
+}
+
+// resourceExplorerTags returns the tags reported in a Resource Explorer resource's `tags` property.
+func resourceExplorerTags(properties []resourceexplorer2types.ResourceProperty) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	for _, v := range properties {
+		if aws.ToString(v.Name) != "tags" || v.Data == nil {
+			continue
+		}
+
+		var data []map[string]any
+		if err := v.Data.UnmarshalSmithyDocument(&data); err != nil {
+			return nil, err
+		}
+
+		for _, tag := range data {
+			key, _ := tag["Key"].(string)
+			value, _ := tag["Value"].(string)
+			tags[key] = value
+		}
+	}
+
+	return tags, nil
+}
+
+// tagFiltersMatch returns whether the specified tags match the tag filters.
+// If all is true, every filter must match; otherwise, any filter must match.
+// A filter matches if the tag key is present and, if values are specified, the tag value is one of them.
+func tagFiltersMatch(ctx context.Context, tags tftags.KeyValueTags, filters []*tagFilterModel, all bool) bool {
+	for _, filter := range filters {
+		key := filter.Key.ValueString()
+		ok := tags.KeyExists(key)
+
+		if values := fwflex.ExpandFrameworkStringValueSet(ctx, filter.Values); ok && len(values) > 0 {
+			ok = slices.Contains(values, aws.ToString(tags.KeyValue(key)))
+		}
+
+		if ok != all {
+			return ok
+		}
+	}
+
+	return all
+}
+
+type discoveredResourcesDataSourceModel struct {
+	ExcludeTags             fwtypes.ListNestedObjectValueOf[tagFilterModel]          `tfsdk:"exclude_tag"`
+	ID                      types.String                                             `tfsdk:"id"`
+	IncludeTags             fwtypes.ListNestedObjectValueOf[tagFilterModel]          `tfsdk:"include_tag"`
+	Regions                 fwtypes.SetValueOf[types.String]                         `tfsdk:"regions"`
+	ResourceExplorerQuery   types.String                                             `tfsdk:"resource_explorer_query"`
+	ResourceExplorerViewARN fwtypes.ARN                                              `tfsdk:"resource_explorer_view_arn"`
+	ResourceTypeFilters     fwtypes.SetValueOf[types.String]                         `tfsdk:"resource_type_filters"`
+	Resources               fwtypes.ListNestedObjectValueOf[discoveredResourceModel] `tfsdk:"resources"`
+}
+
+type tagFilterModel struct {
+	Key    types.String                     `tfsdk:"key"`
+	Values fwtypes.SetValueOf[types.String] `tfsdk:"values"`
+}
+
+type discoveredResourceModel struct {
+	AccountID    types.String `tfsdk:"account_id"`
+	ARN          types.String `tfsdk:"arn"`
+	Name         types.String `tfsdk:"name"`
+	Partition    types.String `tfsdk:"partition"`
+	Region       types.String `tfsdk:"region"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	Service      types.String `tfsdk:"service"`
+	Tags         types.Map    `tfsdk:"tags"`
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccResourceGroupsTaggingAPIDiscoveredResourcesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_resourcegroupstaggingapi_discovered_resources.test"
+	resourceName := "aws_sns_topic.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDiscoveredResourcesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", acctest.Ct1),
+					acctest.CheckResourceAttrAccountID(dataSourceName, "resources.0.account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.partition", acctest.Partition()),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.region", acctest.Region()),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.resource_type", "sns"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.service", "sns"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.tags.%", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccDiscoveredResourcesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+
+  tags = {
+    Name     = %[1]q
+    Excluded = "false"
+  }
+}
+
+resource "aws_sns_topic" "excluded" {
+  name = "%[1]s-excluded"
+
+  tags = {
+    Name     = %[1]q
+    Excluded = "true"
+  }
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_resourcegroupstaggingapi_discovered_resources" "test" {
+  regions               = [data.aws_region.current.name]
+  resource_type_filters = ["sns"]
+
+  include_tag {
+    key    = "Name"
+    values = [%[1]q]
+  }
+
+  exclude_tag {
+    key    = "Excluded"
+    values = ["true"]
+  }
+
+  depends_on = [aws_sns_topic.test, aws_sns_topic.excluded, aws_sqs_queue.test]
+}
+`, rName)
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_route53_records", name="Records")
+func newRecordsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &recordsDataSource{}, nil
+}
+
+type recordsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*recordsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_route53_records"
+}
+
+func (d *recordsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"name_regex": schema.StringAttribute{
+				CustomType: fwtypes.RegexpType,
+				Optional:   true,
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RRType](),
+				Optional:   true,
+			},
+			"zone_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"resource_record_sets": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[resourceRecordSetModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Computed: true,
+						},
+						"records": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"set_identifier": schema.StringAttribute{
+							Computed: true,
+						},
+						"ttl": schema.Int64Attribute{
+							Computed: true,
+						},
+						names.AttrType: schema.StringAttribute{
+							Computed: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"alias": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[aliasTargetModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"evaluate_target_health": schema.BoolAttribute{
+										Computed: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Computed: true,
+									},
+									"zone_id": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *recordsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data recordsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().Route53Client(ctx)
+
+	zoneID := data.ZoneID.ValueString()
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+	output, err := findResourceRecordSets(ctx, conn, input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), func(v *awstypes.ResourceRecordSet) bool {
+		if !data.NameRegex.IsNull() && !data.NameRegex.ValueRegexp().MatchString(recordSetName(v)) {
+			return false
+		}
+
+		if !data.Type.IsNull() && v.Type != data.Type.ValueEnum() {
+			return false
+		}
+
+		return true
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing Route 53 Hosted Zone (%s) Records", zoneID), err.Error())
+
+		return
+	}
+
+	recordSets := tfslices.ApplyToAll(output, func(v awstypes.ResourceRecordSet) resourceRecordSetModel {
+		recordSet := resourceRecordSetModel{
+			Alias: fwtypes.NewListNestedObjectValueOfNull[aliasTargetModel](ctx),
+			Name:  types.StringValue(recordSetName(&v)),
+			Records: fwflex.FlattenFrameworkStringValueListOfString(ctx, tfslices.ApplyToAll(v.ResourceRecords, func(v awstypes.ResourceRecord) string {
+				return aws.ToString(v.Value)
+			})),
+			SetIdentifier: fwflex.StringToFramework(ctx, v.SetIdentifier),
+			TTL:           fwflex.Int64ToFramework(ctx, v.TTL),
+			Type:          types.StringValue(string(v.Type)),
+		}
+
+		if v := v.AliasTarget; v != nil {
+			recordSet.Alias = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &aliasTargetModel{
+				EvaluateTargetHealth: types.BoolValue(v.EvaluateTargetHealth),
+				Name:                 types.StringValue(normalizeAliasName(aws.ToString(v.DNSName))),
+				ZoneID:               fwflex.StringToFramework(ctx, v.HostedZoneId),
+			})
+		}
+
+		return recordSet
+	})
+
+	data.ID = types.StringValue(zoneID)
+	data.ResourceRecordSets = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, recordSets)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// recordSetName returns a record set's name without escapes or the trailing dot.
+func recordSetName(v *awstypes.ResourceRecordSet) string {
+	return strings.TrimSuffix(cleanRecordName(aws.ToString(v.Name)), ".")
+}
+
+type recordsDataSourceModel struct {
+	ID                 types.String                                            `tfsdk:"id"`
+	NameRegex          fwtypes.Regexp                                          `tfsdk:"name_regex"`
+	ResourceRecordSets fwtypes.ListNestedObjectValueOf[resourceRecordSetModel] `tfsdk:"resource_record_sets"`
+	Type               fwtypes.StringEnum[awstypes.RRType]                     `tfsdk:"type"`
+	ZoneID             types.String                                            `tfsdk:"zone_id"`
+}
+
+type resourceRecordSetModel struct {
+	Alias         fwtypes.ListNestedObjectValueOf[aliasTargetModel] `tfsdk:"alias"`
+	Name          types.String                                      `tfsdk:"name"`
+	Records       fwtypes.ListValueOf[types.String]                 `tfsdk:"records"`
+	SetIdentifier types.String                                      `tfsdk:"set_identifier"`
+	TTL           types.Int64                                       `tfsdk:"ttl"`
+	Type          types.String                                      `tfsdk:"type"`
+}
+
+type aliasTargetModel struct {
+	EvaluateTargetHealth types.Bool   `tfsdk:"evaluate_target_health"`
+	Name                 types.String `tfsdk:"name"`
+	ZoneID               types.String `tfsdk:"zone_id"`
+}
@@ -196,6 +196,12 @@ func resourceTrafficPolicyDelete(ctx context.Context, d *schema.ResourceData, me
 	return diags
 }
 
+// trafficPolicyImportID returns the ID with which the traffic policy described by d is imported,
+// 'traffic-policy-id/traffic-policy-version'.
+func trafficPolicyImportID(d *schema.ResourceData) (string, error) {
+	return d.Id() + "/" + strconv.Itoa(d.Get(names.AttrVersion).(int)), nil
+}
+
 func findTrafficPolicyByID(ctx context.Context, conn *route53.Client, id string) (*awstypes.TrafficPolicy, error) {
 	inputLTP := &route53.ListTrafficPoliciesInput{}
 	trafficPolicy, err := findTrafficPolicy(ctx, conn, inputLTP, func(v *awstypes.TrafficPolicySummary) bool {
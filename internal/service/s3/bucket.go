@@ -711,7 +711,7 @@ func resourceBucketCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	conn := meta.(*conns.AWSClient).S3Client(ctx)
 
 	bucket := create.Name(d.Get(names.AttrBucket).(string), d.Get(names.AttrBucketPrefix).(string))
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).RegionForContext(ctx)
 
 	if err := validBucketName(bucket, region); err != nil {
 		return sdkdiag.AppendErrorf(diags, "validating S3 Bucket (%s) name: %s", bucket, err)
@@ -2117,19 +2117,15 @@ func expandBucketLifecycleRules(ctx context.Context, l []interface{}) []types.Li
 			result.Expiration = expandBucketLifecycleExpiration(v)
 		}
 
-		var filter types.LifecycleRuleFilter
+		filter := &types.LifecycleRuleFilter{}
 		prefix := tfMap[names.AttrPrefix].(string)
 		if tags := Tags(tftags.New(ctx, tfMap[names.AttrTags]).IgnoreAWS()); len(tags) > 0 {
-			filter = &types.LifecycleRuleFilterMemberAnd{
-				Value: types.LifecycleRuleAndOperator{
-					Prefix: aws.String(prefix),
-					Tags:   tags,
-				},
+			filter.And = &types.LifecycleRuleAndOperator{
+				Prefix: aws.String(prefix),
+				Tags:   tags,
 			}
 		} else {
-			filter = &types.LifecycleRuleFilterMemberPrefix{
-				Value: prefix,
-			}
+			filter.Prefix = aws.String(prefix)
 		}
 		result.Filter = filter
 
@@ -2293,18 +2289,18 @@ func flattenBucketLifecycleRules(ctx context.Context, rules []types.LifecycleRul
 		}
 
 		if filter := rule.Filter; filter != nil {
-			switch v := filter.(type) {
-			case *types.LifecycleRuleFilterMemberAnd:
-				if v := v.Value.Prefix; v != nil {
+			switch {
+			case filter.And != nil:
+				if v := filter.And.Prefix; v != nil {
 					m[names.AttrPrefix] = aws.ToString(v)
 				}
-				if v := v.Value.Tags; v != nil {
+				if v := filter.And.Tags; v != nil {
 					m[names.AttrTags] = keyValueTags(ctx, v).IgnoreAWS().Map()
 				}
-			case *types.LifecycleRuleFilterMemberPrefix:
-				m[names.AttrPrefix] = v.Value
-			case *types.LifecycleRuleFilterMemberTag:
-				m[names.AttrTags] = keyValueTags(ctx, []types.Tag{v.Value}).IgnoreAWS().Map()
+			case filter.Prefix != nil:
+				m[names.AttrPrefix] = aws.ToString(filter.Prefix)
+			case filter.Tag != nil:
+				m[names.AttrTags] = keyValueTags(ctx, []types.Tag{*filter.Tag}).IgnoreAWS().Map()
 			}
 		}
 
@@ -2473,19 +2469,15 @@ func expandBucketReplicationRules(ctx context.Context, l []interface{}) []types.
 		if v, ok := tfRuleMap[names.AttrFilter].([]interface{}); ok && len(v) > 0 && v[0] != nil {
 			// XML schema V2.
 			tfFilterMap := v[0].(map[string]interface{})
-			var filter types.ReplicationRuleFilter
+			filter := &types.ReplicationRuleFilter{}
 
 			if tags := Tags(tftags.New(ctx, tfFilterMap[names.AttrTags]).IgnoreAWS()); len(tags) > 0 {
-				filter = &types.ReplicationRuleFilterMemberAnd{
-					Value: types.ReplicationRuleAndOperator{
-						Prefix: aws.String(tfFilterMap[names.AttrPrefix].(string)),
-						Tags:   tags,
-					},
+				filter.And = &types.ReplicationRuleAndOperator{
+					Prefix: aws.String(tfFilterMap[names.AttrPrefix].(string)),
+					Tags:   tags,
 				}
 			} else {
-				filter = &types.ReplicationRuleFilterMemberPrefix{
-					Value: tfFilterMap[names.AttrPrefix].(string),
-				}
+				filter.Prefix = aws.String(tfFilterMap[names.AttrPrefix].(string))
 			}
 
 			rule.Filter = filter
@@ -2723,21 +2715,21 @@ func flattenBucketDestination(dest *types.Destination) []interface{} {
 	return []interface{}{m}
 }
 
-func flattenBucketReplicationRuleFilter(ctx context.Context, filter types.ReplicationRuleFilter) []interface{} {
+func flattenBucketReplicationRuleFilter(ctx context.Context, filter *types.ReplicationRuleFilter) []interface{} {
 	if filter == nil {
 		return []interface{}{}
 	}
 
 	m := make(map[string]interface{})
 
-	switch v := filter.(type) {
-	case *types.ReplicationRuleFilterMemberAnd:
-		m[names.AttrPrefix] = aws.ToString(v.Value.Prefix)
-		m[names.AttrTags] = keyValueTags(ctx, v.Value.Tags).IgnoreAWS().Map()
-	case *types.ReplicationRuleFilterMemberPrefix:
-		m[names.AttrPrefix] = v.Value
-	case *types.ReplicationRuleFilterMemberTag:
-		m[names.AttrTags] = keyValueTags(ctx, []types.Tag{v.Value}).IgnoreAWS().Map()
+	switch {
+	case filter.And != nil:
+		m[names.AttrPrefix] = aws.ToString(filter.And.Prefix)
+		m[names.AttrTags] = keyValueTags(ctx, filter.And.Tags).IgnoreAWS().Map()
+	case filter.Prefix != nil:
+		m[names.AttrPrefix] = aws.ToString(filter.Prefix)
+	case filter.Tag != nil:
+		m[names.AttrTags] = keyValueTags(ctx, []types.Tag{*filter.Tag}).IgnoreAWS().Map()
 	}
 
 	return []interface{}{m}
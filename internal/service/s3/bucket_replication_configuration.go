@@ -779,20 +779,26 @@ func expandSSEKMSEncryptedObjects(l []interface{}) *types.SseKmsEncryptedObjects
 	return result
 }
 
-func expandReplicationRuleFilter(ctx context.Context, l []interface{}) types.ReplicationRuleFilter {
+func expandReplicationRuleFilter(ctx context.Context, l []interface{}) *types.ReplicationRuleFilter {
 	if len(l) == 0 || l[0] == nil {
-		return &types.ReplicationRuleFilterMemberPrefix{}
+		return &types.ReplicationRuleFilter{
+			Prefix: aws.String(""),
+		}
 	}
 
 	tfMap := l[0].(map[string]interface{})
-	var result types.ReplicationRuleFilter
+	var result *types.ReplicationRuleFilter
 
 	if v, ok := tfMap["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-		result = expandReplicationRuleFilterMemberAnd(ctx, v)
+		result = &types.ReplicationRuleFilter{
+			And: expandReplicationRuleAndOperator(ctx, v),
+		}
 	}
 
 	if v, ok := tfMap["tag"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-		result = expandReplicationRuleFilterMemberTag(v)
+		result = &types.ReplicationRuleFilter{
+			Tag: expandReplicationRuleFilterTag(v),
+		}
 	}
 
 	// Per AWS S3 API, "A Filter must have exactly one of Prefix, Tag, or And specified";
@@ -801,15 +807,15 @@ func expandReplicationRuleFilter(ctx context.Context, l []interface{}) types.Rep
 	// in the API request even if it is an empty value, else Terraform will report non-empty plans.
 	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/23487
 	if v, ok := tfMap[names.AttrPrefix].(string); ok && result == nil {
-		result = &types.ReplicationRuleFilterMemberPrefix{
-			Value: v,
+		result = &types.ReplicationRuleFilter{
+			Prefix: aws.String(v),
 		}
 	}
 
 	return result
 }
 
-func expandReplicationRuleFilterMemberAnd(ctx context.Context, l []interface{}) *types.ReplicationRuleFilterMemberAnd {
+func expandReplicationRuleAndOperator(ctx context.Context, l []interface{}) *types.ReplicationRuleAndOperator {
 	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
@@ -820,25 +826,23 @@ func expandReplicationRuleFilterMemberAnd(ctx context.Context, l []interface{})
 		return nil
 	}
 
-	result := &types.ReplicationRuleFilterMemberAnd{
-		Value: types.ReplicationRuleAndOperator{},
-	}
+	result := &types.ReplicationRuleAndOperator{}
 
 	if v, ok := tfMap[names.AttrPrefix].(string); ok && v != "" {
-		result.Value.Prefix = aws.String(v)
+		result.Prefix = aws.String(v)
 	}
 
 	if v, ok := tfMap[names.AttrTags].(map[string]interface{}); ok && len(v) > 0 {
 		tags := Tags(tftags.New(ctx, v).IgnoreAWS())
 		if len(tags) > 0 {
-			result.Value.Tags = tags
+			result.Tags = tags
 		}
 	}
 
 	return result
 }
 
-func expandReplicationRuleFilterMemberTag(l []interface{}) *types.ReplicationRuleFilterMemberTag {
+func expandReplicationRuleFilterTag(l []interface{}) *types.Tag {
 	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
@@ -849,16 +853,14 @@ func expandReplicationRuleFilterMemberTag(l []interface{}) *types.ReplicationRul
 		return nil
 	}
 
-	result := &types.ReplicationRuleFilterMemberTag{
-		Value: types.Tag{},
-	}
+	result := &types.Tag{}
 
 	if v, ok := tfMap[names.AttrKey].(string); ok && v != "" {
-		result.Value.Key = aws.String(v)
+		result.Key = aws.String(v)
 	}
 
 	if v, ok := tfMap[names.AttrValue].(string); ok && v != "" {
-		result.Value.Value = aws.String(v)
+		result.Value = aws.String(v)
 	}
 
 	return result
@@ -1044,20 +1046,20 @@ func flattenExistingObjectReplication(eor *types.ExistingObjectReplication) []in
 	return []interface{}{m}
 }
 
-func flattenReplicationRuleFilter(ctx context.Context, filter types.ReplicationRuleFilter) []interface{} {
+func flattenReplicationRuleFilter(ctx context.Context, filter *types.ReplicationRuleFilter) []interface{} {
 	if filter == nil {
 		return []interface{}{}
 	}
 
 	m := make(map[string]interface{})
 
-	switch v := filter.(type) {
-	case *types.ReplicationRuleFilterMemberAnd:
-		m["and"] = flattenReplicationRuleFilterMemberAnd(ctx, v)
-	case *types.ReplicationRuleFilterMemberPrefix:
-		m[names.AttrPrefix] = v.Value
-	case *types.ReplicationRuleFilterMemberTag:
-		m["tag"] = flattenReplicationRuleFilterMemberTag(v)
+	switch {
+	case filter.And != nil:
+		m["and"] = flattenReplicationRuleAndOperator(ctx, filter.And)
+	case filter.Prefix != nil:
+		m[names.AttrPrefix] = aws.ToString(filter.Prefix)
+	case filter.Tag != nil:
+		m["tag"] = flattenReplicationRuleFilterTag(filter.Tag)
 	default:
 		return nil
 	}
@@ -1065,36 +1067,36 @@ func flattenReplicationRuleFilter(ctx context.Context, filter types.ReplicationR
 	return []interface{}{m}
 }
 
-func flattenReplicationRuleFilterMemberAnd(ctx context.Context, op *types.ReplicationRuleFilterMemberAnd) []interface{} {
+func flattenReplicationRuleAndOperator(ctx context.Context, op *types.ReplicationRuleAndOperator) []interface{} {
 	if op == nil {
 		return []interface{}{}
 	}
 
 	m := make(map[string]interface{})
 
-	if v := op.Value.Prefix; v != nil {
+	if v := op.Prefix; v != nil {
 		m[names.AttrPrefix] = aws.ToString(v)
 	}
 
-	if v := op.Value.Tags; v != nil {
+	if v := op.Tags; v != nil {
 		m[names.AttrTags] = keyValueTags(ctx, v).IgnoreAWS().Map()
 	}
 
 	return []interface{}{m}
 }
 
-func flattenReplicationRuleFilterMemberTag(op *types.ReplicationRuleFilterMemberTag) []interface{} {
+func flattenReplicationRuleFilterTag(op *types.Tag) []interface{} {
 	if op == nil {
 		return []interface{}{}
 	}
 
 	m := make(map[string]interface{})
 
-	if v := op.Value.Key; v != nil {
+	if v := op.Key; v != nil {
 		m[names.AttrKey] = aws.ToString(v)
 	}
 
-	if v := op.Value.Value; v != nil {
+	if v := op.Value; v != nil {
 		m[names.AttrValue] = aws.ToString(v)
 	}
 
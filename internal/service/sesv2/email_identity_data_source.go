@@ -103,7 +103,7 @@ func dataSourceEmailIdentityRead(ctx context.Context, d *schema.ResourceData, me
 		return create.AppendDiagError(diags, names.SESV2, create.ErrActionReading, DSNameEmailIdentity, name, err)
 	}
 
-	arn := emailIdentityNameToARN(meta, name)
+	arn := emailIdentityNameToARN(ctx, meta, name)
 
 	d.SetId(name)
 	d.Set(names.AttrARN, arn)
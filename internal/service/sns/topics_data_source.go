@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_sns_topics", name="Topics")
+func newTopicsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &topicsDataSource{}, nil
+}
+
+type topicsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*topicsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_sns_topics"
+}
+
+func (d *topicsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARNs: schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"name_regex": schema.StringAttribute{
+				CustomType: fwtypes.RegexpType,
+				Optional:   true,
+			},
+			names.AttrNames: schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrTags: tftags.TagsAttribute(),
+		},
+	}
+}
+
+func (d *topicsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data topicsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SNSClient(ctx)
+
+	var arns, topicNames []string
+	tagsToMatch := tftags.New(ctx, data.Tags)
+
+	pages := sns.NewListTopicsPaginator(conn, &sns.ListTopicsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			response.Diagnostics.AddError("listing SNS Topics", err.Error())
+
+			return
+		}
+
+		for _, v := range page.Topics {
+			topicARN := aws.ToString(v.TopicArn)
+			parsedARN, err := arn.Parse(topicARN)
+
+			if err != nil {
+				response.Diagnostics.AddError("listing SNS Topics", err.Error())
+
+				return
+			}
+
+			topicName := parsedARN.Resource
+			if !data.NameRegex.IsNull() && !data.NameRegex.ValueRegexp().MatchString(topicName) {
+				continue
+			}
+
+			if len(tagsToMatch) > 0 {
+				tags, err := listTags(ctx, conn, topicARN)
+
+				if err != nil {
+					response.Diagnostics.AddError(fmt.Sprintf("listing tags for SNS Topic (%s)", topicARN), err.Error())
+
+					return
+				}
+
+				if !tags.ContainsAll(tagsToMatch) {
+					continue
+				}
+			}
+
+			arns = append(arns, topicARN)
+			topicNames = append(topicNames, topicName)
+		}
+	}
+
+	data.ARNs = flex.FlattenFrameworkStringValueListLegacy(ctx, arns)
+	data.ID = types.StringValue(d.Meta().RegionForContext(ctx))
+	data.Names = flex.FlattenFrameworkStringValueListLegacy(ctx, topicNames)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type topicsDataSourceModel struct {
+	ARNs      types.List     `tfsdk:"arns"`
+	ID        types.String   `tfsdk:"id"`
+	NameRegex fwtypes.Regexp `tfsdk:"name_regex"`
+	Names     types.List     `tfsdk:"names"`
+	Tags      types.Map      `tfsdk:"tags"`
+}
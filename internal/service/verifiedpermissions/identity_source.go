@@ -5,16 +5,13 @@ package verifiedpermissions
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -29,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -45,6 +43,7 @@ const (
 // @FrameworkResource(name="Identity Source")
 func newResourceIdentitySource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceIdentitySource{}
+	r.SetIdentity(identitySourceIdentity)
 
 	return r, nil
 }
@@ -55,6 +54,7 @@ const (
 
 type resourceIdentitySource struct {
 	framework.ResourceWithConfigure
+	framework.WithImportByIdentity
 }
 
 func (r *resourceIdentitySource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -393,18 +393,6 @@ func (r *resourceIdentitySource) Delete(ctx context.Context, request resource.De
 	}
 }
 
-func (r *resourceIdentitySource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	parts := strings.Split(request.ID, ":")
-	if len(parts) != 2 {
-		response.Diagnostics.AddError("Resource Import Invalid ID", fmt.Sprintf("unexpected format of import ID (%s), expected: 'POLICY_STORE_ID:IDENTITY-SOURCE-ID'", request.ID))
-		return
-	}
-	policyStoreID := parts[0]
-	identitySourceID := parts[1]
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), identitySourceID)...)
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("policy_store_id"), policyStoreID)...)
-}
-
 func flattenConfiguration(ctx context.Context, apiObject awstypes.ConfigurationDetail) (fwtypes.ListNestedObjectValueOf[configuration], diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -472,6 +460,8 @@ func flattenTokenSelection(ctx context.Context, apiObject awstypes.OpenIdConnect
 	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, obj), diags
 }
 
+var identitySourceIdentity = identity.New(":", "policy_store_id", names.AttrID)
+
 type resourceIdentitySourceData struct {
 	Configuration       fwtypes.ListNestedObjectValueOf[configuration] `tfsdk:"configuration"`
 	ID                  types.String                                   `tfsdk:"id"`
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/wafv2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -18,7 +19,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_wafv2_ip_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_ip_set", &resource.Sweeper{
 		Name: "aws_wafv2_ip_set",
 		F:    sweepIPSets,
 		Dependencies: []string{
@@ -27,7 +28,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_regex_pattern_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_regex_pattern_set", &resource.Sweeper{
 		Name: "aws_wafv2_regex_pattern_set",
 		F:    sweepRegexPatternSets,
 		Dependencies: []string{
@@ -36,7 +37,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_rule_group", &resource.Sweeper{
 		Name: "aws_wafv2_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -44,7 +45,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_web_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_web_acl", &resource.Sweeper{
 		Name: "aws_wafv2_web_acl",
 		F:    sweepWebACLs,
 	})
@@ -75,7 +76,7 @@ func sweepIPSets(region string) error {
 			d.Set(names.AttrName, v.Name)
 			d.Set(names.AttrScope, input.Scope)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithImportID(idNameScopeImportID)))
 		}
 
 		return !lastPage
@@ -124,7 +125,7 @@ func sweepRegexPatternSets(region string) error {
 			d.Set(names.AttrName, v.Name)
 			d.Set(names.AttrScope, input.Scope)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithImportID(idNameScopeImportID)))
 		}
 
 		return !lastPage
@@ -173,7 +174,7 @@ func sweepRuleGroups(region string) error {
 			d.Set(names.AttrName, v.Name)
 			d.Set(names.AttrScope, input.Scope)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithImportID(idNameScopeImportID)))
 		}
 
 		return !lastPage
@@ -232,7 +233,7 @@ func sweepWebACLs(region string) error {
 			d.Set(names.AttrName, name)
 			d.Set(names.AttrScope, input.Scope)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithImportID(idNameScopeImportID)))
 		}
 
 		return !lastPage
@@ -255,3 +256,9 @@ func sweepWebACLs(region string) error {
 
 	return nil
 }
+
+// idNameScopeImportID returns the ID with which the IP set, regex pattern set, rule group or web ACL
+// described by d is imported, 'id/name/scope'.
+func idNameScopeImportID(d *schema.ResourceData) (string, error) {
+	return strings.Join([]string{d.Id(), d.Get(names.AttrName).(string), d.Get(names.AttrScope).(string)}, "/"), nil
+}
@@ -10,8 +10,10 @@ import (
 )
 
 func Context(region string) context.Context {
-	ctx := context.Background()
+	return regionalContext(context.Background(), region)
+}
 
+func regionalContext(ctx context.Context, region string) context.Context {
 	ctx = tfsdklog.RegisterStdlogSink(ctx)
 
 	ctx = logger(ctx, "sweeper", region)
@@ -0,0 +1,408 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+// DefaultParallelism is the default maximum number of sweepers run concurrently in each Region.
+const DefaultParallelism = 4
+
+// registeredSweeper is a sweeper registered with the sweeper engine.
+type registeredSweeper struct {
+	*resource.Sweeper
+
+	// list lists the resources that the sweeper deletes.
+	// nil if the sweeper lists and deletes resources in F.
+	list SweeperFn
+}
+
+// registeredSweepers are all registered sweepers, keyed by name.
+var registeredSweepers = make(map[string]*registeredSweeper)
+
+func registerSweeper(name string, s *resource.Sweeper, list SweeperFn) {
+	// The plugin-testing harness rejects duplicate names.
+	resource.AddTestSweepers(name, s)
+
+	registeredSweepers[name] = &registeredSweeper{
+		Sweeper: s,
+		list:    list,
+	}
+}
+
+// Options configures a run of the sweeper engine.
+type Options struct {
+	// AllowFailures continues sweeping after a sweeper fails.
+	// Sweepers that depend on a failed sweeper are still run.
+	AllowFailures bool
+
+	// DryRun lists the resources that would be deleted without deleting them.
+	// AWS API clients reject any operation that is not read-only.
+	DryRun bool
+
+	// Output receives the dry run report.
+	Output io.Writer
+
+	// Parallelism is the maximum number of sweepers run concurrently in each Region.
+	Parallelism int
+
+	// Regions are the Regions to sweep, in order.
+	Regions []string
+
+	// Run is a comma-separated list of sweeper names to run, as for the -sweep-run flag.
+	// Names are matched case-insensitively as substrings. Dependencies of matching sweepers are also run.
+	Run string
+}
+
+// Run runs the registered sweepers in dependency order.
+// Within a Region, sweepers whose dependencies have completed are run concurrently.
+func Run(ctx context.Context, opts Options) error {
+	if opts.DryRun {
+		// Sweepers that do not delete through SweepOrchestrator must not delete anything.
+		setReadOnlyClients(true)
+		defer setReadOnlyClients(false)
+	}
+
+	e := &engine{
+		client:   SharedRegionalSweepClient,
+		options:  opts,
+		sweepers: registeredSweepers,
+	}
+
+	return e.run(ctx)
+}
+
+type engine struct {
+	client   func(context.Context, string) (*conns.AWSClient, error)
+	options  Options
+	sweepers map[string]*registeredSweeper
+}
+
+func (e *engine) run(ctx context.Context) error {
+	sweepers := filterSweepers(e.options.Run, e.sweepers)
+
+	g, err := dependencyGraph(ctx, sweepers)
+	if err != nil {
+		return err
+	}
+
+	if cycles := g.Cycles(); len(cycles) > 0 {
+		return dependencyCyclesError(cycles)
+	}
+
+	var errs []error
+
+	for _, region := range e.options.Regions {
+		region = strings.TrimSpace(region)
+		ctx := regionalContext(ctx, region)
+
+		if e.options.DryRun {
+			err = e.dryRunRegion(ctx, region, g, sweepers)
+		} else {
+			err = e.runRegion(ctx, region, g, sweepers)
+		}
+
+		if err != nil {
+			errs = append(errs, err)
+
+			if !e.options.AllowFailures {
+				break
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// runRegion runs sweepers in a Region using a bounded pool of workers.
+// A sweeper is started once all of its dependencies have completed.
+func (e *engine) runRegion(ctx context.Context, region string, g *depgraph.Graph, sweepers map[string]*registeredSweeper) error {
+	order, err := g.OverallOrder()
+	if err != nil {
+		return err
+	}
+
+	parallelism := e.options.Parallelism
+	if parallelism < 1 {
+		parallelism = DefaultParallelism
+	}
+
+	type result struct {
+		name string
+		err  error
+	}
+
+	var ready []string
+	pending := make(map[string]int, len(order))
+	for _, name := range order {
+		dependencies, err := g.DirectDependenciesOf(name)
+		if err != nil {
+			return err
+		}
+
+		if pending[name] = len(dependencies); pending[name] == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	start := time.Now()
+	tflog.Info(ctx, "Running sweepers", map[string]any{
+		"sweeper_count": len(order),
+		"parallelism":   parallelism,
+	})
+
+	results := make(chan result, len(order))
+	var errs []error
+	var running int
+	var stopped bool
+
+	for {
+		for !stopped && len(ready) > 0 && running < parallelism {
+			name := ready[0]
+			ready = ready[1:]
+			running++
+
+			go func() {
+				results <- result{name: name, err: runSweeper(ctx, region, sweepers[name])}
+			}()
+		}
+
+		if running == 0 {
+			break
+		}
+
+		r := <-results
+		running--
+
+		if r.err != nil {
+			errs = append(errs, fmt.Errorf("sweeper (%s) for region (%s) failed: %w", r.name, region, r.err))
+
+			if !e.options.AllowFailures {
+				// Let running sweepers complete but don't start any more.
+				stopped = true
+				continue
+			}
+		}
+
+		dependents, err := g.DirectDependentsOf(r.name)
+		if err != nil {
+			return err
+		}
+
+		for _, dependent := range dependents {
+			if pending[dependent]--; pending[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	tflog.Info(ctx, "Completed sweepers", map[string]any{
+		"duration":    time.Since(start).String(),
+		"error_count": len(errs),
+	})
+
+	return errors.Join(errs...)
+}
+
+func runSweeper(ctx context.Context, region string, s *registeredSweeper) error {
+	ctx = logWithResourceType(ctx, s.Name)
+
+	start := time.Now()
+	tflog.Debug(ctx, "Running sweeper")
+
+	err := s.F(region)
+
+	if err != nil {
+		tflog.Error(ctx, "Sweeper failed", map[string]any{
+			"duration": time.Since(start).String(),
+			"error":    err.Error(),
+		})
+	} else {
+		tflog.Debug(ctx, "Completed sweeper", map[string]any{
+			"duration": time.Since(start).String(),
+		})
+	}
+
+	return err
+}
+
+// dryRunRegion writes the sweepers that would be run in a Region, in order, and
+// the resources that they would delete.
+// Sweepers that delete resources other than through SweepOrchestrator cannot be listed and are reported as such.
+func (e *engine) dryRunRegion(ctx context.Context, region string, g *depgraph.Graph, sweepers map[string]*registeredSweeper) error {
+	order, err := g.OverallOrder()
+	if err != nil {
+		return err
+	}
+
+	w := e.options.Output
+	if w == nil {
+		w = io.Discard
+	}
+
+	fmt.Fprintf(w, "Sweepers for region (%s), in order:\n", region)
+
+	var client *conns.AWSClient
+	var errs []error
+
+	for _, name := range order {
+		sweepables, err := e.listSweepables(logWithResourceType(ctx, name), region, sweepers[name], &client)
+
+		if SkipSweepError(err) {
+			fmt.Fprintf(w, "  %s: skipped: %s\n", name, err)
+			continue
+		}
+
+		if errors.Is(err, conns.ErrReadOnlyOperation) {
+			fmt.Fprintf(w, "  %s: resources not listed, sweeper deletes resources without SweepOrchestrator: %s\n", name, err)
+			continue
+		}
+
+		if err != nil {
+			fmt.Fprintf(w, "  %s: error listing resources: %s\n", name, err)
+			errs = append(errs, fmt.Errorf("listing %q (%s): %w", name, region, err))
+
+			if !e.options.AllowFailures {
+				break
+			}
+			continue
+		}
+
+		fmt.Fprintf(w, "  %s: %d resource(s) would be deleted\n", name, len(sweepables))
+		for _, v := range sweepables {
+			fmt.Fprintf(w, "    - %s\n", describeSweepable(v))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// listSweepables returns the resources that a sweeper would delete in a Region without deleting them.
+// Sweepers registered without a listing function are run with the resources passed to SweepOrchestrator
+// recorded instead of deleted. *client is set on first use and reused.
+func (e *engine) listSweepables(ctx context.Context, region string, s *registeredSweeper, client **conns.AWSClient) ([]Sweepable, error) {
+	if s.list == nil {
+		var sweepables []Sweepable
+
+		err := withRecorder(func(v []Sweepable) {
+			sweepables = append(sweepables, v...)
+		}, func() error {
+			return s.F(region)
+		})
+
+		return sweepables, err
+	}
+
+	if *client == nil {
+		c, err := e.client(ctx, region)
+		if err != nil {
+			return nil, fmt.Errorf("getting client: %w", err)
+		}
+		*client = c
+	}
+
+	return s.list(ctx, *client)
+}
+
+func describeSweepable(v Sweepable) string {
+	if v, ok := v.(fmt.Stringer); ok {
+		return v.String()
+	}
+
+	return fmt.Sprintf("%T", v)
+}
+
+// filterSweepers returns the sweepers whose names contain any of the comma-separated
+// values in f, case-insensitively, together with their dependencies.
+// An empty filter returns all sweepers.
+func filterSweepers(f string, source map[string]*registeredSweeper) map[string]*registeredSweeper {
+	if f == "" {
+		return source
+	}
+
+	result := make(map[string]*registeredSweeper)
+
+	var addWithDependencies func(string)
+	addWithDependencies = func(name string) {
+		s, ok := source[name]
+		if !ok {
+			return
+		}
+
+		if _, ok := result[name]; ok {
+			return
+		}
+
+		result[name] = s
+
+		for _, dependency := range s.Dependencies {
+			addWithDependencies(dependency)
+		}
+	}
+
+	for _, v := range strings.Split(strings.ToLower(f), ",") {
+		for name := range source {
+			if strings.Contains(strings.ToLower(name), v) {
+				addWithDependencies(name)
+			}
+		}
+	}
+
+	return result
+}
+
+// dependencyGraph returns a graph of sweepers in which each sweeper depends on the
+// sweepers that must run before it.
+// Dependencies on unregistered sweepers are ignored.
+func dependencyGraph(ctx context.Context, sweepers map[string]*registeredSweeper) (*depgraph.Graph, error) {
+	g := depgraph.New()
+
+	names := tfmaps.Keys(sweepers)
+	slices.Sort(names)
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	for _, name := range names {
+		for _, dependency := range sweepers[name].Dependencies {
+			if !g.HasNode(dependency) {
+				tflog.Warn(ctx, "Sweeper dependency not found", map[string]any{
+					"sweeper":    name,
+					"dependency": dependency,
+				})
+				continue
+			}
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return g, nil
+}
+
+func dependencyCyclesError(cycles [][]string) error {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%d sweeper dependency cycle(s) found:", len(cycles))
+	for _, cycle := range cycles {
+		fmt.Fprintf(&sb, "\n\t- %s", strings.Join(cycle, " -> "))
+	}
+
+	return errors.New(sb.String())
+}
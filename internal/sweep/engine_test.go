@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepRecorder struct {
+	mu      sync.Mutex
+	order   []string
+	running atomic.Int32
+	maxSeen atomic.Int32
+}
+
+func (r *testSweepRecorder) sweeper(name string, err error, dependencies ...string) *registeredSweeper {
+	return &registeredSweeper{
+		Sweeper: &resource.Sweeper{
+			Name:         name,
+			Dependencies: dependencies,
+			F: func(string) error {
+				n := r.running.Add(1)
+				defer r.running.Add(-1)
+
+				for {
+					m := r.maxSeen.Load()
+					if n <= m || r.maxSeen.CompareAndSwap(m, n) {
+						break
+					}
+				}
+
+				time.Sleep(10 * time.Millisecond)
+
+				r.mu.Lock()
+				r.order = append(r.order, name)
+				r.mu.Unlock()
+
+				return err
+			},
+		},
+	}
+}
+
+func (r *testSweepRecorder) indexOf(name string) int {
+	for i, v := range r.order {
+		if v == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func TestEngineRun_dependencyOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &testSweepRecorder{}
+	e := &engine{
+		options: Options{
+			Parallelism: 2,
+			Regions:     []string{"us-west-2"}, //lintignore:AWSAT003
+		},
+		sweepers: map[string]*registeredSweeper{
+			"aws_a": r.sweeper("aws_a", nil, "aws_b", "aws_c"),
+			"aws_b": r.sweeper("aws_b", nil, "aws_d"),
+			"aws_c": r.sweeper("aws_c", nil, "aws_d"),
+			"aws_d": r.sweeper("aws_d", nil),
+			"aws_e": r.sweeper("aws_e", nil),
+			"aws_f": r.sweeper("aws_f", nil, "aws_unregistered"),
+		},
+	}
+
+	if err := e.run(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(r.order), len(e.sweepers); got != expected {
+		t.Fatalf("incorrect number of sweepers run. Expected: %d, got: %d", expected, got)
+	}
+
+	for _, v := range [][2]string{{"aws_d", "aws_b"}, {"aws_d", "aws_c"}, {"aws_b", "aws_a"}, {"aws_c", "aws_a"}} {
+		if r.indexOf(v[0]) > r.indexOf(v[1]) {
+			t.Errorf("expected %s to run before %s, got order %v", v[0], v[1], r.order)
+		}
+	}
+
+	if got, limit := r.maxSeen.Load(), int32(2); got > limit {
+		t.Errorf("expected at most %d concurrent sweepers, got %d", limit, got)
+	}
+}
+
+func TestEngineRun_failure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &testSweepRecorder{}
+	e := &engine{
+		options: Options{
+			Parallelism: 1,
+			Regions:     []string{"us-west-2"}, //lintignore:AWSAT003
+		},
+		sweepers: map[string]*registeredSweeper{
+			"aws_a": r.sweeper("aws_a", nil, "aws_b"),
+			"aws_b": r.sweeper("aws_b", errors.New("failed")),
+		},
+	}
+
+	err := e.run(ctx)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !regexache.MustCompile(`sweeper \(aws_b\) for region \(us-west-2\) failed: failed`).MatchString(err.Error()) { //lintignore:AWSAT003
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(r.order, []string{"aws_b"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// Dependents still run when failures are allowed.
+	r.order = nil
+	e.options.AllowFailures = true
+
+	if err := e.run(ctx); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if diff := cmp.Diff(r.order, []string{"aws_b", "aws_a"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestEngineRun_filter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &testSweepRecorder{}
+	e := &engine{
+		options: Options{
+			Regions: []string{"us-west-2"}, //lintignore:AWSAT003
+			Run:     "AWS_A",
+		},
+		sweepers: map[string]*registeredSweeper{
+			"aws_a": r.sweeper("aws_a", nil, "aws_b"),
+			"aws_b": r.sweeper("aws_b", nil),
+			"aws_c": r.sweeper("aws_c", nil),
+		},
+	}
+
+	if err := e.run(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(r.order, []string{"aws_b", "aws_a"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestEngineRun_cycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &testSweepRecorder{}
+	e := &engine{
+		options: Options{
+			Regions: []string{"us-west-2"}, //lintignore:AWSAT003
+		},
+		sweepers: map[string]*registeredSweeper{
+			"aws_a": r.sweeper("aws_a", nil, "aws_b"),
+			"aws_b": r.sweeper("aws_b", nil, "aws_c"),
+			"aws_c": r.sweeper("aws_c", nil, "aws_a"),
+			"aws_d": r.sweeper("aws_d", nil),
+		},
+	}
+
+	err := e.run(ctx)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, expected := err.Error(), "1 sweeper dependency cycle(s) found:\n\t- aws_a -> aws_b -> aws_c -> aws_a"; got != expected {
+		t.Errorf("incorrect error. Expected: %q, got: %q", expected, got)
+	}
+
+	if len(r.order) != 0 {
+		t.Errorf("expected no sweepers to run, got %v", r.order)
+	}
+}
+
+type testSweepable string
+
+func (v testSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	return errors.New("unexpected delete")
+}
+
+func (v testSweepable) String() string {
+	return string(v)
+}
+
+func TestEngineRun_dryRun(t *testing.T) { //nolint:paralleltest // Sets the package-level sweep recorder.
+	ctx := context.Background()
+	r := &testSweepRecorder{}
+	listable := r.sweeper("aws_a", nil, "aws_b", "aws_c")
+	listable.list = func(context.Context, *conns.AWSClient) ([]Sweepable, error) {
+		return []Sweepable{testSweepable("a-1"), testSweepable("a-2")}, nil
+	}
+
+	var out bytes.Buffer
+	e := &engine{
+		client: func(context.Context, string) (*conns.AWSClient, error) {
+			return &conns.AWSClient{}, nil
+		},
+		options: Options{
+			DryRun:  true,
+			Output:  &out,
+			Regions: []string{"us-west-2"}, //lintignore:AWSAT003
+		},
+		sweepers: map[string]*registeredSweeper{
+			"aws_a": listable,
+			"aws_b": {
+				// Sweepers that do not separate listing from deletion pass their resources to SweepOrchestrator.
+				Sweeper: &resource.Sweeper{
+					Name: "aws_b",
+					F: func(string) error {
+						return SweepOrchestrator(ctx, []Sweepable{testSweepable("b-1")})
+					},
+				},
+			},
+			"aws_c": {
+				// Sweepers that delete resources directly are rejected by read-only clients.
+				Sweeper: &resource.Sweeper{
+					Name:         "aws_c",
+					Dependencies: []string{"aws_b"},
+					F: func(string) error {
+						return fmt.Errorf("deleting c-1: %w", conns.ErrReadOnlyOperation)
+					},
+				},
+			},
+		},
+	}
+
+	if err := e.run(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(r.order) != 0 {
+		t.Errorf("expected no sweepers to run, got %v", r.order)
+	}
+
+	expected := `Sweepers for region (us-west-2), in order:
+  aws_b: 1 resource(s) would be deleted
+    - b-1
+  aws_c: resources not listed, sweeper deletes resources without SweepOrchestrator: deleting c-1: operation is not permitted in read-only mode
+  aws_a: 2 resource(s) would be deleted
+    - a-1
+    - a-2
+` //lintignore:AWSAT003
+	if diff := cmp.Diff(out.String(), expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if recorder() != nil {
+		t.Error("expected sweep recorder to be unset")
+	}
+}
@@ -5,6 +5,8 @@ package framework
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -20,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -48,6 +51,42 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
+// String returns the identifying attributes of the resource to be deleted.
+func (sr *sweepResource) String() string {
+	parts := make([]string, 0, len(sr.attributes))
+	for _, attr := range sr.attributes {
+		parts = append(parts, fmt.Sprintf("%s=%v", attr.path, attr.value))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// ImportID returns the ID with which the resource is imported and whether the resource supports import.
+// The ID is the value of the `id` attribute or, if the resource is identified by a single attribute, that attribute's value.
+func (sr *sweepResource) ImportID(ctx context.Context) (string, bool, error) {
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return "", false, err
+	}
+
+	if _, ok := resource.(fwresource.ResourceWithImportState); !ok {
+		return "", false, nil
+	}
+
+	for _, attr := range sr.attributes {
+		if attr.path == names.AttrID {
+			return fmt.Sprint(attr.value), true, nil
+		}
+	}
+
+	if len(sr.attributes) == 1 {
+		return fmt.Sprint(sr.attributes[0].value), true, nil
+	}
+
+	return "", true, errors.New("import ID format unknown")
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	resource, err := sr.factory(ctx)
 
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// ImportBlocksOptions configures generation of import blocks.
+type ImportBlocksOptions struct {
+	// Output receives the generated HCL.
+	Output io.Writer
+
+	// Regions are the Regions to list resources in, in order.
+	Regions []string
+
+	// ResourceTypes are the resource types to list, in order.
+	// Each must be the name of a registered sweeper.
+	ResourceTypes []string
+}
+
+// ImportBlocks writes Terraform `import` blocks for existing resources of the specified types.
+// Resources are enumerated using each sweeper's listing logic with read-only AWS API clients and are never deleted.
+// Import IDs are in the format expected by each resource's importer.
+// Resources whose import ID format cannot be determined are reported as comments.
+func ImportBlocks(ctx context.Context, opts ImportBlocksOptions) error {
+	setReadOnlyClients(true)
+	defer setReadOnlyClients(false)
+
+	e := &engine{
+		client: SharedRegionalSweepClient,
+		options: Options{
+			Output:  opts.Output,
+			Regions: opts.Regions,
+		},
+		sweepers: registeredSweepers,
+	}
+
+	return e.importBlocks(ctx, opts.ResourceTypes)
+}
+
+// importer is implemented by sweepable resources that can report their import ID.
+// ImportID returns false if the resource does not support import and an error if its import ID cannot be determined.
+type importer interface {
+	ImportID(context.Context) (string, bool, error)
+}
+
+// importBlocks lists resources of the specified types in each Region and writes an import block for each.
+// Listing errors are reported as comments and returned once all Regions and types have been listed.
+func (e *engine) importBlocks(ctx context.Context, resourceTypes []string) error {
+	resourceTypes = tfslices.ApplyToAll(resourceTypes, strings.TrimSpace)
+
+	for _, resourceType := range resourceTypes {
+		if _, ok := e.sweepers[resourceType]; !ok {
+			return fmt.Errorf("no sweeper registered for resource type %q", resourceType)
+		}
+	}
+
+	w := e.options.Output
+	if w == nil {
+		w = io.Discard
+	}
+
+	multiRegion := len(e.options.Regions) > 1
+	labels := make(map[string]bool) // Addresses already used.
+	var errs []error
+
+	for _, region := range e.options.Regions {
+		region = strings.TrimSpace(region)
+		ctx := regionalContext(ctx, region)
+
+		var client *conns.AWSClient
+
+		for _, resourceType := range resourceTypes {
+			s := e.sweepers[resourceType]
+			ctx := logWithResourceType(ctx, resourceType)
+
+			sweepables, err := e.listSweepables(ctx, region, s, &client)
+
+			if SkipSweepError(err) {
+				fmt.Fprintf(w, "# %s (%s): skipped: %s\n\n", resourceType, region, commentText(err.Error()))
+				continue
+			}
+
+			if err != nil {
+				fmt.Fprintf(w, "# %s (%s): error listing resources: %s\n\n", resourceType, region, commentText(err.Error()))
+				errs = append(errs, fmt.Errorf("listing %q (%s): %w", resourceType, region, err))
+				continue
+			}
+
+			for _, v := range sweepables {
+				var id string
+				var ok bool
+				var err error
+
+				if v, isImporter := v.(importer); isImporter {
+					id, ok, err = v.ImportID(ctx)
+				}
+
+				if err != nil {
+					fmt.Fprintf(w, "# %s (%s): %s: %s\n\n", resourceType, region, commentText(describeSweepable(v)), commentText(err.Error()))
+					continue
+				}
+
+				if !ok {
+					fmt.Fprintf(w, "# %s (%s): %s: resource does not support import\n\n", resourceType, region, commentText(describeSweepable(v)))
+					continue
+				}
+
+				base := importLabel(id)
+				label := base
+				for n := 2; labels[resourceType+"."+label]; n++ {
+					label = fmt.Sprintf("%s_%d", base, n)
+				}
+				labels[resourceType+"."+label] = true
+
+				fmt.Fprintln(w, "import {")
+				if multiRegion {
+					// Each Region is expected to have a provider configuration whose alias is the Region name.
+					fmt.Fprintf(w, "  provider = aws.%s\n", strings.ReplaceAll(region, "-", "_"))
+					fmt.Fprintf(w, "  to       = %s.%s\n", resourceType, label)
+					fmt.Fprintf(w, "  id       = %s\n", quoteHCLString(id))
+				} else {
+					fmt.Fprintf(w, "  to = %s.%s\n", resourceType, label)
+					fmt.Fprintf(w, "  id = %s\n", quoteHCLString(id))
+				}
+				fmt.Fprint(w, "}\n\n")
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// importLabel returns a resource name label derived from an import ID.
+// The label is based on the ID's final component, e.g. the name in an ARN.
+func importLabel(id string) string {
+	if i := strings.LastIndexAny(id, ":/,|"); i >= 0 && i < len(id)-1 {
+		id = id[i+1:]
+	}
+
+	label := strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-') {
+			return r
+		}
+		return '_'
+	}, id)
+
+	if label == "" {
+		return "resource"
+	}
+
+	if r := rune(label[0]); !unicode.IsLetter(r) && r != '_' {
+		label = "r_" + label
+	}
+
+	return label
+}
+
+// quoteHCLString returns s as a quoted HCL string literal.
+// Template sequences are escaped so that the value is taken literally.
+func quoteHCLString(s string) string {
+	var b strings.Builder
+
+	b.WriteByte('"')
+	for i, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '$', '%':
+			b.WriteRune(r)
+			if strings.HasPrefix(s[i+1:], "{") {
+				b.WriteRune(r)
+			}
+		default:
+			switch {
+			case unicode.IsPrint(r):
+				b.WriteRune(r)
+			case r > 0xFFFF:
+				fmt.Fprintf(&b, `\U%08X`, r)
+			default:
+				fmt.Fprintf(&b, `\u%04X`, r)
+			}
+		}
+	}
+	b.WriteByte('"')
+
+	return b.String()
+}
+
+// commentText returns s on a single line, suitable for inclusion in an HCL comment.
+func commentText(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
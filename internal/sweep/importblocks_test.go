@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+type testImportable struct {
+	testSweepable
+	importable bool
+	err        error
+}
+
+func (v testImportable) ImportID(context.Context) (string, bool, error) {
+	return string(v.testSweepable), v.importable, v.err
+}
+
+func TestEngineImportBlocks(t *testing.T) { //nolint:paralleltest // Sets the package-level sweep recorder.
+	ctx := context.Background()
+
+	var out bytes.Buffer
+	e := &engine{
+		client: func(context.Context, string) (*conns.AWSClient, error) {
+			return &conns.AWSClient{}, nil
+		},
+		options: Options{
+			Output:  &out,
+			Regions: []string{"us-west-2"}, //lintignore:AWSAT003
+		},
+		sweepers: map[string]*registeredSweeper{
+			"aws_a": {
+				Sweeper: &resource.Sweeper{Name: "aws_a"},
+				list: func(context.Context, *conns.AWSClient) ([]Sweepable, error) {
+					return []Sweepable{
+						testImportable{testSweepable: "arn:aws:a:us-west-2:123456789012:thing/one", importable: true}, //lintignore:AWSAT003,AWSAT005
+						testImportable{testSweepable: "arn:aws:a:us-west-2:123456789012:other/one", importable: true}, //lintignore:AWSAT003,AWSAT005
+						testImportable{testSweepable: "2", importable: false},
+						testSweepable("3"),
+						testImportable{testSweepable: "4", importable: true, err: errors.New("import ID format unknown")},
+					}, nil
+				},
+			},
+			"aws_b": {
+				// Sweepers that do not separate listing from deletion pass their resources to SweepOrchestrator.
+				Sweeper: &resource.Sweeper{
+					Name: "aws_b",
+					F: func(string) error {
+						return SweepOrchestrator(ctx, []Sweepable{
+							testImportable{testSweepable: "x,${y}", importable: true},
+						})
+					},
+				},
+			},
+			"aws_c": {
+				Sweeper: &resource.Sweeper{
+					Name: "aws_c",
+					F: func(string) error {
+						return errors.New("failed")
+					},
+				},
+			},
+			"aws_d": {
+				Sweeper: &resource.Sweeper{Name: "aws_d"},
+				list: func(context.Context, *conns.AWSClient) ([]Sweepable, error) {
+					return nil, awserr.New("AccessDeniedException", "not authorized", nil)
+				},
+			},
+		},
+	}
+
+	err := e.importBlocks(ctx, []string{"aws_a", "aws_b", "aws_c", "aws_d"})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	expected := `import {
+  to = aws_a.one
+  id = "arn:aws:a:us-west-2:123456789012:thing/one"
+}
+
+import {
+  to = aws_a.one_2
+  id = "arn:aws:a:us-west-2:123456789012:other/one"
+}
+
+# aws_a (us-west-2): 2: resource does not support import
+
+# aws_a (us-west-2): 3: resource does not support import
+
+# aws_a (us-west-2): 4: import ID format unknown
+
+import {
+  to = aws_b.__y_
+  id = "x,$${y}"
+}
+
+# aws_c (us-west-2): error listing resources: failed
+
+# aws_d (us-west-2): skipped: AccessDeniedException: not authorized
+
+` //lintignore:AWSAT003,AWSAT005
+	if diff := cmp.Diff(out.String(), expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if recorder() != nil {
+		t.Error("expected sweep recorder to be unset")
+	}
+}
+
+func TestEngineImportBlocks_multipleRegions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var out bytes.Buffer
+	e := &engine{
+		client: func(context.Context, string) (*conns.AWSClient, error) {
+			return &conns.AWSClient{}, nil
+		},
+		options: Options{
+			Output:  &out,
+			Regions: []string{"us-west-2", "us-east-1"}, //lintignore:AWSAT003
+		},
+		sweepers: map[string]*registeredSweeper{
+			"aws_a": {
+				Sweeper: &resource.Sweeper{Name: "aws_a"},
+				list: func(context.Context, *conns.AWSClient) ([]Sweepable, error) {
+					return []Sweepable{testImportable{testSweepable: "a", importable: true}}, nil
+				},
+			},
+		},
+	}
+
+	if err := e.importBlocks(ctx, []string{"aws_a"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `import {
+  provider = aws.us_west_2
+  to       = aws_a.a
+  id       = "a"
+}
+
+import {
+  provider = aws.us_east_1
+  to       = aws_a.a_2
+  id       = "a"
+}
+
+` //lintignore:AWSAT003
+	if diff := cmp.Diff(out.String(), expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestEngineImportBlocks_clientError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var out bytes.Buffer
+	e := &engine{
+		client: func(context.Context, string) (*conns.AWSClient, error) {
+			return nil, errors.New("no credentials")
+		},
+		options: Options{
+			Output:  &out,
+			Regions: []string{"us-west-2"}, //lintignore:AWSAT003
+		},
+		sweepers: map[string]*registeredSweeper{
+			"aws_a": {
+				Sweeper: &resource.Sweeper{Name: "aws_a"},
+				list: func(context.Context, *conns.AWSClient) ([]Sweepable, error) {
+					return []Sweepable{testImportable{testSweepable: "a", importable: true}}, nil
+				},
+			},
+		},
+	}
+
+	err := e.importBlocks(ctx, []string{"aws_a"})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	expected := `# aws_a (us-west-2): error listing resources: getting client: no credentials
+
+` //lintignore:AWSAT003
+	if diff := cmp.Diff(out.String(), expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestEngineImportBlocks_unknownResourceType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	e := &engine{
+		options: Options{
+			Regions: []string{"us-west-2"}, //lintignore:AWSAT003
+		},
+		sweepers: map[string]*registeredSweeper{},
+	}
+
+	err := e.importBlocks(ctx, []string{"aws_a"})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if got, expected := err.Error(), `no sweeper registered for resource type "aws_a"`; got != expected {
+		t.Errorf("incorrect error. Expected: %q, got: %q", expected, got)
+	}
+}
+
+func TestImportLabel(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"":          "resource",
+		"my-bucket": "my-bucket",
+		"arn:aws:sns:us-west-2:123456789012:my-topic": "my-topic", //lintignore:AWSAT003,AWSAT005
+		"cluster/service": "service",
+		"sg-123,ingress":  "ingress",
+		"trailing/":       "trailing_",
+		"123abc":          "r_123abc",
+		"a.b c":           "a_b_c",
+		"ünïcode":         "_n_code",
+	}
+
+	for id, expected := range testCases {
+		if got := importLabel(id); got != expected {
+			t.Errorf("importLabel(%q) = %q, want %q", id, got, expected)
+		}
+	}
+}
+
+func TestQuoteHCLString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"plain":    `"plain"`,
+		`a"b\c`:    `"a\"b\\c"`,
+		"${x}%{y}": `"$${x}%%{y}"`,
+		"$x%y":     `"$x%y"`,
+		"a\nb\tc":  `"a\nb\tc"`,
+		"\x00":     `"\u0000"`,
+	}
+
+	for s, expected := range testCases {
+		if got := quoteHCLString(s); got != expected {
+			t.Errorf("quoteHCLString(%q) = %s, want %s", s, got, expected)
+		}
+	}
+}
@@ -5,7 +5,9 @@ package sdk
 
 import (
 	"context"
+	"errors"
 	"math/rand"
+	"reflect"
 	"strings"
 	"time"
 
@@ -20,14 +22,42 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// errImportIDFormatUnknown is returned when a resource supports import but its import ID cannot be derived from its ID.
+var errImportIDFormatUnknown = errors.New("import ID format unknown")
+
+// ImportIDFunc returns the ID with which the resource described by d is imported.
+type ImportIDFunc func(d *schema.ResourceData) (string, error)
+
 type sweepResource struct {
 	d        *schema.ResourceData
+	importID ImportIDFunc
 	meta     *conns.AWSClient
 	resource *schema.Resource
 }
 
-func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) *sweepResource {
+type SweepResourceOptionsFunc func(*sweepResource)
+
+// WithImportID sets the function used to derive the resource's import ID.
+// It is required for resources whose importer does not take the resource's ID as-is.
+func WithImportID(f ImportIDFunc) SweepResourceOptionsFunc {
+	return func(sr *sweepResource) {
+		sr.importID = f
+	}
+}
+
+// WithPassthroughImportID marks the resource's custom importer as taking the resource's ID as-is,
+// e.g. an importer that only sets defaults for attributes that cannot be read from the API.
+func WithPassthroughImportID() SweepResourceOptionsFunc {
+	return WithImportID(func(d *schema.ResourceData) (string, error) {
+		return d.Id(), nil
+	})
+}
+
+func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient, optFns ...SweepResourceOptionsFunc) *sweepResource {
 	s := newSweepResource(resource, d, meta)
+	for _, optFn := range optFns {
+		optFn(&s)
+	}
 	return &s
 }
 
@@ -39,6 +69,30 @@ func newSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *c
 	}
 }
 
+// String returns the ID of the resource to be deleted.
+func (sr *sweepResource) String() string {
+	return sr.d.Id()
+}
+
+// ImportID returns the ID with which the resource is imported and whether the resource supports import.
+// The resource's ID is only used if its importer is a passthrough importer, otherwise an ImportIDFunc is required.
+func (sr *sweepResource) ImportID(context.Context) (string, bool, error) {
+	if sr.resource.Importer == nil {
+		return "", false, nil
+	}
+
+	if sr.importID != nil {
+		id, err := sr.importID(sr.d)
+		return id, true, err
+	}
+
+	if isPassthroughImporter(sr.resource.Importer) {
+		return sr.d.Id(), true, nil
+	}
+
+	return "", true, errImportIDFormatUnknown
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	ctx = tflog.SetField(ctx, "id", sr.d.Id())
 
@@ -105,6 +159,19 @@ func (rsr *readerSweepResource) Read(ctx context.Context) error {
 	return ReadResource(ctx, rsr.resource, rsr.d, rsr.meta)
 }
 
+// isPassthroughImporter returns whether the importer uses the import ID as the resource's ID.
+func isPassthroughImporter(importer *schema.ResourceImporter) bool {
+	if importer.StateContext != nil {
+		return reflect.ValueOf(importer.StateContext).Pointer() == reflect.ValueOf(schema.ImportStatePassthroughContext).Pointer()
+	}
+
+	if importer.State != nil { //nolint:staticcheck // Deprecated importer variant.
+		return reflect.ValueOf(importer.State).Pointer() == reflect.ValueOf(schema.ImportStatePassthrough).Pointer() //nolint:staticcheck // Deprecated importer variant.
+	}
+
+	return false
+}
+
 func deleteResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) error {
 	if resource.DeleteContext != nil || resource.DeleteWithoutTimeout != nil {
 		var diags diag.Diagnostics
@@ -8,3 +8,7 @@ import (
 )
 
 var NewSweepResource = sdk.NewSweepResource
+
+var WithImportID = sdk.WithImportID
+
+var WithPassthroughImportID = sdk.WithPassthroughImportID
@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -33,11 +35,32 @@ var ServicePackages []conns.ServicePackage
 
 // sweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+var (
+	sweeperClients      map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+	sweeperClientsMutex sync.Mutex
+
+	// readOnlyClients is set during dry runs and while import blocks are generated.
+	// Clients then reject any AWS API operation that is not read-only.
+	readOnlyClients bool
+)
+
+// sweepRecorder, if set, receives the resources passed to SweepOrchestrator instead of them being deleted.
+var (
+	sweepRecorder      func([]Sweepable)
+	sweepRecorderMutex sync.Mutex
+)
 
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
-	if client, ok := sweeperClients[region]; ok {
+	sweeperClientsMutex.Lock()
+	defer sweeperClientsMutex.Unlock()
+
+	key := region
+	if readOnlyClients {
+		key += "/read-only"
+	}
+
+	if client, ok := sweeperClients[key]; ok {
 		return client, nil
 	}
 
@@ -63,29 +86,34 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 
 	conf := &conns.Config{
 		MaxRetries:       5,
+		ReadOnly:         readOnlyClients,
 		Region:           region,
 		SuppressDebugLog: true,
 	}
 
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
-		conf.AssumeRole.RoleARN = role
+		assumeRole := awsbase.AssumeRole{
+			RoleARN:  role,
+			Duration: time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second,
+		}
 
-		conf.AssumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
 		if v := os.Getenv(envvar.AssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", envvar.AssumeRoleDuration, err)
 			}
-			conf.AssumeRole.Duration = time.Duration(d) * time.Second
+			assumeRole.Duration = time.Duration(d) * time.Second
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleExternalID); v != "" {
-			conf.AssumeRole.ExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleSessionName); v != "" {
-			conf.AssumeRole.SessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRole = []awsbase.AssumeRole{assumeRole}
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -95,16 +123,42 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		return nil, fmt.Errorf("getting AWS client: %#v", diags)
 	}
 
-	sweeperClients[region] = client
+	sweeperClients[key] = client
 
 	return client, nil
 }
 
+// UseSharedRegionalSweepClient makes SharedRegionalSweepClient return client for the Region until the returned function is called.
+// It allows sweepers to be run against fake AWS services in tests.
+func UseSharedRegionalSweepClient(region string, client *conns.AWSClient) func() {
+	sweeperClientsMutex.Lock()
+	defer sweeperClientsMutex.Unlock()
+
+	keys := []string{region, region + "/read-only"}
+	for _, key := range keys {
+		sweeperClients[key] = client
+	}
+
+	return func() {
+		sweeperClientsMutex.Lock()
+		defer sweeperClientsMutex.Unlock()
+
+		for _, key := range keys {
+			delete(sweeperClients, key)
+		}
+	}
+}
+
 type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	if record := recorder(); record != nil {
+		record(sweepables)
+		return nil
+	}
+
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
@@ -122,6 +176,37 @@ func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tf
 	return g.Wait().ErrorOrNil()
 }
 
+func recorder() func([]Sweepable) {
+	sweepRecorderMutex.Lock()
+	defer sweepRecorderMutex.Unlock()
+
+	return sweepRecorder
+}
+
+// withRecorder runs f with SweepOrchestrator passing listed resources to record instead of deleting them.
+func withRecorder(record func([]Sweepable), f func() error) error {
+	sweepRecorderMutex.Lock()
+	sweepRecorder = record
+	sweepRecorderMutex.Unlock()
+
+	defer func() {
+		sweepRecorderMutex.Lock()
+		sweepRecorder = nil
+		sweepRecorderMutex.Unlock()
+	}()
+
+	return f()
+}
+
+// setReadOnlyClients sets whether SharedRegionalSweepClient returns clients that reject
+// any AWS API operation that is not read-only.
+func setReadOnlyClients(v bool) {
+	sweeperClientsMutex.Lock()
+	defer sweeperClientsMutex.Unlock()
+
+	readOnlyClients = v
+}
+
 // Deprecated: Use awsv1.SkipSweepError
 var SkipSweepError = awsv1.SkipSweepError
 
@@ -135,9 +220,11 @@ func PartitionDNSSuffix(region string) string {
 
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
 
+// Register registers a sweeper that lists resources with f and deletes them with SweepOrchestrator.
 func Register(name string, f SweeperFn, dependencies ...string) {
-	resource.AddTestSweepers(name, &resource.Sweeper{
-		Name: name,
+	registerSweeper(name, &resource.Sweeper{
+		Name:         name,
+		Dependencies: dependencies,
 		F: func(region string) error {
 			ctx := Context(region)
 			ctx = logWithResourceType(ctx, name)
@@ -166,5 +253,12 @@ func Register(name string, f SweeperFn, dependencies ...string) {
 
 			return nil
 		},
-	})
+	}, f)
+}
+
+// AddTestSweepers registers a sweeper with the sweeper engine and the plugin-testing harness.
+// In dry runs the sweeper is run with read-only AWS API clients and the resources that it passes to
+// SweepOrchestrator are listed instead of deleted.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	registerSweeper(name, s, nil)
 }
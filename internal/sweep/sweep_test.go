@@ -5,12 +5,23 @@ package sweep_test
 
 import (
 	"context"
+	"errors"
+	"flag"
+	"log"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
+var (
+	flagDryRun          = flag.Bool("dry-run", false, "List the resources that sweepers would delete without deleting them")
+	flagImportBlocksOut = flag.String("import-blocks-out", "", "Write import blocks for the resources listed by the -sweep-run sweepers to this file instead of sweeping")
+	flagParallelism     = flag.Int("sweep-parallelism", sweep.DefaultParallelism, "Maximum number of sweepers to run concurrently in each Region")
+)
+
 func TestMain(m *testing.M) {
 	ctx := context.Background()
 
@@ -18,5 +29,54 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
+	flag.Parse()
+
+	// The -sweep, -sweep-run and -sweep-allow-failures flags are defined by the plugin-testing harness.
+	if regions := flag.Lookup("sweep").Value.String(); regions != "" && *flagImportBlocksOut != "" {
+		if err := importBlocks(ctx, *flagImportBlocksOut, strings.Split(regions, ","), flag.Lookup("sweep-run").Value.String()); err != nil {
+			log.Printf("[ERROR] %s", err)
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	}
+
+	if regions := flag.Lookup("sweep").Value.String(); regions != "" {
+		err := sweep.Run(ctx, sweep.Options{
+			AllowFailures: flag.Lookup("sweep-allow-failures").Value.String() == "true",
+			DryRun:        *flagDryRun,
+			Output:        os.Stdout,
+			Parallelism:   *flagParallelism,
+			Regions:       strings.Split(regions, ","),
+			Run:           flag.Lookup("sweep-run").Value.String(),
+		})
+
+		if err != nil {
+			log.Printf("[ERROR] %s", err)
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	}
+
 	resource.TestMain(m)
 }
+
+func importBlocks(ctx context.Context, filename string, regions []string, resourceTypes string) error {
+	if resourceTypes == "" {
+		return errors.New("-sweep-run must list the resource types to generate import blocks for")
+	}
+
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	err = sweep.ImportBlocks(ctx, sweep.ImportBlocksOptions{
+		Output:        f,
+		Regions:       regions,
+		ResourceTypes: strings.Split(resourceTypes, ","),
+	})
+
+	return errors.Join(err, f.Close())
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"path"
+)
+
+// DefaultTagsRule adds, overrides or suppresses default tags for resource types matching any of its patterns.
+type DefaultTagsRule struct {
+	ResourceTypes []string     // Resource type glob patterns, e.g. "aws_iam_*"
+	Tags          KeyValueTags // Tags to add or override
+	ExcludeKeys   []string     // Tag keys to suppress
+}
+
+// ValidResourceTypePattern returns an error if pattern is not a valid resource type glob pattern.
+func ValidResourceTypePattern(pattern string) error {
+	_, err := path.Match(pattern, "")
+	return err
+}
+
+// matchesResourceType returns whether the specified resource type matches any of the patterns.
+func matchesResourceType(patterns []string, typeName string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, typeName); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matches returns whether the rule applies to the specified resource type.
+func (r DefaultTagsRule) matches(typeName string) bool {
+	return matchesResourceType(r.ResourceTypes, typeName)
+}
+
+// ForResourceType returns the default tags configuration for the specified resource type with any matching rules applied.
+// Rules are applied in order. Within a rule, excluded keys are removed after the rule's tags are added or overridden.
+// The returned configuration has no rules.
+func (dc *DefaultConfig) ForResourceType(ctx context.Context, typeName string) *DefaultConfig {
+	if dc == nil || len(dc.Rules) == 0 {
+		return dc
+	}
+
+	tags := New(ctx, dc.Tags)
+	for _, rule := range dc.Rules {
+		if !rule.matches(typeName) {
+			continue
+		}
+
+		tags = tags.Merge(rule.Tags).Ignore(New(ctx, rule.ExcludeKeys))
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
+}
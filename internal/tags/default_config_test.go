@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDefaultConfigForResourceType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"CostCenter":  "1234",
+			"Environment": "production",
+		}),
+		Rules: []DefaultTagsRule{
+			{
+				ResourceTypes: []string{"aws_instance"},
+				Tags: New(ctx, map[string]string{
+					"Backup": "daily",
+				}),
+			},
+			{
+				ResourceTypes: []string{"aws_iam_*", "aws_sqs_queue"},
+				ExcludeKeys:   []string{"CostCenter"},
+			},
+			{
+				ResourceTypes: []string{"aws_iam_role"},
+				Tags: New(ctx, map[string]string{
+					"CostCenter":  "5678",
+					"Environment": "shared",
+				}),
+				ExcludeKeys: []string{"Environment"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		typeName      string
+		want          map[string]string
+	}{
+		{
+			name:     "no matching rules",
+			typeName: "aws_vpc",
+			want: map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "production",
+			},
+		},
+		{
+			name:     "add",
+			typeName: "aws_instance",
+			want: map[string]string{
+				"Backup":      "daily",
+				"CostCenter":  "1234",
+				"Environment": "production",
+			},
+		},
+		{
+			name:     "suppress by pattern",
+			typeName: "aws_iam_user",
+			want: map[string]string{
+				"Environment": "production",
+			},
+		},
+		{
+			name:     "suppress by name",
+			typeName: "aws_sqs_queue",
+			want: map[string]string{
+				"Environment": "production",
+			},
+		},
+		{
+			name:     "later rules override",
+			typeName: "aws_iam_role",
+			want: map[string]string{
+				"CostCenter": "5678",
+			},
+		},
+		{
+			name: "no rules",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"CostCenter": "1234",
+				}),
+			},
+			typeName: "aws_iam_role",
+			want: map[string]string{
+				"CostCenter": "1234",
+			},
+		},
+		{
+			name: "no default tags",
+			defaultConfig: &DefaultConfig{
+				Rules: []DefaultTagsRule{
+					{
+						ResourceTypes: []string{"aws_*"},
+						Tags: New(ctx, map[string]string{
+							"ManagedBy": "terraform",
+						}),
+					},
+				},
+			},
+			typeName: "aws_vpc",
+			want: map[string]string{
+				"ManagedBy": "terraform",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			dc := testCase.defaultConfig
+			if dc == nil {
+				dc = defaultConfig
+			}
+
+			got := dc.ForResourceType(ctx, testCase.typeName)
+
+			if len(got.Rules) != 0 {
+				t.Errorf("got %d rules, want none", len(got.Rules))
+			}
+			testKeyValueTagsVerifyMap(t, got.Tags.Map(), testCase.want)
+		})
+	}
+}
+
+func TestDefaultConfigForResourceType_nil(t *testing.T) {
+	t.Parallel()
+
+	var dc *DefaultConfig
+
+	if got := dc.ForResourceType(context.Background(), "aws_vpc"); got != nil {
+		t.Errorf("got %v, want nil", got)
+	}
+}
+
+func TestValidResourceTypePattern(t *testing.T) {
+	t.Parallel()
+
+	for _, pattern := range []string{"aws_instance", "aws_iam_*", "aws_?pc", "aws_[ev]*"} {
+		if err := ValidResourceTypePattern(pattern); err != nil {
+			t.Errorf("ValidResourceTypePattern(%q) = %s, want no error", pattern, err)
+		}
+	}
+
+	if err := ValidResourceTypePattern("aws_[instance"); err == nil {
+		t.Error("ValidResourceTypePattern(\"aws_[instance\") = nil, want error")
+	}
+}
@@ -32,7 +32,8 @@ const (
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
-	Tags KeyValueTags
+	Tags  KeyValueTags
+	Rules []DefaultTagsRule // Per-resource type rules. See ForResourceType.
 }
 
 // IgnoreConfig contains various options for removing resource tags.
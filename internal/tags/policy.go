@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	PolicySeverityError   = "error"
+	PolicySeverityWarning = "warning"
+)
+
+func PolicySeverity_Values() []string {
+	return []string{
+		PolicySeverityError,
+		PolicySeverityWarning,
+	}
+}
+
+// PolicyConfig contains tag policy configuration that is evaluated when planning resources.
+type PolicyConfig struct {
+	Severity string // One of the PolicySeverity* values. Defaults to PolicySeverityError.
+	Rules    []PolicyRule
+}
+
+// PolicyRule is a tag policy rule for a single tag key.
+// Tag keys are matched case-insensitively.
+type PolicyRule struct {
+	Key           string   // Tag key, in the required capitalization if EnforceCase is set.
+	Required      bool     // Whether resources must have the tag.
+	AllowedValues []string // If non-empty, the allowed tag values. A value ending in `*` matches any value with that prefix.
+	EnforceCase   bool     // Whether the tag key must have the same capitalization as Key.
+	ResourceTypes []string // If non-empty, resource type patterns that the rule applies to. See ValidResourceTypePattern.
+}
+
+// IsWarning returns whether tag policy violations are reported as warnings instead of errors.
+func (pc *PolicyConfig) IsWarning() bool {
+	return pc != nil && pc.Severity == PolicySeverityWarning
+}
+
+// Violations returns a description of each violation of the tag policy by the specified resource type's tags.
+func (pc *PolicyConfig) Violations(typeName string, tags KeyValueTags) []string {
+	if pc == nil {
+		return nil
+	}
+
+	var violations []string
+
+	for _, rule := range pc.Rules {
+		if len(rule.ResourceTypes) > 0 && !matchesResourceType(rule.ResourceTypes, typeName) {
+			continue
+		}
+
+		var found bool
+		for _, k := range tags.Keys() {
+			if !strings.EqualFold(k, rule.Key) {
+				continue
+			}
+			found = true
+
+			if rule.EnforceCase && k != rule.Key {
+				violations = append(violations, fmt.Sprintf("tag key %q must be capitalized as %q", k, rule.Key))
+			}
+
+			if v := tags.KeyValue(k); v != nil && len(rule.AllowedValues) > 0 && !rule.isAllowedValue(*v) {
+				violations = append(violations, fmt.Sprintf("tag %q value %q is not one of the allowed values: %s", k, *v, strings.Join(rule.AllowedValues, ", ")))
+			}
+		}
+
+		if !found && rule.Required {
+			violations = append(violations, fmt.Sprintf("tag %q is required", rule.Key))
+		}
+	}
+
+	slices.Sort(violations)
+
+	return slices.Compact(violations)
+}
+
+func (rule PolicyRule) isAllowedValue(v string) bool {
+	return slices.ContainsFunc(rule.AllowedValues, func(allowed string) bool {
+		if prefix, ok := strings.CutSuffix(allowed, "*"); ok {
+			return strings.HasPrefix(v, prefix)
+		}
+		return v == allowed
+	})
+}
+
+// organizationsTagPolicy is the JSON document of an AWS Organizations tag policy.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_example-tag-policies.html.
+type organizationsTagPolicy struct {
+	Tags map[string]struct {
+		TagKey   json.RawMessage `json:"tag_key"`
+		TagValue json.RawMessage `json:"tag_value"`
+	} `json:"tags"`
+}
+
+// ExpandOrganizationsTagPolicy returns the tag policy rules for an AWS Organizations tag policy JSON document,
+// typically an account's effective tag policy.
+// Tag keys must have the capitalization specified in `tag_key` and values must be one of those in `tag_value`.
+// `enforced_for` is ignored: rules apply to all resources, as for tag policy compliance reporting.
+func ExpandOrganizationsTagPolicy(document []byte) ([]PolicyRule, error) {
+	var policy organizationsTagPolicy
+
+	if err := json.Unmarshal(document, &policy); err != nil {
+		return nil, fmt.Errorf("parsing AWS Organizations tag policy: %w", err)
+	}
+
+	var rules []PolicyRule
+
+	for name, tag := range policy.Tags {
+		rule := PolicyRule{
+			Key: name,
+		}
+
+		if len(tag.TagKey) > 0 {
+			var key string
+			if err := unmarshalOrganizationsPolicyValue(tag.TagKey, &key); err != nil {
+				return nil, fmt.Errorf("parsing AWS Organizations tag policy: tag %q: tag_key: %w", name, err)
+			}
+			rule.Key = key
+			rule.EnforceCase = true
+		}
+
+		if len(tag.TagValue) > 0 {
+			if err := unmarshalOrganizationsPolicyValue(tag.TagValue, &rule.AllowedValues); err != nil {
+				return nil, fmt.Errorf("parsing AWS Organizations tag policy: tag %q: tag_value: %w", name, err)
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	slices.SortFunc(rules, func(a, b PolicyRule) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return rules, nil
+}
+
+// unmarshalOrganizationsPolicyValue unmarshals a policy value that is either specified directly or using the `@@assign` value setting operator.
+func unmarshalOrganizationsPolicyValue(data json.RawMessage, v any) error {
+	var operators map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &operators); err == nil {
+		data = operators["@@assign"]
+		if data == nil {
+			return nil
+		}
+	}
+
+	return json.Unmarshal(data, v)
+}
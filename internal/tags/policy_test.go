@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPolicyConfigViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policyConfig := &PolicyConfig{
+		Rules: []PolicyRule{
+			{
+				Key:      "CostCenter",
+				Required: true,
+				AllowedValues: []string{
+					"100",
+					"200*",
+				},
+				EnforceCase: true,
+			},
+			{
+				Key:           "Backup",
+				Required:      true,
+				ResourceTypes: []string{"aws_instance", "aws_ebs_*"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		typeName string
+		tags     map[string]string
+		want     []string
+	}{
+		{
+			name:     "compliant",
+			typeName: "aws_vpc",
+			tags: map[string]string{
+				"CostCenter": "100",
+			},
+		},
+		{
+			name:     "compliant wildcard value",
+			typeName: "aws_instance",
+			tags: map[string]string{
+				"Backup":     "daily",
+				"CostCenter": "2001",
+			},
+		},
+		{
+			name:     "missing required",
+			typeName: "aws_ebs_volume",
+			tags: map[string]string{
+				"Name": "test",
+			},
+			want: []string{
+				`tag "Backup" is required`,
+				`tag "CostCenter" is required`,
+			},
+		},
+		{
+			name:     "wrong case",
+			typeName: "aws_vpc",
+			tags: map[string]string{
+				"costcenter": "100",
+			},
+			want: []string{
+				`tag key "costcenter" must be capitalized as "CostCenter"`,
+			},
+		},
+		{
+			name:     "value not allowed",
+			typeName: "aws_vpc",
+			tags: map[string]string{
+				"CostCenter": "300",
+			},
+			want: []string{
+				`tag "CostCenter" value "300" is not one of the allowed values: 100, 200*`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := policyConfig.Violations(testCase.typeName, New(ctx, testCase.tags))
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyConfigViolations_nil(t *testing.T) {
+	t.Parallel()
+
+	var pc *PolicyConfig
+
+	if got := pc.Violations("aws_vpc", New(context.Background(), map[string]string{"Name": "test"})); len(got) != 0 {
+		t.Errorf("got %v, want none", got)
+	}
+	if pc.IsWarning() {
+		t.Error("IsWarning() = true, want false")
+	}
+}
+
+func TestExpandOrganizationsTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	document := `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {
+        "@@assign": "CostCenter"
+      },
+      "tag_value": {
+        "@@assign": ["100", "200*"]
+      },
+      "enforced_for": {
+        "@@assign": ["ec2:instance"]
+      }
+    },
+    "project": {
+      "tag_key": "Project"
+    },
+    "owner": {
+      "tag_value": {
+        "@@operators_allowed_for_child_policies": ["@@none"]
+      }
+    }
+  }
+}`
+
+	got, err := ExpandOrganizationsTagPolicy([]byte(document))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []PolicyRule{
+		{
+			Key:           "CostCenter",
+			AllowedValues: []string{"100", "200*"},
+			EnforceCase:   true,
+		},
+		{
+			Key:         "Project",
+			EnforceCase: true,
+		},
+		{
+			Key: "owner",
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if _, err := ExpandOrganizationsTagPolicy([]byte(`{"tags": {"costcenter": {"tag_key": 42}}}`)); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/YakDriver/regexache"
@@ -197,6 +198,201 @@ func LegacyPolicyNormalize(policy interface{}) (string, error) {
 	return n, nil
 }
 
+// PolicyMinify returns the shortest form of a JSON policy document that is equivalent to the policy.
+// The policy is normalized as by PolicyToSet and the `Version` element is placed first.
+// Single-element arrays are replaced by their element, and statements and the values of
+// elements whose order is insignificant are sorted, so that equivalent policies minify to the same string.
+func PolicyMinify(policy string) (string, error) {
+	if strings.TrimSpace(policy) == "" {
+		return "", nil
+	}
+
+	np, err := structure.NormalizeJsonString(policy)
+	if err != nil {
+		return policy, fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal([]byte(np), &doc); err != nil {
+		return policy, fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+	}
+
+	m, ok := doc.(map[string]interface{})
+	if !ok {
+		return policy, fmt.Errorf("policy (%s) is not a JSON object", policy)
+	}
+
+	if v, ok := m["Statement"]; ok {
+		m["Statement"] = minifyPolicyStatements(v)
+	}
+
+	n, err := marshalPolicy(m)
+	if err != nil {
+		return policy, fmt.Errorf("minifying policy (%s): %w", policy, err)
+	}
+
+	if !PolicyStringsEquivalent(policy, n) {
+		return policy, fmt.Errorf("minified policy (%s) is not equivalent to policy (%s)", n, policy)
+	}
+
+	return n, nil
+}
+
+// minifyPolicyStatements returns the minified form of a policy's `Statement` element.
+func minifyPolicyStatements(v interface{}) interface{} {
+	statements, ok := v.([]interface{})
+	if !ok {
+		return minifyPolicyStatement(v)
+	}
+
+	for i, statement := range statements {
+		statements[i] = minifyPolicyStatement(statement)
+	}
+
+	if len(statements) == 1 {
+		return statements[0]
+	}
+
+	// Statement order is insignificant.
+	type keyedStatement struct {
+		key       string
+		statement interface{}
+	}
+
+	keyed := make([]keyedStatement, 0, len(statements))
+	for _, statement := range statements {
+		key, err := marshalPolicy(statement)
+		if err != nil {
+			return statements
+		}
+		keyed = append(keyed, keyedStatement{key: key, statement: statement})
+	}
+
+	slices.SortStableFunc(keyed, func(a, b keyedStatement) int {
+		return strings.Compare(a.key, b.key)
+	})
+
+	for i, v := range keyed {
+		statements[i] = v.statement
+	}
+
+	return statements
+}
+
+// minifyPolicyStatement returns the minified form of a policy statement.
+func minifyPolicyStatement(v interface{}) interface{} {
+	statement, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+
+	for _, k := range []string{"Action", "NotAction", "Resource", "NotResource"} {
+		if v, ok := statement[k]; ok {
+			statement[k] = minifyPolicyStringSet(v)
+		}
+	}
+
+	for _, k := range []string{"Principal", "NotPrincipal"} {
+		if principals, ok := statement[k].(map[string]interface{}); ok {
+			for k, v := range principals {
+				principals[k] = minifyPolicyStringSet(v)
+			}
+		}
+	}
+
+	if conditions, ok := statement["Condition"].(map[string]interface{}); ok {
+		for _, condition := range conditions {
+			if condition, ok := condition.(map[string]interface{}); ok {
+				for k, v := range condition {
+					condition[k] = minifyPolicyStringSet(v)
+				}
+			}
+		}
+	}
+
+	return statement
+}
+
+// minifyPolicyStringSet returns the minified form of an element whose value is a set of strings.
+// The values are sorted and a single value replaces the array.
+func minifyPolicyStringSet(v interface{}) interface{} {
+	values, ok := v.([]interface{})
+	if !ok {
+		return v
+	}
+
+	strs := make([]string, 0, len(values))
+	for _, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			return values
+		}
+		strs = append(strs, s)
+	}
+
+	if len(strs) == 1 {
+		return strs[0]
+	}
+
+	slices.Sort(strs)
+
+	for i, s := range strs {
+		values[i] = s
+	}
+
+	return values
+}
+
+// marshalPolicy returns the compact JSON encoding of a policy document or element.
+// Object keys are sorted except that a top-level `Version` element is placed first.
+// HTML characters are not escaped.
+func marshalPolicy(v interface{}) (string, error) {
+	encode := func(v interface{}) (string, error) {
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+
+		if err := enc.Encode(v); err != nil {
+			return "", err
+		}
+
+		return strings.TrimSuffix(buf.String(), "\n"), nil
+	}
+
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return encode(v)
+	}
+
+	version, ok := m["Version"]
+	if !ok {
+		return encode(m)
+	}
+
+	rest := make(map[string]interface{}, len(m)-1)
+	for k, v := range m {
+		if k != "Version" {
+			rest[k] = v
+		}
+	}
+
+	vs, err := encode(version)
+	if err != nil {
+		return "", err
+	}
+
+	if len(rest) == 0 {
+		return `{"Version":` + vs + `}`, nil
+	}
+
+	rs, err := encode(rest)
+	if err != nil {
+		return "", err
+	}
+
+	return `{"Version":` + vs + `,` + rs[1:], nil
+}
+
 // LegacyPolicyToSet returns the existing policy if the new policy is equivalent.
 // Otherwise, it returns the new policy. Either policy is legacy normalized.
 func LegacyPolicyToSet(exist, new string) (string, error) {
@@ -810,3 +810,168 @@ func TestLegacyPolicyNormalize(t *testing.T) {
 		})
 	}
 }
+
+func TestPolicyMinify(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Name:     "empty",
+			Input:    "",
+			Expected: "",
+			Error:    false,
+		},
+		{
+			Name:     "minified",
+			Input:    `{"Version":"2012-10-17","Statement":{"Action":"*","Effect":"Allow","Resource":"*"}}`,
+			Expected: `{"Version":"2012-10-17","Statement":{"Action":"*","Effect":"Allow","Resource":"*"}}`,
+			Error:    false,
+		},
+		{
+			Name: "versionFirst",
+			Input: `{
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "s3.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ],
+  "Version": "2012-10-17"
+}
+`,
+			Expected: `{"Version":"2012-10-17","Statement":{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Sid":""}}`,
+			Error:    false,
+		},
+		{
+			Name: "whitespaceInValues",
+			Input: `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Sid": "Enable IAM User Permissions",
+    "Effect": "Allow",
+    "Action": "*",
+    "Resource": "*"
+  }
+}`,
+			Expected: `{"Version":"2012-10-17","Statement":{"Action":"*","Effect":"Allow","Resource":"*","Sid":"Enable IAM User Permissions"}}`,
+			Error:    false,
+		},
+		{
+			Name:     "htmlCharacters",
+			Input:    `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a&b/<c>"}}`, //lintignore:AWSAT005
+			Expected: `{"Version":"2012-10-17","Statement":{"Action":"s3:GetObject","Effect":"Allow","Resource":"arn:aws:s3:::a&b/<c>"}}`, //lintignore:AWSAT005
+			Error:    false,
+		},
+		{
+			Name:     "badJSON",
+			Input:    `{"Version":`,
+			Expected: `{"Version":`,
+			Error:    true,
+		},
+		{
+			Name:     "notObject",
+			Input:    `["s3:GetObject"]`,
+			Expected: `["s3:GetObject"]`,
+			Error:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := PolicyMinify(tc.Input)
+
+			if tc.Error {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+			} else {
+				if err != nil {
+					t.Errorf("expected no error, got: %s", err)
+				}
+			}
+
+			if p != tc.Expected {
+				t.Errorf("expected %s, got: %s", tc.Expected, p)
+			}
+		})
+	}
+}
+
+func TestPolicyMinify_equivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Inputs   []string
+		Expected string
+	}{
+		{
+			Name: "elementOrderAndWhitespace",
+			Inputs: []string{
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+				`{
+  "Statement": [
+    {
+      "Resource": "*",
+      "Action": "s3:GetObject",
+      "Effect": "Allow"
+    }
+  ],
+  "Version": "2012-10-17"
+}`,
+			},
+			Expected: `{"Version":"2012-10-17","Statement":{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}}`,
+		},
+		{
+			Name: "singleElementArrays",
+			Inputs: []string{
+				`{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Condition":{"StringEquals":{"aws:PrincipalTag/team":"a"}}}}`,           //lintignore:AWSAT005
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["*"],"Principal":{"AWS":["arn:aws:iam::123456789012:root"]},"Condition":{"StringEquals":{"aws:PrincipalTag/team":["a"]}}}]}`, //lintignore:AWSAT005
+			},
+			Expected: `{"Version":"2012-10-17","Statement":{"Action":"s3:GetObject","Condition":{"StringEquals":{"aws:PrincipalTag/team":"a"}},"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Resource":"*"}}`, //lintignore:AWSAT005
+		},
+		{
+			Name: "setOrder",
+			Inputs: []string{
+				`{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"*"},{"Sid":"B","Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`,
+				`{"Version":"2012-10-17","Statement":[{"Sid":"B","Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"},{"Sid":"A","Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+			},
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:DeleteObject","Effect":"Deny","Resource":"*","Sid":"B"},{"Action":["s3:GetObject","s3:PutObject"],"Effect":"Allow","Resource":"*","Sid":"A"}]}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, input := range tc.Inputs {
+				if !PolicyStringsEquivalent(input, tc.Expected) {
+					t.Fatalf("test case policies are not equivalent: %s", input)
+				}
+
+				p, err := PolicyMinify(input)
+
+				if err != nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if p != tc.Expected {
+					t.Errorf("expected %s, got: %s", tc.Expected, p)
+				}
+			}
+		})
+	}
+}
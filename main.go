@@ -7,8 +7,10 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -38,6 +40,13 @@ func main() {
 		serveOpts...,
 	)
 
+	// Flush any client-side API call telemetry before exiting.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	if err := conns.ShutdownTelemetry(ctx); err != nil {
+		log.Printf("[WARN] shutting down telemetry: %s", err)
+	}
+	cancel()
+
 	if err != nil {
 		log.Fatal(err)
 	}
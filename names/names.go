@@ -318,6 +318,30 @@ func IsOptInRegion(region string) bool {
 	}
 }
 
+// IsGlobalService returns whether the specified service package's resources are global,
+// i.e. not managed via an AWS Region-specific endpoint.
+func IsGlobalService(servicePackageName string) bool {
+	switch servicePackageName {
+	case Account,
+		Budgets,
+		CE,
+		CloudFront,
+		CUR,
+		GlobalAccelerator,
+		IAM,
+		NetworkManager,
+		Organizations,
+		Route53,
+		Route53RecoveryControlConfig,
+		Route53RecoveryReadiness,
+		Shield,
+		WAF:
+		return true
+	default:
+		return false
+	}
+}
+
 func PartitionForRegion(region string) string {
 	switch region {
 	case "":
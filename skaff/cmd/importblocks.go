@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/importblocks"
+	"github.com/spf13/cobra"
+)
+
+var (
+	importBlocksOut           string
+	importBlocksProviderDir   string
+	importBlocksRegions       []string
+	importBlocksResourceTypes []string
+)
+
+var importBlocksCmd = &cobra.Command{
+	Use:   "import-blocks",
+	Short: "Generate import blocks for existing resources",
+	Long: `Generate Terraform import blocks for the existing resources of the specified types.
+
+Resources are listed using each resource type's sweeper with read-only AWS API clients;
+nothing is created, modified or deleted. Credentials are taken from the environment as for
+running sweepers. To run against a local stand-in endpoint, set AWS_ENDPOINT_URL (or a
+service-specific AWS_ENDPOINT_URL_<SERVICE>) together with fake static credentials.`,
+	Example: `  skaff import-blocks --types aws_sns_topic,aws_sqs_queue --regions us-west-2 --out imports.tf`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return importblocks.Generate(importBlocksProviderDir, importBlocksResourceTypes, importBlocksRegions, importBlocksOut)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(importBlocksCmd)
+	importBlocksCmd.Flags().StringVarP(&importBlocksOut, "out", "o", "imports.tf", "file to write the import blocks to")
+	importBlocksCmd.Flags().StringVarP(&importBlocksProviderDir, "provider-dir", "p", ".", "directory within the Terraform AWS Provider source tree")
+	importBlocksCmd.Flags().StringSliceVarP(&importBlocksRegions, "regions", "r", nil, "comma-separated list of Regions to list resources in")
+	importBlocksCmd.Flags().StringSliceVarP(&importBlocksResourceTypes, "types", "t", nil, "comma-separated list of resource types, e.g. aws_sns_topic")
+	importBlocksCmd.MarkFlagRequired("regions")
+	importBlocksCmd.MarkFlagRequired("types")
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package importblocks
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const providerModulePath = "github.com/hashicorp/terraform-provider-aws"
+
+// Generate writes Terraform import blocks for existing resources of the specified types in the specified Regions to out.
+// Resources are listed by the provider's sweepers, run in read-only mode from the provider source tree containing dir.
+func Generate(dir string, resourceTypes, regions []string, out string) error {
+	if len(resourceTypes) == 0 {
+		return errors.New("at least one resource type is required")
+	}
+
+	if len(regions) == 0 {
+		return errors.New("at least one Region is required")
+	}
+
+	root, err := providerRoot(dir)
+	if err != nil {
+		return err
+	}
+
+	out, err = filepath.Abs(out)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command("go", "test", "./internal/sweep", "-run", "^$", "-timeout", "0", //nolint:gosec // Arguments are passed as flags to a local test binary.
+		"-sweep="+strings.Join(regions, ","),
+		"-sweep-run="+strings.Join(resourceTypes, ","),
+		"-import-blocks-out="+out,
+	)
+	cmd.Dir = root
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("listing resources: %w", err)
+	}
+
+	fmt.Fprintf(os.Stdout, "Import blocks written to %s\n", out)
+
+	return nil
+}
+
+// providerRoot returns the root directory of the provider source tree containing dir.
+func providerRoot(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		if modulePath(filepath.Join(dir, "go.mod")) == providerModulePath {
+			return dir, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("%s is not in the Terraform AWS Provider source tree", dir)
+		}
+		dir = parent
+	}
+}
+
+// modulePath returns the module path declared in a go.mod file, or "" if the file cannot be read.
+func modulePath(filename string) string {
+	f, err := os.Open(filename)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if v, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module "); ok {
+			return strings.Trim(strings.TrimSpace(v), `"`)
+		}
+	}
+
+	return ""
+}
@@ -4,16 +4,21 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	_ "embed"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
 	"os"
 	"path"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
@@ -24,11 +29,12 @@ import (
 var (
 	dataSourceType = flag.String("data-source", "", "Data Source type")
 	resourceType   = flag.String("resource", "", "Resource type")
+	stateFile      = flag.String("state-file", "", "Terraform state file from which to capture Plugin SDK V2 resource state fixtures")
 )
 
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n")
-	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] <package-name> <name> <generated-file>\n\n")
+	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type> [-state-file <terraform.tfstate>]|-data-source <data-source-type>] <package-name> <name> <generated-file>\n\n")
 }
 
 func main() {
@@ -83,6 +89,7 @@ func main() {
 		}
 
 		migrator.Resource = resource
+		migrator.StateFile = *stateFile
 		migrator.Template = resourceImpl
 		migrator.TFTypeName = v
 	}
@@ -98,6 +105,7 @@ type migrator struct {
 	Name         string
 	PackageName  string
 	Resource     *schema.Resource
+	StateFile    string
 	Template     string
 	TFTypeName   string
 }
@@ -126,7 +134,221 @@ func (m *migrator) migrate(outputFilename string) error {
 		return err
 	}
 
-	return d.Write()
+	if err := d.Write(); err != nil {
+		return err
+	}
+
+	if m.IsDataSource {
+		return nil
+	}
+
+	// Generate a test which replays Plugin SDK V2 state fixtures through the generated state upgrader.
+	testFilename := strings.TrimSuffix(outputFilename, ".go") + "_state_upgrade_test.go"
+	m.infof("generating state upgrade test into %[1]q", testFilename)
+
+	d = m.Generator.NewGoFileDestination(testFilename)
+
+	if err := d.WriteTemplate("stateupgradetest", stateUpgradeTestImpl, templateData); err != nil {
+		return err
+	}
+
+	if err := d.Write(); err != nil {
+		return err
+	}
+
+	return m.writeStateFixtures(path.Join(dirname, "testdata", "state_upgrade", m.TFTypeName))
+}
+
+// stateFixture is a Plugin SDK V2 resource instance's state, in Terraform state file format.
+type stateFixture struct {
+	Attributes     json.RawMessage   `json:"attributes,omitempty"`
+	AttributesFlat map[string]string `json:"attributes_flat,omitempty"`
+	SchemaVersion  int64             `json:"schema_version"`
+
+	// Expected holds the upgraded state's expected top-level string, number and bool attribute values.
+	// It is verified by framework.VerifySDKv2StateFixture.
+	Expected map[string]string `json:"expected,omitempty"`
+}
+
+// writeStateFixtures writes Plugin SDK V2 state fixtures into the specified directory.
+// Fixtures for the resource's minimal state in both JSON and flatmap encodings are always written.
+// Any instances of the resource type in the migrator's Terraform state file are captured as additional fixtures.
+// Each fixture's expected values are its top-level primitive attribute values; review and extend them before committing.
+func (m *migrator) writeStateFixtures(dirname string) error {
+	if err := os.MkdirAll(dirname, 0755); err != nil {
+		return fmt.Errorf("creating fixture directory %s: %w", dirname, err)
+	}
+
+	schemaVersion := int64(m.Resource.SchemaVersion)
+	d := m.Resource.Data(nil)
+	d.SetId("example")
+	state := d.State()
+
+	ty := m.Resource.CoreConfigSchema().ImpliedType()
+	val, err := state.AttrsAsObjectValue(ty)
+
+	if err != nil {
+		return fmt.Errorf("converting %s state: %w", m.TFTypeName, err)
+	}
+
+	attributes, err := ctyjson.Marshal(val, ty)
+
+	if err != nil {
+		return fmt.Errorf("encoding %s state: %w", m.TFTypeName, err)
+	}
+
+	fixtures := map[string]stateFixture{
+		"minimal.json": {
+			Attributes:    attributes,
+			SchemaVersion: schemaVersion,
+		},
+		"minimal_flatmap.json": {
+			AttributesFlat: state.Attributes,
+			SchemaVersion:  schemaVersion,
+		},
+	}
+
+	if m.StateFile != "" {
+		captured, err := m.captureStateFixtures(m.StateFile)
+
+		if err != nil {
+			return err
+		}
+
+		for name, fixture := range captured {
+			fixtures[name] = fixture
+		}
+	}
+
+	for name, fixture := range fixtures {
+		if fixture.Expected, err = m.expectedStateValues(fixture); err != nil {
+			return fmt.Errorf("state fixture %s: %w", name, err)
+		}
+
+		b, err := json.MarshalIndent(fixture, "", "  ")
+
+		if err != nil {
+			return fmt.Errorf("encoding state fixture %s: %w", name, err)
+		}
+
+		filename := path.Join(dirname, name)
+		m.infof("writing state fixture %[1]q", filename)
+
+		if err := os.WriteFile(filename, append(b, '\n'), 0644); err != nil {
+			return fmt.Errorf("writing state fixture %s: %w", filename, err)
+		}
+	}
+
+	return nil
+}
+
+// expectedStateValues returns the values of the fixture's top-level string, number and bool attributes
+// that are declared in the resource's schema.
+func (m *migrator) expectedStateValues(fixture stateFixture) (map[string]string, error) {
+	expected := make(map[string]string)
+
+	declared := func(name string) bool {
+		v, ok := m.Resource.Schema[name]
+		if !ok {
+			return false
+		}
+
+		switch v.Type {
+		case schema.TypeBool, schema.TypeFloat, schema.TypeInt, schema.TypeString:
+			return true
+		default:
+			return false
+		}
+	}
+
+	if fixture.AttributesFlat != nil {
+		for name, v := range fixture.AttributesFlat {
+			if name == "id" || declared(name) {
+				expected[name] = v
+			}
+		}
+
+		return expected, nil
+	}
+
+	d := json.NewDecoder(bytes.NewReader(fixture.Attributes))
+	d.UseNumber()
+
+	var attributes map[string]any
+	if err := d.Decode(&attributes); err != nil {
+		return nil, fmt.Errorf("decoding attributes: %w", err)
+	}
+
+	for name, v := range attributes {
+		if name != "id" && !declared(name) {
+			continue
+		}
+
+		switch v := v.(type) {
+		case bool:
+			expected[name] = strconv.FormatBool(v)
+		case json.Number:
+			expected[name] = v.String()
+		case string:
+			expected[name] = v
+		}
+	}
+
+	return expected, nil
+}
+
+var fixtureNameRegexp = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// captureStateFixtures returns state fixtures for all instances of the migrator's resource type in the specified Terraform state file.
+func (m *migrator) captureStateFixtures(filename string) (map[string]stateFixture, error) {
+	b, err := os.ReadFile(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading state file %s: %w", filename, err)
+	}
+
+	var state struct {
+		Resources []struct {
+			Instances []struct {
+				IndexKey any `json:"index_key"`
+				stateFixture
+			} `json:"instances"`
+			Mode   string `json:"mode"`
+			Module string `json:"module"`
+			Name   string `json:"name"`
+			Type   string `json:"type"`
+		} `json:"resources"`
+	}
+
+	if err := json.Unmarshal(b, &state); err != nil {
+		return nil, fmt.Errorf("decoding state file %s: %w", filename, err)
+	}
+
+	fixtures := make(map[string]stateFixture)
+
+	for _, resource := range state.Resources {
+		if resource.Mode != "managed" || resource.Type != m.TFTypeName {
+			continue
+		}
+
+		for _, instance := range resource.Instances {
+			address := strings.Join([]string{resource.Module, resource.Name}, ".")
+			if instance.IndexKey != nil {
+				address = fmt.Sprintf("%s[%v]", address, instance.IndexKey)
+			}
+			name := "captured_" + strings.Trim(fixtureNameRegexp.ReplaceAllString(address, "_"), "_") + ".json"
+
+			fixtures[name] = instance.stateFixture
+		}
+	}
+
+	if len(fixtures) == 0 {
+		m.Generator.Warnf("no %s instances found in state file %s", m.TFTypeName, filename)
+	} else {
+		m.Generator.Warnf("review captured state fixtures for sensitive values before committing them")
+	}
+
+	return fixtures, nil
 }
 
 func (m *migrator) generateTemplateData() (*templateData, error) {
@@ -153,12 +375,14 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		EmitResourceImportState:      m.Resource.Importer != nil,
 		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
 		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
+		EmitResourceUpgradeState:     !m.IsDataSource,
 		HasTimeouts:                  emitter.HasTimeouts,
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
 		Name:                         m.Name,
 		PackageName:                  m.PackageName,
 		Schema:                       sbSchema.String(),
+		SDKv2SchemaVersion:           int64(m.Resource.SchemaVersion),
 		Struct:                       sbStruct.String(),
 		TFTypeName:                   m.TFTypeName,
 	}
@@ -242,8 +466,17 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 		return err
 	}
 
-	if version := resource.SchemaVersion; version > 0 {
-		fprintf(e.SchemaWriter, "Version:%d,\n", version)
+	if e.IsDataSource {
+		if version := resource.SchemaVersion; version > 0 {
+			fprintf(e.SchemaWriter, "Version:%d,\n", version)
+		}
+	} else {
+		// The schema version is incremented so that state written by the Plugin SDK V2 resource is upgraded.
+		fprintf(e.SchemaWriter, "Version:%d,\n", resource.SchemaVersion+1)
+
+		if len(resource.StateUpgraders) > 0 {
+			e.warnf("Plugin SDK V2 state upgraders for schema versions before %d are not migrated", resource.SchemaVersion)
+		}
 	}
 
 	if description := resource.Description; description != "" {
@@ -908,6 +1141,7 @@ type templateData struct {
 	EmitResourceImportState       bool
 	EmitResourceModifyPlan        bool
 	EmitResourceUpdateSkeleton    bool
+	EmitResourceUpgradeState      bool
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
 	GoImports                     []goImport
@@ -917,6 +1151,7 @@ type templateData struct {
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
 	Schema                        string
+	SDKv2SchemaVersion            int64
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
 }
@@ -927,6 +1162,9 @@ var datasourceImpl string
 //go:embed resource.gtpl
 var resourceImpl string
 
+//go:embed state_upgrade_test.gtpl
+var stateUpgradeTestImpl string
+
 type goImport struct {
 	Path  string
 	Alias string